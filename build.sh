@@ -1,6 +1,6 @@
 echo "======= Converting Header Files"
 cd raylib-convert
-go run main.go
+go run *.go
 
 echo "======= Copying Generated Files"
 echo "Audio";  	cp out/audio_gen.go ../raylib/audio_gen.go
@@ -10,6 +10,7 @@ echo "Gestures";  cp out/gestures_gen.go ../raylib/gestures_gen.go
 echo "Input";  cp out/input_gen.go ../raylib/input_gen.go
 echo "Main";  cp out/main_gen.go ../raylib/main_gen.go
 echo "Models";  cp out/models_gen.go ../raylib/models_gen.go
+echo "Physics";  cp out/physics_gen.go ../raylib/physics_gen.go
 echo "GUI";  	cp out/raygui_gen.go ../raylib/raygui_gen.go
 echo "Shader";  cp out/shader_gen.go ../raylib/shader_gen.go
 echo "Shapes";  cp out/shapes_gen.go ../raylib/shapes_gen.go
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+//cHeader is everything the converter reads from a C header
+type cHeader struct {
+	name      string
+	functions []*cFunction
+	structs   []*cStruct
+	enums     []*cEnum
+	aliases   map[string]string
+}
+
+//cFunction is a function prototype from a header, with the headings it was found under
+type cFunction struct {
+	header  string
+	module  string
+	section string
+	name    string
+	line    string //The prototype on a single line, with its comment, in the format parseLine expects
+}
+
+//key is what the directive regexes are matched against: "raylib.h > Module heading > Section heading > Name"
+func (f *cFunction) key() string {
+	return f.header + " > " + f.module + " > " + f.section + " > " + f.name
+}
+
+//cStruct is a typedef struct from a header
+type cStruct struct {
+	header string
+	name   string
+	fields []cField
+}
+
+//cField is a single field of a struct
+type cField struct {
+	name         string
+	valueType    string
+	pointerDepth int
+	arrayLength  string
+}
+
+//cEnum is a typedef enum from a header
+type cEnum struct {
+	header string
+	name   string
+	values []string
+}
+
+var (
+	reDefStart   = regexp.MustCompile(`^(RLAPI|RAYGUIDEF|PHYSACDEF|RMDEF)\s`)
+	reDefinition = regexp.MustCompile(`^(RLAPI|RAYGUIDEF|PHYSACDEF|RMDEF)\s+(const |unsigned )?([a-zA-Z0-9_]+)\s+(\**)\s*([a-zA-Z0-9_]+)\s*\((.*?)\)\s*(;|\{|$)`)
+	reStructOpen = regexp.MustCompile(`^typedef struct (\w+)\s*\{`)
+	reEnumOpen   = regexp.MustCompile(`^typedef enum\s*(\w+)?\s*\{`)
+	reAlias      = regexp.MustCompile(`^typedef (struct )?(\w+)\s*(\**)\s*(\w+);`)
+	reClose      = regexp.MustCompile(`^\}\s*(\w+)\s*[;,]`)
+	reField      = regexp.MustCompile(`^(const |unsigned )?(struct )?(\w+)\s+(.+);`)
+	reFieldName  = regexp.MustCompile(`^(\**)\s*(\w+)\s*(\[(\w+)\])?$`)
+	reEnumValue  = regexp.MustCompile(`^(\w+)\s*(=[^,]*)?,?`)
+	reImplement  = regexp.MustCompile(`^#if\s+defined\s*\(?\s*\w+_IMPLEMENTATION\s*\)?\s*$`)
+)
+
+//readHeader parses the functions, structs and enums from a C header.
+// It is not a C parser, it only understands the layout the raylib headers are written in:
+// a prototype starts with its API macro and ends with a ; (or a { for header only libraries like raymath), and
+// the headings are the comment between two //--- banners (the module) and the comment after an empty line (the section).
+// Functions only defined in the #if defined(XXX_IMPLEMENTATION) block of a header only library (ie: raygui's GuiSliderPro) are not part of its API, so they are left out.
+func readHeader(path string) (*cHeader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	header := &cHeader{name: filepath.Base(path), aliases: make(map[string]string)}
+	seen := make(map[string]bool)
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(scanner.Text(), " \t\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	module := ""
+	section := ""
+	depth := 0
+	inBanner := false
+	inComment := false
+	ifDepth := 0
+	implementationDepth := 0
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		//Skip block comments, they are only used for licences and examples
+		if inComment {
+			if strings.Contains(line, "*/") {
+				inComment = false
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "/*") {
+			inComment = !strings.Contains(line, "*/")
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "//---") && depth == 0:
+			inBanner = !inBanner
+			if inBanner {
+				module = ""
+				section = ""
+			}
+
+		case strings.HasPrefix(line, "//"):
+			text := strings.TrimSpace(strings.TrimLeft(line, "/"))
+			if inBanner && module == "" {
+				module = text
+			} else if !inBanner && depth == 0 && i > 0 && strings.TrimSpace(lines[i-1]) == "" && !isDocComment(lines, i) {
+				section = text
+			}
+
+		case strings.HasPrefix(line, "typedef struct") && reStructOpen.MatchString(line):
+			s := &cStruct{header: header.name, name: reStructOpen.FindStringSubmatch(line)[1]}
+			if body, ok := inlineBody(line); ok {
+				readStructFields(body, 0, s)
+			} else {
+				i = readStructFields(lines, i+1, s)
+			}
+			header.structs = append(header.structs, s)
+			header.aliases[s.name] = s.name
+
+		case strings.HasPrefix(line, "typedef enum") && reEnumOpen.MatchString(line):
+			e := &cEnum{header: header.name}
+			if body, ok := inlineBody(line); ok {
+				readEnumValues(body, 0, e)
+			} else {
+				i = readEnumValues(lines, i+1, e)
+			}
+			header.enums = append(header.enums, e)
+
+		case reAlias.MatchString(line):
+			match := reAlias.FindStringSubmatch(line)
+			header.aliases[match[4]] = match[2] + match[3]
+
+		case reDefStart.MatchString(line):
+			//Join the prototypes that are split over several lines
+			start := i
+			full := line
+			for !strings.ContainsAny(full, ";{") && i+1 < len(lines) {
+				i++
+				full += " " + strings.TrimSpace(lines[i])
+			}
+			if !strings.ContainsAny(full, ";{") && i+1 < len(lines) && strings.TrimSpace(lines[i+1]) == "{" {
+				i++
+				full += " {"
+			}
+
+			fn, err := newFunction(full, docComment(lines, start))
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", header.name, i+1, err)
+			}
+
+			//Header only libraries declare and define their functions, the declaration is first.
+			if fn != nil && !seen[fn.name] && implementationDepth == 0 {
+				seen[fn.name] = true
+				fn.header = header.name
+				fn.module = module
+				fn.section = section
+				header.functions = append(header.functions, fn)
+			}
+			depth += strings.Count(full, "{") - strings.Count(full, "}")
+
+		case strings.HasPrefix(line, "#if"):
+			ifDepth++
+			if implementationDepth == 0 && reImplement.MatchString(line) {
+				implementationDepth = ifDepth
+			}
+
+		case strings.HasPrefix(line, "#endif"):
+			if ifDepth == implementationDepth {
+				implementationDepth = 0
+			}
+			if ifDepth > 0 {
+				ifDepth--
+			}
+
+		case strings.HasPrefix(line, "extern \"C\""):
+			//The C++ guard would make everything look like it is in a block
+
+		default:
+			depth += strings.Count(line, "{") - strings.Count(line, "}")
+			if depth < 0 {
+				depth = 0
+			}
+		}
+	}
+
+	return header, nil
+}
+
+//isDocComment returns true if the comment is directly above a prototype that does not have its own comment
+func isDocComment(lines []string, index int) bool {
+	for index++; index < len(lines); index++ {
+		next := strings.TrimSpace(lines[index])
+		if !strings.HasPrefix(next, "//") {
+			return reDefStart.MatchString(next) && !strings.Contains(next, "//")
+		}
+	}
+	return false
+}
+
+//docComment gets the first line of the comments directly above the prototype
+func docComment(lines []string, index int) string {
+	comment := ""
+	for index--; index >= 0; index-- {
+		line := strings.TrimSpace(lines[index])
+		if !strings.HasPrefix(line, "//") || strings.HasPrefix(line, "//---") {
+			break
+		}
+		comment = strings.TrimSpace(strings.TrimLeft(line, "/"))
+	}
+	return comment
+}
+
+//newFunction creates the function from its prototype. The doc comment is used if the prototype does not have one on the same line.
+func newFunction(full string, doc string) (*cFunction, error) {
+	match := reDefinition.FindStringSubmatch(full)
+	if match == nil {
+		return nil, errors.New("cannot read prototype: " + full)
+	}
+
+	comment := ""
+	if index := strings.Index(full, "//"); index >= 0 && match[7] == ";" {
+		comment = strings.TrimSpace(full[index+2:])
+	} else {
+		comment = doc
+	}
+
+	args := strings.Join(strings.Fields(match[6]), " ")
+	if args == "" {
+		args = "void"
+	}
+
+	line := "RLAPI " + match[2] + match[3] + " " + match[4] + match[5] + "(" + args + ");"
+	if comment != "" {
+		line += " // " + comment
+	}
+	return &cFunction{name: match[5], line: line}, nil
+}
+
+//inlineBody splits a typedef that is on a single line, ie: typedef enum { false, true } bool;
+// into the lines it would have if it was written over several.
+func inlineBody(line string) ([]string, bool) {
+	open := strings.Index(line, "{")
+	close := strings.LastIndex(line, "}")
+	if close < open {
+		return nil, false
+	}
+
+	body := strings.Split(strings.Replace(line[open+1:close], ";", ";\n", -1), "\n")
+	return append(body, line[close:]), true
+}
+
+//readStructFields reads the fields up to the closing brace and returns the index of its line
+func readStructFields(lines []string, start int, s *cStruct) int {
+	for i := start; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if index := strings.Index(line, "//"); index >= 0 {
+			line = strings.TrimSpace(line[:index])
+		}
+
+		if match := reClose.FindStringSubmatch(line); match != nil {
+			return i
+		}
+
+		match := reField.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		valueType := match[3]
+		if match[1] == "unsigned " {
+			valueType = "unsigned " + valueType
+		}
+
+		for _, part := range strings.Split(match[4], ",") {
+			field := reFieldName.FindStringSubmatch(strings.TrimSpace(part))
+			if field == nil {
+				continue
+			}
+
+			s.fields = append(s.fields, cField{name: field[2], valueType: valueType, pointerDepth: len(field[1]), arrayLength: field[4]})
+		}
+	}
+	return len(lines)
+}
+
+//readEnumValues reads the values up to the closing brace and returns the index of its line. The closing brace names the enum.
+func readEnumValues(lines []string, start int, e *cEnum) int {
+	for i := start; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if index := strings.Index(line, "//"); index >= 0 {
+			line = strings.TrimSpace(line[:index])
+		}
+
+		if match := reClose.FindStringSubmatch(line); match != nil {
+			e.name = match[1]
+			return i
+		}
+
+		for _, part := range strings.Split(line, ",") {
+			if match := reEnumValue.FindStringSubmatch(strings.TrimSpace(part)); match != nil {
+				e.values = append(e.values, match[1])
+			}
+		}
+	}
+	return len(lines)
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//Statuses of a function in the coverage report
+const (
+	statusGenerated = "generated"
+	statusManual    = "manual"
+	statusGo        = "go"
+	statusSkipped   = "skipped"
+	statusFailed    = "failed"
+	statusMissing   = "missing"
+)
+
+var statusOrder = []string{statusGenerated, statusManual, statusGo, statusSkipped, statusFailed, statusMissing}
+
+//goPackage is what the Go package already has, so the report can tell which C functions and types are covered by hand written Go
+type goPackage struct {
+	functions map[string]string
+	types     map[string]int //Number of fields for structs, -1 for other types
+	constants map[string]bool
+}
+
+//readGoPackage reads the declarations of the Go package, ignoring the generated files
+func readGoPackage(dir string) (*goPackage, error) {
+	pkg := &goPackage{functions: make(map[string]string), types: make(map[string]int), constants: make(map[string]bool)}
+
+	filter := func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && !strings.HasSuffix(info.Name(), *fileSuffix+".go")
+	}
+
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range parsed {
+		for path, file := range p.Files {
+			for _, decl := range file.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					if d.Recv == nil {
+						pkg.functions[d.Name.Name] = filepath.Base(path)
+					}
+
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *ast.TypeSpec:
+							pkg.types[s.Name.Name] = -1
+							if st, ok := s.Type.(*ast.StructType); ok {
+								pkg.types[s.Name.Name] = countFields(st)
+							}
+						case *ast.ValueSpec:
+							if d.Tok == token.CONST {
+								for _, name := range s.Names {
+									pkg.constants[strings.ToLower(name.Name)] = true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+func countFields(st *ast.StructType) int {
+	count := 0
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			count++
+		}
+		count += len(field.Names)
+	}
+	return count
+}
+
+//coverageEntry is the status of a single function
+type coverageEntry struct {
+	function *cFunction
+	status   string
+	note     string
+}
+
+//coverage collects the status of every function in the headers
+type coverage struct {
+	pkg     *goPackage
+	entries map[string]*coverageEntry
+}
+
+func newCoverage(pkg *goPackage) *coverage {
+	return &coverage{pkg: pkg, entries: make(map[string]*coverageEntry)}
+}
+
+func (c *coverage) set(fn *cFunction, status string, note string) {
+	c.entries[fn.name] = &coverageEntry{function: fn, status: status, note: note}
+}
+
+//skip marks a function that is not generated. If the Go package has a function with the same name, it is implemented in Go.
+func (c *coverage) skip(fn *cFunction, status string, note string) {
+	if file, ok := c.pkg.functions[fn.name]; ok {
+		if note == "" {
+			note = file
+		} else {
+			note = file + ", " + note
+		}
+		status = statusGo
+	}
+	c.set(fn, status, note)
+}
+
+//write writes the report as markdown
+func (c *coverage) write(path string, headers []*cHeader) error {
+	var b strings.Builder
+	b.WriteString("# Binding Coverage\n\n")
+	b.WriteString("This file is written by raylib-convert, do not edit it. It lists every function, struct and enum of the C headers and how the Go package covers them.\n\n")
+	b.WriteString("| Status | Meaning |\n|---|---|\n")
+	b.WriteString("| " + statusGenerated + " | Converted from the header into a `" + *fileSuffix + ".go` file |\n")
+	b.WriteString("| " + statusManual + " | Converted from the hand written binding in `" + *manualDir + "` |\n")
+	b.WriteString("| " + statusGo + " | Not converted, the Go package implements it itself |\n")
+	b.WriteString("| " + statusSkipped + " | Ignored in `" + *input + "` |\n")
+	b.WriteString("| " + statusFailed + " | The converter could not convert it |\n")
+	b.WriteString("| " + statusMissing + " | Not in any group of `" + *input + "` |\n\n")
+
+	//Summary
+	b.WriteString("| Header |")
+	for _, status := range statusOrder {
+		b.WriteString(" " + status + " |")
+	}
+	b.WriteString("\n|---|")
+	for range statusOrder {
+		b.WriteString("---|")
+	}
+	b.WriteString("\n")
+	for _, header := range headers {
+		counts := make(map[string]int)
+		for _, fn := range header.functions {
+			if entry, ok := c.entries[fn.name]; ok {
+				counts[entry.status]++
+			}
+		}
+		b.WriteString("| " + header.name + " |")
+		for _, status := range statusOrder {
+			b.WriteString(fmt.Sprintf(" %d |", counts[status]))
+		}
+		b.WriteString("\n")
+	}
+
+	//Functions, under their headings
+	for _, header := range headers {
+		b.WriteString("\n## " + header.name + "\n")
+		heading := ""
+		for _, fn := range header.functions {
+			entry, ok := c.entries[fn.name]
+			if !ok {
+				continue
+			}
+			if current := fn.module + " > " + fn.section; current != heading {
+				heading = current
+				title := fn.module
+				if fn.section != "" {
+					title += ": " + fn.section
+				}
+				b.WriteString("\n### " + title + "\n\n| Function | Status | Notes |\n|---|---|---|\n")
+			}
+			b.WriteString(fmt.Sprintf("| %s | %s | %s |\n", fn.name, entry.status, escapeCell(entry.note)))
+		}
+	}
+
+	//Functions only in the overrides
+	extra := make([]*coverageEntry, 0)
+	for _, entry := range c.entries {
+		if entry.function.header == *input {
+			extra = append(extra, entry)
+		}
+	}
+	if len(extra) > 0 {
+		sort.Slice(extra, func(i, j int) bool { return extra[i].function.name < extra[j].function.name })
+		b.WriteString("\n## " + *input + "\n\n| Function | Status | Notes |\n|---|---|---|\n")
+		for _, entry := range extra {
+			b.WriteString(fmt.Sprintf("| %s | %s | %s |\n", entry.function.name, entry.status, escapeCell(entry.note)))
+		}
+	}
+
+	//Structs, the headers guard their shared structs so only the first is listed. The Go type can have the name of a typedef of the struct.
+	b.WriteString("\n## Structs\n\n| Struct | Header | C Fields | Go Type | Go Fields |\n|---|---|---|---|---|\n")
+	listed := make(map[string]bool)
+	for _, header := range headers {
+		for _, s := range header.structs {
+			if listed[s.name] {
+				continue
+			}
+			listed[s.name] = true
+
+			goType, goFields := "missing", ""
+			for _, name := range structNames(header, s.name) {
+				if count, ok := c.pkg.types[name]; ok {
+					goType, goFields = name, "not a struct"
+					if count >= 0 {
+						goFields = fmt.Sprint(count)
+					}
+					break
+				}
+			}
+			b.WriteString(fmt.Sprintf("| %s | %s | %d | %s | %s |\n", s.name, header.name, len(s.fields), goType, goFields))
+		}
+	}
+
+	//Enums, the Go constants are the C names in camel case (KEY_LEFT_SHIFT is KeyLeftShift), or prefixed with the enum (LABEL is GuiControlLabel)
+	b.WriteString("\n## Enums\n\n| Enum | Header | C Values | Go Constants | Missing |\n|---|---|---|---|---|\n")
+	for _, header := range headers {
+		for _, e := range header.enums {
+			if e.name == "bool" {
+				continue
+			}
+
+			missing := make([]string, 0)
+			for _, value := range e.values {
+				name := strings.ToLower(strings.Replace(value, "_", "", -1))
+				if !c.pkg.constants[name] && !c.pkg.constants[strings.ToLower(e.name)+name] {
+					missing = append(missing, value)
+				}
+			}
+			b.WriteString(fmt.Sprintf("| %s | %s | %d | %d | %s |\n", e.name, header.name, len(e.values), len(e.values)-len(missing), strings.Join(missing, ", ")))
+		}
+	}
+
+	return ioutil.WriteFile(path, []byte(b.String()), 0644)
+}
+
+//structNames gets the name of the struct and the names of its typedefs
+func structNames(header *cHeader, name string) []string {
+	names := []string{name}
+	for alias, target := range header.aliases {
+		if alias != name && strings.TrimRight(target, "*") == name {
+			names = append(names, alias)
+		}
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+func escapeCell(s string) string {
+	return strings.Replace(s, "|", "\\|", -1)
+}
@@ -6,10 +6,14 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -18,18 +22,22 @@ import (
 var (
 	fileSuffix        = flag.String("suffix", "_gen", "the suffic to append to each file")
 	format            = flag.Bool("format", true, "run gofmt to the resulting output")
-	input             = flag.String("in", "headers.txt", "raylib-convert compatible header file")
+	input             = flag.String("in", "headers.txt", "raylib-convert overrides file, which says which headers to read and how to group their functions")
+	source            = flag.String("src", "../raylib/", "directory that stores the C headers and the Go package")
+	coverageOutput    = flag.String("coverage", "COVERAGE.md", "the coverage report to write, empty to skip it")
 	ignoreOOPFile     = flag.String("ioop", "ignore_oop.txt", "file that contains a list of types that cannot be OOP")
 	manualDir         = flag.String("manual", "manual/", "directory that stores the manual files")
 	output            = flag.String("out", "out/", "the output directory")
 	functionalConvert = flag.Bool("use_func", true, "tells the converter to use newTypeFromPointer and cptr() functions")
 	oopOnly           = flag.Bool("oop_only", false, "should only the OOP version of the function be generated?")
 	trackUnloadables  = flag.Bool("track_unloadables", true, "should unloadables track when they are being loaded and unloaded to our list. Only applicable with OOP")
+	threadCheck       = flag.Bool("thread_check", true, "start every function that calls C with checkMainThread, which panics in debug builds when it is not called from the main thread")
 )
 
 var ignoreOOPs []string
 var patterns []matchPattern
 var enums []matchEnum
+var errorVariants []matchError
 
 type matchPattern struct {
 	pattern *regexp.Regexp
@@ -41,19 +49,25 @@ type matchEnum struct {
 	enum    string
 }
 
+type matchError struct {
+	pattern *regexp.Regexp
+	formats string
+	failure string
+}
+
 func (p *matchPattern) replaceAll(s string) string {
 	return p.pattern.ReplaceAllString(s, p.replace)
 }
 
 func main() {
 
+	//Parse the flags
+	flag.Parse()
+
 	if _, err := os.Stat(*output); os.IsNotExist(err) {
 		os.Mkdir(*output, os.ModePerm)
 	}
 
-	//Parse the flags
-	flag.Parse()
-
 	//Read the ignore FileExists
 	ifile, ierr := os.Open(*ignoreOOPFile)
 	ignoreOOPs = make([]string, 0)
@@ -67,144 +81,143 @@ func main() {
 		}
 	}
 
-	//Read each line of the headers now
-	file, err := os.Open(*input)
+	//Read the overrides, they tell us which headers to read and how to group them
+	conf, err := readOverrides(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	headers := make([]*cHeader, 0, len(conf.headers))
+	for _, name := range conf.headers {
+		header, err := readHeader(filepath.Join(*source, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		headers = append(headers, header)
+	}
+
+	pkg, err := readGoPackage(*source)
 	if err != nil {
 		log.Fatal(err)
 	}
+	report := newCoverage(pkg)
 
-	//Defer the close until the end
-	defer file.Close()
+	//Functions without a group are only reported
+	for _, fn := range conf.assign(headers) {
+		if reason, ok := ignored(conf.ignores, fn.key()); ok {
+			report.skip(fn, statusSkipped, reason)
+		} else {
+			report.skip(fn, statusMissing, "")
+		}
+	}
 
 	successTally := 0
-	failureTally := 0
-
-	//PRepare a bunch of variables to hold our succes, failed and prototypes
-	prototypes := make([]*prototype, 0)
-	failed := make([]string, 0)
-	success := make([]string, 0)
-	patterns = make([]matchPattern, 0)
-	enums = make([]matchEnum, 0)
+	prototypeTally := 0
 
 	defaultHeader := "//Generated " + time.Now().Format(time.RFC3339) + "\n#include \"raylib.h\"\n#include <stdlib.h>\n#include \"go.h\"\n"
 
-	fileHeader := defaultHeader
-	filenameSuccess := "main" + *fileSuffix + ".go"
-	filenameFailed := "main" + *fileSuffix + ".failed"
-
-	ignoring := false
-	asOOP := false
-
-	//Read each line of the input file and generate the prototypes
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-
-		//Read the line
-		line := strings.Trim(scanner.Text(), " ")
-
-		//We are special instructions
-		if strings.HasPrefix(line, "//conv:") {
-			fmt.Println("Processing Command")
-			parts := strings.Split(line, ":")
-			if len(parts) > 0 {
-				switch parts[1] {
-				default:
-
-					//A file group
-				case "g":
-
-					//Write the old filename and recrate the values
-					failedResults := strings.Join(failed, "\n")
-					sucessResults := "package raylib\n/*\n" + fileHeader + "*/\nimport \"C\"\nimport \"unsafe\"\n" + strings.Join(success, "\n")
-					saveProgress(filenameFailed, filenameSuccess, sucessResults, failedResults)
-
-					//Clear previous arrays
-					fileHeader = defaultHeader
-					failed = make([]string, 0)
-					success = make([]string, 0)
-					patterns = make([]matchPattern, 0)
-					enums = make([]matchEnum, 0)
-
-					//Prepare the new filename
-					filenameSuccess = parts[2] + *fileSuffix + ".go"
-					filenameFailed = parts[2] + *fileSuffix + ".failed"
-
-					//A new header line
-				case "cgo":
-					fileHeader = fileHeader + strings.Join(parts[2:], ":") + "\n"
-					fmt.Println("Header: " + fileHeader)
-
-				case "ignore":
-					ignoring = parts[2] == "start"
-
-				case "oop":
-					asOOP = parts[2] == "start"
-
-				case "replace":
-					patterns = append(patterns, matchPattern{
-						pattern: regexp.MustCompile(parts[2]),
-						replace: parts[3],
-					})
-
-				case "enum":
-					//conv:enum:/int gamepad/g:GamepadNumber
-					enums = append(enums, matchEnum{
-						pattern: regexp.MustCompile(parts[2]),
-						enum:    parts[3],
-					})
-				}
+	for _, g := range conf.groups {
+
+		//Prepare the group and a bunch of variables to hold our succes and failed
+		fmt.Println("Processing Group", g.name)
+		fileHeader := defaultHeader + strings.Join(append(g.cgo, ""), "\n")
+		filenameSuccess := g.name + *fileSuffix + ".go"
+		filenameFailed := g.name + *fileSuffix + ".failed"
+		failed := make([]string, 0)
+		success := make([]string, 0)
+		patterns = g.patterns
+		enums = g.enums
+		errorVariants = g.errorVariants
+
+		for _, fn := range g.functions {
+			key := fn.key()
+			line := fn.line
+
+			//We are ignoring, so skip the function
+			if reason, ok := ignored(conf.ignores, key); ok {
+				report.skip(fn, statusSkipped, reason)
+				continue
+			}
+			if reason, ok := ignored(g.ignores, key); ok {
+				report.skip(fn, statusSkipped, reason)
+				continue
+			}
 
+			//Process the line
+			prototypeTally++
+			p, err := parseLine(line)
+			if err == nil && p == nil {
+				err = errors.New("not a prototype")
+			}
+			if err != nil {
+				//Failed to parse the file
+				fmt.Println("Failed: ", line)
+				failed = append(failed, "\n//"+err.Error()+"\n"+line)
+				report.set(fn, statusFailed, err.Error())
+				continue
 			}
 
-			//Skip because its a command line
-			continue
-		}
+			//Translate it. If we are successful then add it to our success list,
+			// otherwise add it to our fail list
+			asOOP := selects(g.oop, key)
+			check := ""
+			if *threadCheck && !selects(g.anyThread, key) {
+				check = "checkMainThread"
+				if selects(g.deferred, key) {
+					check = "checkUploadThread"
+				}
+			}
+			trans, terr := translatePrototype(p, asOOP, check)
+			if terr != nil {
+				fmt.Println("Failed: ", line)
+				failed = append(failed, "\n//"+terr.Error()+"\n"+line)
+				report.set(fn, statusFailed, terr.Error())
+				continue
+			}
 
-		//We are ignoring, so skip the lines
-		if ignoring {
-			continue
-		}
+			success = append(success, trans)
+			successTally++
 
-		//Process the line
-		p, err := parseLine(line)
+			status, note := statusGenerated, filenameSuccess
+			if _, err := os.Stat(*manualDir + p.name + ".go"); err == nil {
+				status, note = statusManual, *manualDir+p.name+".go"
+				if file, ok := pkg.functions[p.name]; ok {
+					status, note = statusGo, file
+				}
+			}
 
-		if err != nil {
-			//Failed to parse the file
-			fmt.Println("Failed: ", line)
-			failed = append(failed, "\n//"+err.Error()+"\n"+line)
-		} else {
-			//We parsed it, but comments return no errors and nil prototypes.
-			if p != nil {
-
-				//Add the prototype to our list of processed.
-				prototypes = append(prototypes, p)
-
-				//Translate it. If we are successful then add it to our success list,
-				// otherwise add it to our fail list
-				trans, terr := translatePrototype(p, asOOP)
-				if terr == nil {
-					success = append(success, trans)
-					successTally++
-				} else {
-					fmt.Println("Failed: ", line)
-					failed = append(failed, "\n//"+terr.Error()+"\n"+line)
-					failureTally++
+			//Add the error returning variant if its been asked for
+			for _, ev := range errorVariants {
+				if ev.pattern.MatchString(p.name) {
+					etrans, eerr := translateErrorVariant(p, asOOP, ev)
+					if eerr == nil {
+						success = append(success, etrans)
+						note += ", " + p.name + "E"
+					} else {
+						fmt.Println("Failed Error Variant: ", line)
+						failed = append(failed, "\n//"+eerr.Error()+"\n"+line)
+						note += ", " + p.name + "E failed: " + eerr.Error()
+					}
+					break
 				}
 			}
+			report.set(fn, status, note)
 		}
-	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+		//Write the group
+		failedResults := strings.Join(failed, "\n")
+		sucessResults := "package raylib\n/*\n" + fileHeader + "*/\nimport \"C\"\nimport \"unsafe\"\n" + strings.Join(success, "\n")
+		saveProgress(filenameFailed, filenameSuccess, sucessResults, failedResults)
 	}
 
-	//Write the old filename and recrate the values
-	failedResults := strings.Join(failed, "\n")
-	sucessResults := "package raylib\n/*\n" + fileHeader + "*/\nimport \"C\"\nimport \"unsafe\"\n" + strings.Join(success, "\n")
-	saveProgress(filenameFailed, filenameSuccess, sucessResults, failedResults)
+	if *coverageOutput != "" {
+		if err := report.write(*coverageOutput, headers); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	//Complete
-	fmt.Println("Completed ", successTally, " / ", len(prototypes), " functions (", (float64(successTally) / float64(len(prototypes)) * 100), "% Yield)")
+	fmt.Println("Completed ", successTally, " / ", prototypeTally, " functions (", (float64(successTally) / float64(prototypeTally) * 100), "% Yield)")
 }
 
 func saveProgress(filenameFailed string, filenameSuccess string, successResults string, failureResults string) {
@@ -230,12 +243,17 @@ func saveProgress(filenameFailed string, filenameSuccess string, successResults
 
 }
 
-func translatePrototype(prototype *prototype, objectOriented bool) (string, error) {
+//translatePrototype converts the prototype into Go. Unless check is empty, the functions that call C start with a call to it, ie: checkMainThread.
+func translatePrototype(prototype *prototype, objectOriented bool, check string) (string, error) {
 
 	//We have a manual definition, so use that instead
 	if _, err := os.Stat(*manualDir + prototype.name + ".go"); err == nil {
 		bt, fe := ioutil.ReadFile(*manualDir + prototype.name + ".go")
-		return "\n" + string(bt), fe
+		if fe != nil || check == "" {
+			return "\n" + string(bt), fe
+		}
+		guarded, ge := guardManual(string(bt), prototype.name, check)
+		return "\n" + guarded, ge
 	}
 
 	//We do not support return types really yet, but when we do we have a special case for pointers
@@ -358,6 +376,9 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 
 	//Finally combine the body
 	body = body + returnFooter
+	if check != "" {
+		body = threadCheckCall(check, prototype.name) + "\n" + body
+	}
 	oopName := prototype.name
 
 	//The definition is what we will write, including comment at the top
@@ -409,6 +430,108 @@ func translatePrototype(prototype *prototype, objectOriented bool) (string, erro
 	return definition, nil
 }
 
+//threadCheckCall is the call that starts a function, so debug builds panic when it is called from a thread it cannot be called from
+func threadCheckCall(check string, name string) string {
+	return fmt.Sprintf("%s(%q)", check, name)
+}
+
+//guardManual adds the thread check to the functions of a manual file that call C.
+// Functions that only call another Go function, like the functional version of an OOP method, are checked by the one they call.
+func guardManual(source string, name string, check string) (string, error) {
+	const prefix = "package raylib\n"
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name+".go", prefix+source, 0)
+	if err != nil {
+		return "", err
+	}
+
+	offsets := make([]int, 0)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		callsC := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok && x.Name == "C" {
+					callsC = true
+				}
+			}
+			return !callsC
+		})
+		if callsC {
+			offsets = append(offsets, fset.Position(fn.Body.Lbrace).Offset-len(prefix)+1)
+		}
+	}
+
+	//Insert from the end, so the earlier offsets stay the same
+	for i := len(offsets) - 1; i >= 0; i-- {
+		source = source[:offsets[i]] + "\n" + threadCheckCall(check, name) + source[offsets[i]:]
+	}
+	return source, nil
+}
+
+//translateErrorVariant creates the LoadXXXXE version of a function, which returns an error instead of an invalid object.
+// The files are checked by loadChecked before the original function is called.
+func translateErrorVariant(prototype *prototype, objectOriented bool, ev matchError) (string, error) {
+	if prototype.returnArg.valueType == "void" || prototype.returnArg.HasPointer() {
+		return "", errors.New("error variants need a return value")
+	}
+
+	argHeaders := make([]string, 0, len(prototype.args))
+	argNames := make([]string, 0, len(prototype.args))
+	fileNames := make([]string, 0, 1)
+	for _, arg := range prototype.args {
+		if arg == nil {
+			continue
+		}
+
+		if arg.GetPraticalPointerDepth() > 0 {
+			return "", errors.New("error variants cannot have pointer args")
+		}
+
+		spacing := " "
+		if isReferencedObject(arg.valueType) && objectOriented {
+			spacing = " *"
+		}
+
+		header := arg.name + spacing + convertType(arg.valueType, arg.unsigned)
+		if arg.enumType != "" {
+			header = arg.name + spacing + arg.enumType
+		}
+
+		argHeaders = append(argHeaders, header)
+		argNames = append(argNames, arg.name)
+		if arg.valueType == "char" {
+			fileNames = append(fileNames, arg.name)
+		}
+	}
+
+	if len(fileNames) == 0 {
+		return "", errors.New("error variants need a file name")
+	}
+
+	returnType := convertType(prototype.returnArg.valueType, prototype.returnArg.unsigned)
+	zero := returnType + "{}"
+	if isReferencedObject(prototype.returnArg.valueType) {
+		returnType = "*" + returnType
+		zero = "nil"
+	}
+
+	name := prototype.name + "E"
+	body := "var retval " + returnType + "\n"
+	body += fmt.Sprintf("err := loadChecked(%q, %q, %q, func() Unloadable {\nretval = %s(%s)\nreturn retval\n}, %s)\n", prototype.name, ev.formats, ev.failure, prototype.name, strings.Join(argNames, ", "), strings.Join(fileNames, ", "))
+	body += "if err != nil {\nreturn " + zero + ", err\n}\n"
+	body += "return retval, nil"
+
+	definition := "//" + name + " : " + prototype.comment + "\n"
+	definition += "//Returns an error instead of an invalid " + convertType(prototype.returnArg.valueType, prototype.returnArg.unsigned) + " if it cannot be loaded\n"
+	definition += fmt.Sprintf("func %s(%s) (%s, error) {\n %s \n}\n", name, strings.Join(argHeaders, ", "), returnType, body)
+	return definition, nil
+}
+
 //castType creates a cast for a type, returning first the name of the variable and then the definition of the variable.
 // There are some cases where there is no definition.
 func castToC(a argument) (string, string, bool) {
@@ -551,7 +674,7 @@ func parseLine(line string) (*prototype, error) {
 		return nil, nil
 	}
 
-	rePrototype := regexp.MustCompile(`(RAYGUIDEF|RLAPI) (const |unsigned )?([a-zA-Z0-9]+) (\**)([a-zA-Z0-9]+)\s?\(([^!@#$+%^]+?)\);\s*(\/\/(.*))?`)
+	rePrototype := regexp.MustCompile(`(RAYGUIDEF|PHYSACDEF|RLAPI) (const |unsigned )?([a-zA-Z0-9]+) (\**)([a-zA-Z0-9]+)\s?\(([^!@#$+%^]+?)\);\s*(\/\/(.*))?`)
 	reArgument := regexp.MustCompile(`(const |unsigned )?([a-zA-Z0-9]+) (\**)([a-zA-Z0-9]+)`)
 
 	matches := rePrototype.FindAllStringSubmatch(line, -1)
@@ -596,7 +719,7 @@ func parseLine(line string) (*prototype, error) {
 		}
 
 		name := matches[0][4]
-		if name == "type" || name == "interface" || name == "return" {
+		if name == "return" || token.IsKeyword(name) {
 			name = "g" + name
 		}
 
@@ -0,0 +1,9 @@
+//ChangeDirectory : Change working directory
+func ChangeDirectory(dir string) error {
+	cdir := C.CString(dir)
+	defer C.free(unsafe.Pointer(cdir))
+	if !bool(C.ChangeDirectory(cdir)) {
+		return fmt.Errorf("failed to change directory to %s", dir)
+	}
+	return nil
+}
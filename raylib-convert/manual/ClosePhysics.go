@@ -0,0 +1,6 @@
+//ClosePhysics : Unitializes physics pointers and closes physics loop thread
+func ClosePhysics() {
+	unregisterPhysicsBodies()
+	physicsAccumulator = 0
+	C.ClosePhysics()
+}
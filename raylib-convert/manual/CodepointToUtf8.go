@@ -0,0 +1,8 @@
+//CodepointToUtf8 : Encode codepoint into utf8 text
+func CodepointToUtf8(codepoint rune) string {
+	cbyteLength := C.int(0)
+	res := C.CodepointToUtf8(C.int(codepoint), &cbyteLength)
+
+	//The static buffer is not cleared between calls, so we cannot rely on the null terminator
+	return C.GoStringN(res, cbyteLength)
+}
@@ -0,0 +1,16 @@
+//CompressData : Compress data (DEFLATE algorythm)
+func CompressData(data []byte) ([]byte, error) {
+	var cdata *C.uchar
+	if len(data) > 0 {
+		cdata = (*C.uchar)(unsafe.Pointer(&data[0]))
+	}
+
+	ccompDataLength := C.int(0)
+	res := C.CompressData(cdata, C.int(len(data)), &ccompDataLength)
+	if res == nil {
+		return nil, errors.New("failed to compress data")
+	}
+	defer C.free(unsafe.Pointer(res))
+
+	return C.GoBytes(unsafe.Pointer(res), ccompDataLength), nil
+}
@@ -0,0 +1,15 @@
+//DecompressData : Decompress data (DEFLATE algorythm)
+func DecompressData(compData []byte) ([]byte, error) {
+	if len(compData) == 0 {
+		return nil, errors.New("failed to decompress data: no data")
+	}
+
+	cdataLength := C.int(0)
+	res := C.DecompressData((*C.uchar)(unsafe.Pointer(&compData[0])), C.int(len(compData)), &cdataLength)
+	if res == nil {
+		return nil, errors.New("failed to decompress data: invalid or corrupt stream")
+	}
+	defer C.free(unsafe.Pointer(res))
+
+	return C.GoBytes(unsafe.Pointer(res), cdataLength), nil
+}
@@ -0,0 +1,12 @@
+//Unload : Unitializes and destroy a physics body
+func (body *PhysicsBody) Unload() {
+	cbody := *body.cptr()
+	C.DestroyPhysicsBody(cbody)
+	UnregisterUnloadable(body)
+}
+
+//DestroyPhysicsBody : Unitializes and destroy a physics body
+//Recommended to use body.Unload() instead
+func DestroyPhysicsBody(body *PhysicsBody) {
+	body.Unload()
+}
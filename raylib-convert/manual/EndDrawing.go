@@ -0,0 +1,6 @@
+//EndDrawing : End canvas drawing and swap buffers (double buffering)
+//The functions queued by Do and DoAsync are run once the frame has been drawn
+func EndDrawing() {
+	C.EndDrawing()
+	RunMainThreadQueue()
+}
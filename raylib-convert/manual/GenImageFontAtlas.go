@@ -0,0 +1,24 @@
+//GenImageFontAtlas : Generate image font atlas using chars info, and the rectangle of every char in it. The atlas is GrayAlpha, with white pixels and the chars in the alpha.
+// The images of the chars must be Grayscale, like LoadFontData gives. The pack method is 0 for the default packing and 1 for skyline packing.
+// An empty chars gives an empty image.
+func GenImageFontAtlas(chars []CharInfo, fontSize int, padding int, packMethod int) (*Image, []Rectangle) {
+	if len(chars) == 0 {
+		TraceLog(LogWarning, "[FONT] Cannot generate a font atlas without chars")
+		image := &Image{}
+		RegisterUnloadable(image)
+		return image, nil
+	}
+
+	var crecs *C.Rectangle
+	cchars := (*C.CharInfo)(unsafe.Pointer(&chars[0]))
+	res := C.GenImageFontAtlas(cchars, &crecs, C.int(int32(len(chars))), C.int(int32(fontSize)), C.int(int32(padding)), C.int(int32(packMethod)))
+	defer C.free(unsafe.Pointer(crecs))
+
+	retval := newImageFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+
+	//Copy the rectangles, the C array is freed
+	recs := make([]Rectangle, len(chars))
+	copy(recs, (*[1 << 24]Rectangle)(unsafe.Pointer(crecs))[:len(chars):len(chars)])
+	return retval, recs
+}
@@ -0,0 +1,15 @@
+//GetCodepoints : Get all codepoints in a string
+// The text is decoded the same way DrawTextEx and MeasureTextEx decode it, where every invalid byte becomes a '?'.
+// Unlike raylib's GetCodepoints, this does not skip bytes after a truncated sequence and is not limited to 512 codepoints.
+func GetCodepoints(text string) []rune {
+	codepoints := make([]rune, 0, len(text))
+	for i, r := range text {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(text[i:]); size == 1 {
+				r = '?'
+			}
+		}
+		codepoints = append(codepoints, r)
+	}
+	return codepoints
+}
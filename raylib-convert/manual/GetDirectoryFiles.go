@@ -0,0 +1,15 @@
+//GetDirectoryFiles : Get filenames in a directory path.
+// The directory is read by Go, so there is no limit on the number of entries and no need for ClearDirectoryFiles.
+// The names are sorted, and unlike raylib the "." and ".." entries are not included.
+func GetDirectoryFiles(dirPath string) ([]string, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	return names, nil
+}
\ No newline at end of file
@@ -0,0 +1,10 @@
+//GetFileModTime : Get file modification time (last write time)
+func GetFileModTime(fileName string) (time.Time, error) {
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+	res := C.GetFileModTime(cfileName)
+	if res == 0 {
+		return time.Time{}, fmt.Errorf("failed to get the modification time of %s", fileName)
+	}
+	return time.Unix(int64(res), 0), nil
+}
@@ -4,24 +4,8 @@ func GetImageDataNormalized(image *Image) []Vector4 {
 	return image.GetPixelsNormalized()
 }
 
-//GetPixelsNormalized returns the pixel data from an image as a colour slice.
+//GetPixelsNormalized returns a copy of the pixel data from an image as normalized colours [0..1]. Returns nil for compressed images.
+//Float formats are not clamped, use Pixels[Vector4] to read R32G32B32A32 images without copying.
 func (image *Image) GetPixelsNormalized() []Vector4 {
-	//Prepare the pointer and get the data
-	cimg := image.cptr()
-	res := C.GetImageDataNormalized(*cimg)
-	defer C.free(unsafe.Pointer(res))
-
-	//Calculate the samples
-	samples := image.Width*image.Height
-	
-	//Get the slice
-	tmpslice := (*[1 << 24]*C.Vector4)(unsafe.Pointer(res))[:samples:samples]
-
-	//Convert to a Color array
-	goslice := make([]Vector4, samples)
-	for i, s := range tmpslice {
-		goslice[i] = newVector4FromPointer(unsafe.Pointer(s))
-	}
-
-	return goslice
+	return PixelsAs[Vector4](image)
 }
\ No newline at end of file
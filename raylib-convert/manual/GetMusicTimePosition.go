@@ -0,0 +1,13 @@
+//Position : Get current music position (in seconds), of the audio being heard.
+//Unlike GetTimePlayed it does not count the audio that has been queued by UpdateStream but not played yet.
+func (music *Music) Position() float32 {
+	cmusic := *music.cptr()
+	res := C.GetMusicTimePosition(cmusic)
+	return float32(res)
+}
+
+//GetMusicTimePosition : Get current music position (in seconds), of the audio being heard
+//Recommended to use music.Position() instead
+func GetMusicTimePosition(music *Music) float32 {
+	return music.Position()
+}
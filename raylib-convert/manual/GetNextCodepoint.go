@@ -0,0 +1,9 @@
+//GetNextCodepoint : Returns next codepoint in a UTF8 encoded string and the amount of bytes it used; 0x3f('?') is returned on failure
+func GetNextCodepoint(text string) (rune, int) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	cbytesProcessed := C.int(0)
+	res := C.GetNextCodepoint(ctext, &cbytesProcessed)
+	return rune(res), int(int32(cbytesProcessed))
+}
@@ -0,0 +1,12 @@
+//GetShapeVertex : Returns transformed position of a body shape (body position + vertex transformed position)
+func (body *PhysicsBody) GetShapeVertex(vertex int) Vector2 {
+	cbody := *body.cptr()
+	res := C.GetPhysicsShapeVertex(cbody, C.int(int32(vertex)))
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}
+
+//GetPhysicsShapeVertex : Returns transformed position of a body shape (body position + vertex transformed position)
+//Recommended to use body.GetShapeVertex(vertex) instead
+func GetPhysicsShapeVertex(body *PhysicsBody, vertex int) Vector2 {
+	return body.GetShapeVertex(vertex)
+}
@@ -0,0 +1,24 @@
+//LoadMeshes : Load meshes from model file (OBJ, IQM, GLTF), without their materials. Every mesh has to be unloaded.
+// Unlike LoadModel, there is no default cube mesh if the file has none.
+func LoadMeshes(fileName string) []*Mesh {
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+
+	cmeshCount := C.int(0)
+	res := C.LoadMeshes(cfileName, &cmeshCount)
+	defer C.free(unsafe.Pointer(res))
+
+	//Get the slice
+	count := int(cmeshCount)
+	tmpslice := (*[1 << 24]C.Mesh)(unsafe.Pointer(res))[:count:count]
+
+	//Copy the meshes out of the array, which is freed. Their data stays in C memory.
+	goslice := make([]*Mesh, count)
+	for i := range tmpslice {
+		mesh := *newMeshFromPointer(unsafe.Pointer(&tmpslice[i]))
+		goslice[i] = &mesh
+		RegisterUnloadable(goslice[i])
+	}
+
+	return goslice
+}
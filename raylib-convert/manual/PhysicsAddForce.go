@@ -0,0 +1,12 @@
+//AddForce : Adds a force to a physics body
+func (body *PhysicsBody) AddForce(force Vector2) {
+	cforce := *force.cptr()
+	cbody := *body.cptr()
+	C.PhysicsAddForce(cbody, cforce)
+}
+
+//PhysicsAddForce : Adds a force to a physics body
+//Recommended to use body.AddForce(force) instead
+func PhysicsAddForce(body *PhysicsBody, force Vector2) {
+	body.AddForce(force)
+}
@@ -0,0 +1,11 @@
+//AddTorque : Adds an angular force to a physics body
+func (body *PhysicsBody) AddTorque(amount float32) {
+	cbody := *body.cptr()
+	C.PhysicsAddTorque(cbody, C.float(amount))
+}
+
+//PhysicsAddTorque : Adds an angular force to a physics body
+//Recommended to use body.AddTorque(amount) instead
+func PhysicsAddTorque(body *PhysicsBody, amount float32) {
+	body.AddTorque(amount)
+}
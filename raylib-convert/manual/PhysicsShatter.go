@@ -0,0 +1,27 @@
+//Shatter : Shatters a polygon shape physics body to little physics bodies with explosion force.
+// If the body shatters it is destroyed and the new bodies are registered as unloadables in its place.
+func (body *PhysicsBody) Shatter(position Vector2, force float32) {
+	cposition := *position.cptr()
+	cbody := *body.cptr()
+
+	before := GetPhysicsBodiesCount()
+	C.PhysicsShatter(cbody, cposition, C.float(force))
+	after := GetPhysicsBodiesCount()
+
+	//Nothing changed, so the position was not inside the body
+	if before == after {
+		return
+	}
+
+	//The body has been destroyed and the pieces have been appended to the end of the pool
+	UnregisterUnloadable(body)
+	for i := before - 1; i < after; i++ {
+		RegisterUnloadable(GetPhysicsBody(i))
+	}
+}
+
+//PhysicsShatter : Shatters a polygon shape physics body to little physics bodies with explosion force
+//Recommended to use body.Shatter(position, force) instead
+func PhysicsShatter(body *PhysicsBody, position Vector2, force float32) {
+	body.Shatter(position, force)
+}
@@ -0,0 +1,6 @@
+//ResetPhysics : Destroys created physics bodies and manifolds and resets global values
+func ResetPhysics() {
+	unregisterPhysicsBodies()
+	physicsAccumulator = 0
+	C.ResetPhysics()
+}
@@ -0,0 +1,12 @@
+//Seek : Seek music to a position (in seconds). The audio already queued is dropped, so the new position is heard after the next UpdateStream.
+//Seeking an XM or MOD module plays it from the start up to the position without being heard, so it is slower the further in the position is.
+func (music *Music) Seek(position float32) {
+	cmusic := *music.cptr()
+	C.SeekMusicStream(cmusic, C.float(position))
+}
+
+//SeekMusicStream : Seek music to a position (in seconds)
+//Recommended to use music.Seek(position) instead
+func SeekMusicStream(music *Music, position float32) {
+	music.Seek(position)
+}
@@ -0,0 +1,5 @@
+//SetPhysicsTimeStep : Sets physics fixed time step in milliseconds. 1.666666 by default
+func SetPhysicsTimeStep(delta float64) {
+	physicsTimeStep = delta
+	C.SetPhysicsTimeStep(C.double(delta))
+}
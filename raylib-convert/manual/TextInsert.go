@@ -0,0 +1,10 @@
+//TextInsert : Insert text in a position
+// This is done in Go, as raylib's TextInsert copies the wrong parts of the text and insert.
+func TextInsert(text string, insert string, position int) string {
+	if position < 0 {
+		position = 0
+	} else if position > len(text) {
+		position = len(text)
+	}
+	return text[:position] + insert + text[position:]
+}
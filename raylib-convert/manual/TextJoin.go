@@ -0,0 +1,5 @@
+//TextJoin : Join text strings with delimiter
+// This is done in Go, as raylib's TextJoin drops the strings that do not fit in its 1024 byte buffer.
+func TextJoin(textList []string, delimiter string) string {
+	return strings.Join(textList, delimiter)
+}
\ No newline at end of file
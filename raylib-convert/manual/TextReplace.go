@@ -0,0 +1,16 @@
+//TextReplace : Replace text string. The text is returned unchanged if replace is empty.
+func TextReplace(text string, replace string, by string) string {
+	cby := C.CString(by)
+	defer C.free(unsafe.Pointer(cby))
+	creplace := C.CString(replace)
+	defer C.free(unsafe.Pointer(creplace))
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	res := C.TextReplace(ctext, creplace, cby)
+	if res == nil {
+		return text
+	}
+	defer C.free(unsafe.Pointer(res))
+	return C.GoString(res)
+}
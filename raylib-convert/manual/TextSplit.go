@@ -0,0 +1,5 @@
+//TextSplit : Split text into multiple strings
+// This is done in Go, as raylib's TextSplit only splits the first 1024 bytes into at most 128 strings.
+func TextSplit(text string, delimiter byte) []string {
+	return strings.Split(text, string([]byte{delimiter}))
+}
\ No newline at end of file
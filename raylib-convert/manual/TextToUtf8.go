@@ -0,0 +1,10 @@
+//TextToUtf8 : Encode text codepoint into utf8 text
+func TextToUtf8(codepoints []rune) string {
+	if len(codepoints) == 0 {
+		return ""
+	}
+
+	res := C.TextToUtf8((*C.int)(unsafe.Pointer(&codepoints[0])), C.int(len(codepoints)))
+	defer C.free(unsafe.Pointer(res))
+	return C.GoString(res)
+}
@@ -0,0 +1,16 @@
+//Crop : Crop a wave to defined samples range
+func (wave *Wave) Crop(initSample int, finalSample int) {
+	cwave := wave.cptr()
+	C.WaveCrop(cwave, C.int(int32(initSample)), C.int(int32(finalSample)))
+
+	//raylib crops the data but does not change the sample count
+	if initSample >= 0 && initSample < finalSample && finalSample > 0 && uint32(finalSample) < wave.SampleCount {
+		wave.SampleCount = uint32(finalSample - initSample)
+	}
+}
+
+//WaveCrop : Crop a wave to defined samples range
+//Recommended to use wave.Crop(initSample, finalSample) instead
+func WaveCrop(wave *Wave, initSample int, finalSample int) {
+	wave.Crop(initSample, finalSample)
+}
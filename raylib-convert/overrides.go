@@ -0,0 +1,255 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+//overrides is the headers.txt file. The functions come from the C headers, this only says how they are grouped and converted.
+type overrides struct {
+	headers []string
+	ignores []matchIgnore
+	groups  []*group
+}
+
+//group is a single generated file and the directives that apply to its functions
+type group struct {
+	name          string
+	cgo           []string
+	sections      []matchSelector
+	oop           []matchSelector
+	anyThread     []matchSelector
+	deferred      []matchSelector
+	ignores       []matchIgnore
+	patterns      []matchPattern
+	enums         []matchEnum
+	errorVariants []matchError
+	prototypes    []string //Prototypes written in the group, which replace the header ones with the same name
+	functions     []*cFunction
+}
+
+//matchSelector matches the key of a function. Negated selectors remove functions that a previous selector matched.
+type matchSelector struct {
+	pattern *regexp.Regexp
+	negate  bool
+}
+
+type matchIgnore struct {
+	pattern *regexp.Regexp
+	reason  string
+}
+
+//selects returns true if any selector matches the key and no negated one does
+func selects(selectors []matchSelector, key string) bool {
+	selected := false
+	for _, s := range selectors {
+		if s.pattern.MatchString(key) {
+			if s.negate {
+				return false
+			}
+			selected = true
+		}
+	}
+	return selected
+}
+
+//ignored returns the reason the function is ignored, and if it is
+func ignored(ignores []matchIgnore, key string) (string, bool) {
+	for _, i := range ignores {
+		if i.pattern.MatchString(key) {
+			return i.reason, true
+		}
+	}
+	return "", false
+}
+
+//readOverrides reads the headers.txt file
+func readOverrides(path string) (*overrides, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	result := &overrides{}
+	var current *group
+
+	lineNumber := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "RLAPI") || strings.HasPrefix(line, "RAYGUIDEF") || strings.HasPrefix(line, "PHYSACDEF") {
+			if current == nil {
+				return nil, fmt.Errorf("%s:%d: prototypes must be in a group", path, lineNumber)
+			}
+			if _, err := newFunction(line, ""); err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, lineNumber, err)
+			}
+			current.prototypes = append(current.prototypes, line)
+			continue
+		}
+
+		if !strings.HasPrefix(line, "//conv:") {
+			continue
+		}
+
+		parts := strings.Split(line, ":")
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("%s:%d: directive is missing its value", path, lineNumber)
+		}
+
+		command := parts[1]
+		if current == nil && command != "header" && command != "ignore" && command != "g" {
+			return nil, fmt.Errorf("%s:%d: %s must be in a group", path, lineNumber, command)
+		}
+
+		switch command {
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown directive %s", path, lineNumber, command)
+
+		case "header":
+			//conv:header:raylib.h
+			result.headers = append(result.headers, parts[2])
+
+		case "g":
+			//conv:g:texture
+			current = &group{name: parts[2]}
+			result.groups = append(result.groups, current)
+
+		case "cgo":
+			current.cgo = append(current.cgo, strings.Join(parts[2:], ":"))
+
+		case "section", "oop", "anythread", "deferred":
+			//conv:oop:Image manipulation
+			//conv:oop:!ImageText
+			selector, err := newSelector(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, lineNumber, err)
+			}
+			switch command {
+			case "section":
+				current.sections = append(current.sections, selector)
+			case "oop":
+				current.oop = append(current.oop, selector)
+			case "deferred":
+				current.deferred = append(current.deferred, selector)
+			default:
+				current.anyThread = append(current.anyThread, selector)
+			}
+
+		case "ignore":
+			//conv:ignore:TextFormat$:implemented in text.go
+			pattern, err := regexp.Compile(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, lineNumber, err)
+			}
+			ignore := matchIgnore{pattern: pattern, reason: strings.Join(parts[3:], ":")}
+			if current == nil {
+				result.ignores = append(result.ignores, ignore)
+			} else {
+				current.ignores = append(current.ignores, ignore)
+			}
+
+		case "replace":
+			current.patterns = append(current.patterns, matchPattern{
+				pattern: regexp.MustCompile(parts[2]),
+				replace: parts[3],
+			})
+
+		case "enum":
+			//conv:enum:/int gamepad/g:GamepadNumber
+			current.enums = append(current.enums, matchEnum{
+				pattern: regexp.MustCompile(parts[2]),
+				enum:    parts[3],
+			})
+
+		case "error":
+			//conv:error:^LoadTexture$:.png;.gif:optional warning that means it failed
+			ev := matchError{
+				pattern: regexp.MustCompile(parts[2]),
+				formats: parts[3],
+			}
+			if len(parts) > 4 {
+				ev.failure = strings.Join(parts[4:], ":")
+			}
+			current.errorVariants = append(current.errorVariants, ev)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func newSelector(value string) (matchSelector, error) {
+	negate := strings.HasPrefix(value, "!")
+	pattern, err := regexp.Compile(strings.TrimPrefix(value, "!"))
+	if err != nil {
+		return matchSelector{}, err
+	}
+	return matchSelector{pattern: pattern, negate: negate}, nil
+}
+
+//assign puts every function of the headers into the first group that selects it, in the order of the headers.
+// Prototypes in a group replace the header prototype and move the function into that group, or are added to the group if no header has them.
+// Returns the functions that are not in any group.
+func (o *overrides) assign(headers []*cHeader) []*cFunction {
+	written := make(map[string]*group)
+	lines := make(map[string]string)
+	for _, g := range o.groups {
+		for _, line := range g.prototypes {
+			if fn, err := newFunction(line, ""); err == nil {
+				written[fn.name] = g
+				lines[fn.name] = line
+			}
+		}
+	}
+
+	found := make(map[string]bool)
+	unassigned := make([]*cFunction, 0)
+	for _, header := range headers {
+		for _, fn := range header.functions {
+			found[fn.name] = true
+			if line, ok := lines[fn.name]; ok {
+				fn.line = line
+				written[fn.name].functions = append(written[fn.name].functions, fn)
+				continue
+			}
+
+			assigned := false
+			for _, g := range o.groups {
+				if selects(g.sections, fn.key()) {
+					g.functions = append(g.functions, fn)
+					assigned = true
+					break
+				}
+			}
+			if !assigned {
+				unassigned = append(unassigned, fn)
+			}
+		}
+	}
+
+	//Prototypes that are not in the headers, ie: helpers defined in the cgo preamble
+	for _, g := range o.groups {
+		for _, line := range g.prototypes {
+			fn, err := newFunction(line, "")
+			if err != nil || found[fn.name] {
+				continue
+			}
+			found[fn.name] = true
+			fn.header = *input
+			fn.module = g.name
+			fn.line = line
+			g.functions = append(g.functions, fn)
+		}
+	}
+
+	return unassigned
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	r "github.com/lachee/raylib-goplus/raylib"
+)
+
+func main() {
+	screenWidth := 800
+	screenHeight := 450
+
+	r.SetConfigFlags(r.FlagMsaa4xHint)
+	r.InitWindow(screenWidth, screenHeight, "Raylib Go Plus - Physics")
+	defer r.CloseWindow()
+
+	//Physac is built without its own thread, so we step it ourselves in the loop below.
+	r.InitPhysics()
+	defer r.ClosePhysics()
+
+	//The floor and an obstacle in the middle are static
+	floor := r.CreatePhysicsBodyRectangle(r.NewVector2(float32(screenWidth)/2, float32(screenHeight)), 500, 100, 10)
+	floor.Enabled = false
+
+	obstacle := r.CreatePhysicsBodyCircle(r.NewVector2(float32(screenWidth)/2, float32(screenHeight)/2), 45, 10)
+	obstacle.Enabled = false
+
+	r.SetTargetFPS(60)
+	for !r.WindowShouldClose() {
+
+		//Advance the simulation by exactly the frame time. Passing a constant here makes it fully deterministic.
+		r.UpdatePhysics(r.GetFrameTime())
+
+		if r.IsMouseButtonPressed(r.MouseLeftButton) {
+			r.CreatePhysicsBodyPolygon(r.GetMousePosition(), float32(r.GetRandomValue(20, 80)), r.GetRandomValue(3, 8), 10)
+		} else if r.IsMouseButtonPressed(r.MouseRightButton) {
+			r.CreatePhysicsBodyCircle(r.GetMousePosition(), float32(r.GetRandomValue(10, 45)), 10)
+		}
+
+		//Destroy the bodies that have fallen off the screen
+		for i := r.GetPhysicsBodiesCount() - 1; i >= 0; i-- {
+			body := r.GetPhysicsBody(i)
+			if body != nil && body.Position.Y > float32(screenHeight)*2 {
+				body.Unload()
+			}
+		}
+
+		r.BeginDrawing()
+		r.ClearBackground(r.Black)
+
+		for i := 0; i < r.GetPhysicsBodiesCount(); i++ {
+			body := r.GetPhysicsBody(i)
+			if body == nil {
+				continue
+			}
+
+			vertexCount := r.GetPhysicsShapeVerticesCount(i)
+			for j := 0; j < vertexCount; j++ {
+				vertexA := body.GetShapeVertex(j)
+				vertexB := body.GetShapeVertex((j + 1) % vertexCount)
+				r.DrawLineV(vertexA, vertexB, r.Green)
+			}
+		}
+
+		r.DrawText("Left or right click to spawn bodies", 10, 10, 10, r.White)
+		r.DrawFPS(screenWidth-90, screenHeight-30)
+		r.EndDrawing()
+	}
+}
@@ -2,13 +2,14 @@ package main
 
 import (
 	"math"
+	"sync"
 
 	r "github.com/lachee/raylib-goplus/raylib"
 )
 
 const (
-	MaxSamples          = 22050
-	MaxSamplesPerUpdate = 4096
+	SampleRate = 22050
+	MaxSamples = 22050
 )
 
 func main() {
@@ -21,56 +22,52 @@ func main() {
 	defer r.CloseWindow()
 	defer r.UnloadAll()
 
-	stream := r.InitAudioStream(22050, 32, 1)
-	defer stream.Unload()
-
+	//The frequency is changed by the slider and read by the player's goroutine
+	var mutex sync.Mutex
 	frequency := float32(1)
-	oldFrequency := float32(0)
-
-	samplesLeft := MaxSamples
-	data := [MaxSamples]float32{}
+	phase := float64(0)
 
-	stream.Play()
+	player, err := r.NewStreamPlayerFunc(SampleRate, 1, func(samples []float32) {
+		mutex.Lock()
+		step := float64(frequency * r.PI * r.Deg2Rad)
+		mutex.Unlock()
 
-	r.SetTargetFPS(30)
-	for !r.WindowShouldClose() {
-
-		if frequency != oldFrequency {
-			for i := 0; i < MaxSamples; i++ {
-				data[i] = float32(math.Sin(float64(frequency * ((2 * r.PI * float32(i)) / 2) * r.Deg2Rad)))
-			}
-			oldFrequency = frequency
+		for i := range samples {
+			samples[i] = float32(math.Sin(phase))
+			phase = math.Mod(phase+step, 2*math.Pi)
 		}
+	})
+	if err != nil {
+		r.TraceError("Failed to create the stream player: ", err)
+		return
+	}
+	defer player.Unload()
 
-		if r.IsAudioStreamProcessed(stream) {
-
-			samples := samplesLeft
-			if samplesLeft >= MaxSamplesPerUpdate {
-				samples = MaxSamplesPerUpdate
-			}
-
-			//r.UpdateAudioStream(stream, data, samplesCount)
-			stream.Update(data[MaxSamples-samplesLeft:], samples)
-
-			samplesLeft -= samples
-			if samplesLeft <= 0 {
-				samplesLeft = MaxSamples
-			}
+	player.Play()
 
-		}
+	r.SetTargetFPS(30)
+	for !r.WindowShouldClose() {
 
 		r.BeginDrawing()
 
 		r.ClearBackground(r.RayWhite)
 		r.DrawText("Playing a Sine Wave", 240, 140, 20, r.LightGray)
+
+		mutex.Lock()
 		frequency = r.GuiSlider(r.NewRectangle(150, 25, 200, 25), "0", "100", frequency, 1, 1.1)
+		current := frequency
+		mutex.Unlock()
 
 		// NOTE: Draw a part of the sine wave (only screen width)
-		for i := 0; i < MaxSamples; i++ {
-			position := r.NewVector2(float32(i), 250+50*data[i])
+		for i := 0; i < screenWidth; i++ {
+			sample := float32(math.Sin(float64(current * ((2 * r.PI * float32(i)) / 2) * r.Deg2Rad)))
+			position := r.NewVector2(float32(i), 250+50*sample)
 			r.DrawPixelV(position, r.GopherBlue)
 		}
 
+		stats := player.Stats()
+		r.DrawText(r.TextFormat("Buffers: %d Underruns: %d", stats.Buffers, stats.Underruns), 10, 420, 10, r.Gray)
+
 		r.EndDrawing()
 
 	}
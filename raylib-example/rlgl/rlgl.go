@@ -0,0 +1,79 @@
+package main
+
+import (
+	"math"
+
+	r "github.com/lachee/raylib-goplus/raylib"
+	"github.com/lachee/raylib-goplus/raylib/rlgl"
+)
+
+const trailLength = 64
+
+func main() {
+	screenWidth := 800
+	screenHeight := 450
+
+	r.InitWindow(screenWidth, screenHeight, "Raylib Go Plus - rlgl Immediate Mode")
+
+	camera := r.NewCamera(r.NewVector3(0.0, 8.0, 12.0), r.NewVector3(0.0, 0.0, 0.0), r.NewVector3(0.0, 1.0, 0.0), 45, r.CameraTypePerspective)
+	r.SetTargetFPS(60)
+
+	trail := make([]r.Vector3, 0, trailLength)
+	angle := 0.0
+
+	for !r.WindowShouldClose() {
+		//Move the head of the trail along a wobbly circle
+		angle += float64(r.GetFrameTime()) * 2
+		head := r.NewVector3(float32(math.Cos(angle)*4), float32(math.Sin(angle*3)+1.5), float32(math.Sin(angle)*4))
+		if len(trail) == trailLength {
+			trail = trail[1:]
+		}
+		trail = append(trail, head)
+
+		r.BeginDrawing()
+		r.ClearBackground(r.RayWhite)
+		r.BeginMode3D(camera)
+
+		r.DrawGrid(10, 1.0)
+		drawTrail(trail)
+
+		//A spinning quad, transformed with the matrix stack
+		rlgl.PushMatrix()
+		rlgl.Translatef(0, 0.01, 0)
+		rlgl.Rotatef(float32(angle)*r.Rad2Deg, 0, 1, 0)
+		rlgl.Begin(rlgl.Quads)
+		rlgl.SetColor(r.SkyBlue)
+		rlgl.Vertex3f(-1, 0, -1)
+		rlgl.Vertex3f(-1, 0, 1)
+		rlgl.Vertex3f(1, 0, 1)
+		rlgl.Vertex3f(1, 0, -1)
+		rlgl.End()
+		rlgl.PopMatrix()
+
+		r.EndMode3D()
+		r.DrawFPS(10, 10)
+		r.EndDrawing()
+	}
+
+	r.CloseWindow()
+}
+
+//drawTrail draws the points as a line strip that fades out towards the tail
+func drawTrail(trail []r.Vector3) {
+	if len(trail) < 2 {
+		return
+	}
+
+	if rlgl.CheckBufferLimit(len(trail) * 2) {
+		rlgl.Draw()
+	}
+
+	rlgl.Begin(rlgl.Lines)
+	for i := 1; i < len(trail); i++ {
+		rlgl.SetColor(r.Fade(r.Red, float32(i-1)/float32(len(trail))))
+		rlgl.Vertex3(trail[i-1])
+		rlgl.SetColor(r.Fade(r.Red, float32(i)/float32(len(trail))))
+		rlgl.Vertex3(trail[i])
+	}
+	rlgl.End()
+}
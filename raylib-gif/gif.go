@@ -1,12 +1,24 @@
 package rgif
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"image/gif"
+	"io"
+	"io/fs"
+	"math"
 	"os"
 
 	r "github.com/lachee/raylib-goplus/raylib"
 )
 
+//MaxAtlasSize is the largest width or height ToAtlas makes a texture. Most GPUs support 4096, but larger atlases may fail to upload.
+var MaxAtlasSize = 4096
+
+//ErrAtlasTooLarge is returned by ToAtlas when the frames do not fit in a MaxAtlasSize texture
+var ErrAtlasTooLarge = errors.New("rgif: the frames do not fit in the atlas")
+
 type FrameDisposal int
 
 const (
@@ -31,6 +43,8 @@ type GifImage struct {
 	Timing []int
 	//Disposal is the disposal for each frame
 	Disposal []FrameDisposal
+	//LoopCount is how many times the gif loops. 0 loops forever, and -1 plays once.
+	LoopCount int
 
 	pixels        [][]r.Color //Cache of each frame's pixels
 	currentFrame  int         //The current frame
@@ -46,6 +60,26 @@ func LoadGifFromFile(fileName string) (*GifImage, error) {
 	if err != nil {
 		return nil, err
 	}
+	return LoadGifFromReader(file)
+}
+
+//LoadGifFromFS loads a new gif from the file system, ie: an embed.FS
+func LoadGifFromFS(fsys fs.FS, name string) (*GifImage, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadGifFromReader(file)
+}
+
+//LoadGifFromMemory loads a new gif from the data of a .gif file
+func LoadGifFromMemory(data []byte) (*GifImage, error) {
+	return LoadGifFromReader(bytes.NewReader(data))
+}
+
+//LoadGifFromReader loads a new gif, decoding it from the reader
+func LoadGifFromReader(reader io.Reader) (*GifImage, error) {
 
 	/*//Defer any panics
 	defer func() {
@@ -55,7 +89,7 @@ func LoadGifFromFile(fileName string) (*GifImage, error) {
 	}()*/
 
 	//Decode teh gif
-	gif, err := gif.DecodeAll(file)
+	gif, err := gif.DecodeAll(reader)
 	if err != nil {
 		return nil, err
 	}
@@ -124,13 +158,14 @@ func LoadGifFromFile(fileName string) (*GifImage, error) {
 	texture := r.LoadTextureFromGo(gif.Image[0])
 
 	return &GifImage{
-		Texture:  texture,
-		pixels:   images,
-		Width:    imgWidth,
-		Height:   imgHeight,
-		Frames:   frames,
-		Timing:   gif.Delay,
-		Disposal: disposals,
+		Texture:   texture,
+		pixels:    images,
+		Width:     imgWidth,
+		Height:    imgHeight,
+		Frames:    frames,
+		Timing:    gif.Delay,
+		Disposal:  disposals,
+		LoopCount: gif.LoopCount,
 	}, nil
 }
 
@@ -178,6 +213,88 @@ func (gif *GifImage) GetRectangle(frame int) r.Rectangle {
 	return r.NewRectangle(float32(gif.Width*frame), 0, float32(gif.Width), float32(gif.Height))
 }
 
+//ToAtlas packs every frame into a single texture, in a grid, and creates a clip named "gif" that plays them.
+// Gifs that play once get an AnimationOnce clip, gifs with a loop count repeat that many times, and the rest loop forever.
+// Frames without a delay are shown for 0.1 seconds, like browsers do.
+// The grid is kept within MaxAtlasSize, and ErrAtlasTooLarge is returned if the frames do not fit.
+// The atlas is a new texture, so the gif can be unloaded afterwards.
+func (gif *GifImage) ToAtlas() (r.Texture2D, *r.AnimationClip, error) {
+	columns, rows, err := atlasGrid(gif.Frames, gif.Width, gif.Height, MaxAtlasSize)
+	if err != nil {
+		return r.Texture2D{}, nil, err
+	}
+	atlasWidth := columns * gif.Width
+
+	clip := &r.AnimationClip{Name: "gif", Frames: make([]r.AnimationFrame, gif.Frames)}
+	if gif.LoopCount < 0 {
+		clip.Mode = r.AnimationOnce
+	} else {
+		clip.Loops = gif.LoopCount
+	}
+
+	pixels := make([]r.Color, atlasWidth*rows*gif.Height)
+	for i, frame := range gif.pixels {
+		left := (i % columns) * gif.Width
+		top := (i / columns) * gif.Height
+		for y := 0; y < gif.Height; y++ {
+			copy(pixels[left+(top+y)*atlasWidth:], frame[y*gif.Width:(y+1)*gif.Width])
+		}
+
+		delay := gif.Timing[i]
+		if delay <= 0 {
+			delay = 10
+		}
+		clip.Frames[i] = r.AnimationFrame{
+			Source:   r.NewRectangle(float32(left), float32(top), float32(gif.Width), float32(gif.Height)),
+			Duration: float32(delay) / 100,
+		}
+	}
+
+	image := r.LoadImageEx(pixels, int32(atlasWidth), int32(rows*gif.Height))
+	defer image.Unload()
+	return r.LoadTextureFromImage(image), clip, nil
+}
+
+//ToSpriteAnimation packs the gif into an atlas (see ToAtlas) and creates an animation that plays it.
+// Playing the animation only changes the source rectangle, instead of uploading every frame like Step does.
+func (gif *GifImage) ToSpriteAnimation() (*r.SpriteAnimation, error) {
+	texture, clip, err := gif.ToAtlas()
+	if err != nil {
+		return nil, err
+	}
+	return r.NewSpriteAnimation(texture, clip), nil
+}
+
+//LoadGifAnimation loads a gif as a SpriteAnimation. See GifImage.ToSpriteAnimation
+func LoadGifAnimation(fileName string) (*r.SpriteAnimation, error) {
+	gif, err := LoadGifFromFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer gif.Unload()
+	return gif.ToSpriteAnimation()
+}
+
+//atlasGrid gets the columns and rows of a grid of frames that fits in maxSize, keeping it as square as it can
+func atlasGrid(frames, width, height, maxSize int) (columns, rows int, err error) {
+	maxColumns, maxRows := 0, 0
+	if width > 0 && height > 0 {
+		maxColumns, maxRows = maxSize/width, maxSize/height
+	}
+	if maxColumns*maxRows < frames {
+		return 0, 0, fmt.Errorf("%w: %d frames of %dx%d are larger than %d", ErrAtlasTooLarge, frames, width, height, maxSize)
+	}
+
+	columns = max(min(int(math.Ceil(math.Sqrt(float64(frames)))), maxColumns), 1)
+	rows = (frames + columns - 1) / columns
+	if rows > maxRows {
+		//Too tall to be square, so it is made wider instead
+		rows = maxRows
+		columns = (frames + rows - 1) / rows
+	}
+	return columns, rows, nil
+}
+
 //DrawGif draws a single frame of a gif
 func DrawGif(gif *GifImage, x int, y int, tint r.Color) {
 	r.DrawTexture(gif.Texture, x, y, tint)
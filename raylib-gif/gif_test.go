@@ -0,0 +1,32 @@
+package rgif
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAtlasGrid(t *testing.T) {
+	tests := []struct {
+		frames, width, height int
+		columns, rows         int
+	}{
+		{1, 10, 10, 1, 1},
+		{10, 10, 10, 4, 3},
+		//tall frames make the grid wider
+		{10, 10, 50, 5, 2},
+		//wide frames keep it to a single column
+		{3, 100, 10, 1, 3},
+	}
+	for _, test := range tests {
+		columns, rows, err := atlasGrid(test.frames, test.width, test.height, 100)
+		if err != nil || columns != test.columns || rows != test.rows {
+			t.Errorf("%d frames of %dx%d are in %dx%d, %v", test.frames, test.width, test.height, columns, rows, err)
+		}
+	}
+
+	for _, test := range [][3]int{{101, 10, 10}, {1, 101, 1}, {3, 60, 60}} {
+		if _, _, err := atlasGrid(test[0], test[1], test[2], 100); !errors.Is(err, ErrAtlasTooLarge) {
+			t.Errorf("%d frames of %dx%d gave %v", test[0], test[1], test[2], err)
+		}
+	}
+}
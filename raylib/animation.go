@@ -0,0 +1,292 @@
+package raylib
+
+import "errors"
+
+//AnimationMode is how a clip plays once it reaches its last frame
+type AnimationMode int
+
+const (
+	//AnimationLoop starts again from the first frame
+	AnimationLoop AnimationMode = iota
+	//AnimationPingPong plays backwards to the first frame, then forwards again
+	AnimationPingPong
+	//AnimationOnce stops on the last frame
+	AnimationOnce
+)
+
+//ErrClipNotFound is returned when playing a clip the animation does not have
+var ErrClipNotFound = errors.New("animation clip not found")
+
+//AnimationFrame is a single frame of a clip
+type AnimationFrame struct {
+	//Source is the area of the texture atlas the frame is in
+	Source Rectangle
+	//Offset is where the source is drawn inside the full frame, for atlases that trim the empty space around their frames
+	Offset Vector2
+	//Duration is how many seconds the frame is shown for
+	Duration float32
+	//Event is sent to the OnEvent callback when the frame is shown, if it is not empty
+	Event string
+}
+
+//AnimationClip is a named sequence of frames, ie: "walk"
+type AnimationClip struct {
+	Name   string
+	Frames []AnimationFrame
+	Mode   AnimationMode
+	//Loops is how many times a looping or ping-pong clip repeats before it stops, like AnimationOnce does. 0 repeats forever.
+	Loops int
+}
+
+//NewAnimationClip creates a clip from a row of equally sized frames in a sprite sheet, starting at the position
+func NewAnimationClip(name string, position Vector2, frameWidth, frameHeight float32, frames int, duration float32, mode AnimationMode) *AnimationClip {
+	clip := &AnimationClip{Name: name, Mode: mode, Frames: make([]AnimationFrame, frames)}
+	for i := range clip.Frames {
+		clip.Frames[i] = AnimationFrame{
+			Source:   NewRectangle(position.X+frameWidth*float32(i), position.Y, frameWidth, frameHeight),
+			Duration: duration,
+		}
+	}
+	return clip
+}
+
+//SetEvent sets the event sent when the frame is shown
+func (clip *AnimationClip) SetEvent(frame int, event string) *AnimationClip {
+	clip.Frames[frame].Event = event
+	return clip
+}
+
+//Duration gets the length of a single play of the clip in seconds
+func (clip *AnimationClip) Duration() float32 {
+	var duration float32
+	for _, frame := range clip.Frames {
+		duration += frame.Duration
+	}
+	return duration
+}
+
+//SpriteAnimation plays clips from a texture atlas. Changing frames only changes the source rectangle, so nothing is uploaded to the GPU while playing.
+// Like Tween, it is driven by Update with the time that has passed (or Tick, which uses GetFrameTime).
+type SpriteAnimation struct {
+	//Texture is the atlas the frames are drawn from
+	Texture Texture2D
+	//Clips are the clips that can be played, by name
+	Clips map[string]*AnimationClip
+	//Speed scales the time passed to Update. 1 by default.
+	Speed float32
+
+	clip      *AnimationClip
+	frame     int
+	elapsed   float32
+	backwards bool
+	playing   bool
+	loops     int
+
+	onEvent    func(event string, clip string, frame int)
+	onComplete func(clip string)
+}
+
+//NewSpriteAnimation creates an animation for the atlas. The first clip is played.
+func NewSpriteAnimation(texture Texture2D, clips ...*AnimationClip) *SpriteAnimation {
+	animation := &SpriteAnimation{Texture: texture, Clips: make(map[string]*AnimationClip, len(clips)), Speed: 1}
+	for _, clip := range clips {
+		animation.AddClip(clip)
+	}
+	if len(clips) > 0 {
+		animation.Play(clips[0].Name)
+	}
+	return animation
+}
+
+//AddClip adds the clip, replacing any clip with the same name
+func (animation *SpriteAnimation) AddClip(clip *AnimationClip) {
+	animation.Clips[clip.Name] = clip
+}
+
+//Play starts the clip from its first frame. If the clip is already playing, it keeps playing from where it is.
+func (animation *SpriteAnimation) Play(name string) error {
+	if animation.clip != nil && animation.clip.Name == name && animation.playing {
+		return nil
+	}
+	return animation.Restart(name)
+}
+
+//Restart starts the clip from its first frame, even if it is already playing
+func (animation *SpriteAnimation) Restart(name string) error {
+	clip, ok := animation.Clips[name]
+	if !ok {
+		return ErrClipNotFound
+	}
+
+	animation.clip = clip
+	animation.frame = 0
+	animation.elapsed = 0
+	animation.backwards = false
+	animation.loops = 0
+	animation.playing = len(clip.Frames) > 0
+	animation.sendEvent()
+	return nil
+}
+
+//Stop pauses the animation on the current frame
+func (animation *SpriteAnimation) Stop() {
+	animation.playing = false
+}
+
+//Resume continues a stopped animation
+func (animation *SpriteAnimation) Resume() {
+	animation.playing = animation.clip != nil && len(animation.clip.Frames) > 0
+}
+
+//OnEvent sets the function called when a frame with an Event is shown
+func (animation *SpriteAnimation) OnEvent(callback func(event string, clip string, frame int)) *SpriteAnimation {
+	animation.onEvent = callback
+	return animation
+}
+
+//OnComplete sets the function called when a clip in AnimationOnce mode reaches its end, or a clip has repeated its Loops
+func (animation *SpriteAnimation) OnComplete(callback func(clip string)) *SpriteAnimation {
+	animation.onComplete = callback
+	return animation
+}
+
+//Clip gets the clip that is playing. Nil if nothing has been played.
+func (animation *SpriteAnimation) Clip() *AnimationClip { return animation.clip }
+
+//Frame gets the index of the current frame in the clip
+func (animation *SpriteAnimation) Frame() int { return animation.frame }
+
+//IsPlaying returns true if the animation is playing. Clips in AnimationOnce mode stop at their end, and clips with Loops once they have repeated.
+func (animation *SpriteAnimation) IsPlaying() bool { return animation.playing }
+
+//CurrentFrame gets the frame that is being shown
+func (animation *SpriteAnimation) CurrentFrame() AnimationFrame {
+	if animation.clip == nil || len(animation.clip.Frames) == 0 {
+		return AnimationFrame{}
+	}
+	return animation.clip.Frames[animation.frame]
+}
+
+//Tick updates the animation with GetFrameTime
+func (animation *SpriteAnimation) Tick() {
+	animation.Update(GetFrameTime())
+}
+
+//Update advances the animation by dt seconds, skipping as many frames as needed
+func (animation *SpriteAnimation) Update(dt float32) {
+	if !animation.playing {
+		return
+	}
+
+	animation.elapsed += dt * animation.Speed
+	for animation.playing {
+		duration := animation.clip.Frames[animation.frame].Duration
+
+		//Frames without a duration would never be left, so they are shown for a single update
+		if duration <= 0 {
+			if animation.elapsed <= 0 {
+				return
+			}
+			animation.elapsed = 0
+		} else if animation.elapsed < duration {
+			return
+		} else {
+			animation.elapsed -= duration
+		}
+
+		animation.step()
+	}
+}
+
+//step moves to the next frame of the clip
+func (animation *SpriteAnimation) step() {
+	clip := animation.clip
+	last := len(clip.Frames) - 1
+
+	switch {
+	case clip.Mode == AnimationPingPong && last > 0:
+		if animation.backwards && animation.frame == 0 {
+			if !animation.repeat() {
+				return
+			}
+			animation.backwards = false
+		} else if !animation.backwards && animation.frame == last {
+			animation.backwards = true
+		}
+		if animation.backwards {
+			animation.frame--
+		} else {
+			animation.frame++
+		}
+
+	case animation.frame < last:
+		animation.frame++
+
+	case clip.Mode == AnimationOnce:
+		animation.complete()
+		return
+
+	default:
+		if !animation.repeat() {
+			return
+		}
+		animation.frame = 0
+	}
+
+	animation.sendEvent()
+}
+
+//repeat counts a repeat of the clip, completing it instead if it has already repeated its Loops
+func (animation *SpriteAnimation) repeat() bool {
+	if animation.clip.Loops <= 0 {
+		return true
+	}
+	if animation.loops >= animation.clip.Loops {
+		animation.complete()
+		return false
+	}
+	animation.loops++
+	return true
+}
+
+//complete stops the animation on the current frame and calls the OnComplete callback
+func (animation *SpriteAnimation) complete() {
+	animation.playing = false
+	animation.elapsed = 0
+	if animation.onComplete != nil {
+		animation.onComplete(animation.clip.Name)
+	}
+}
+
+//sendEvent calls the OnEvent callback for the current frame
+func (animation *SpriteAnimation) sendEvent() {
+	if animation.onEvent == nil || !animation.playing {
+		return
+	}
+	if event := animation.clip.Frames[animation.frame].Event; event != "" {
+		animation.onEvent(event, animation.clip.Name, animation.frame)
+	}
+}
+
+//Draw draws the current frame with its top left corner at the position
+func (animation *SpriteAnimation) Draw(position Vector2, tint Color) {
+	animation.DrawEx(position, 0, 1, tint)
+}
+
+//DrawEx draws the current frame rotated around its top left corner and scaled
+func (animation *SpriteAnimation) DrawEx(position Vector2, rotation float32, scale float32, tint Color) {
+	frame := animation.CurrentFrame()
+	dest := NewRectangle(position.X, position.Y, frame.Source.Width*scale, frame.Source.Height*scale)
+	DrawTexturePro(animation.Texture, frame.Source, dest, NewVector2(-frame.Offset.X*scale, -frame.Offset.Y*scale), rotation, tint)
+}
+
+//DrawPro draws the current frame into the destination rectangle, rotated around the origin (relative to the rectangle)
+func (animation *SpriteAnimation) DrawPro(dest Rectangle, origin Vector2, rotation float32, tint Color) {
+	frame := animation.CurrentFrame()
+	DrawTexturePro(animation.Texture, frame.Source, dest, origin, rotation, tint)
+}
+
+//Unload unloads the atlas texture
+func (animation *SpriteAnimation) Unload() {
+	animation.Texture.Unload()
+}
@@ -0,0 +1,240 @@
+package raylib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//atlasFrame is a frame in the JSON exported by Aseprite and TexturePacker. They share the same layout.
+type atlasFrame struct {
+	Name  string `json:"filename"`
+	Frame struct {
+		X, Y, W, H float32
+	} `json:"frame"`
+	Rotated          bool `json:"rotated"`
+	SpriteSourceSize struct {
+		X, Y float32
+	} `json:"spriteSourceSize"`
+	Duration float32 `json:"duration"`
+}
+
+//atlasTag is an Aseprite frame tag
+type atlasTag struct {
+	Name      string `json:"name"`
+	From      int    `json:"from"`
+	To        int    `json:"to"`
+	Direction string `json:"direction"`
+	Repeat    string `json:"repeat"`
+}
+
+//atlasFile is the JSON exported by Aseprite and TexturePacker
+type atlasFile struct {
+	Frames json.RawMessage `json:"frames"`
+	Meta   struct {
+		Image     string     `json:"image"`
+		FrameTags []atlasTag `json:"frameTags"`
+	} `json:"meta"`
+}
+
+//parseAtlas reads the frames in the order they are in the file. They can either be an array, or an object keyed by name.
+func parseAtlas(data []byte) (*atlasFile, []atlasFrame, error) {
+	atlas := &atlasFile{}
+	if err := json.Unmarshal(data, atlas); err != nil {
+		return nil, nil, err
+	}
+
+	var frames []atlasFrame
+	raw := bytes.TrimSpace(atlas.Frames)
+	switch {
+	case len(raw) == 0:
+		return nil, nil, fmt.Errorf("atlas has no frames")
+
+	case raw[0] == '[':
+		if err := json.Unmarshal(raw, &frames); err != nil {
+			return nil, nil, err
+		}
+
+	default:
+		//A map would lose the order, so the object is read a token at a time
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		if _, err := decoder.Token(); err != nil {
+			return nil, nil, err
+		}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+			frame := atlasFrame{}
+			if err := decoder.Decode(&frame); err != nil {
+				return nil, nil, err
+			}
+			frame.Name = key.(string)
+			frames = append(frames, frame)
+		}
+	}
+
+	for _, frame := range frames {
+		if frame.Rotated {
+			return nil, nil, fmt.Errorf("atlas frame %q is rotated, which is not supported", frame.Name)
+		}
+	}
+	return atlas, frames, nil
+}
+
+//toAnimationFrame converts the frame, with its duration in seconds
+func (frame atlasFrame) toAnimationFrame(duration float32) AnimationFrame {
+	return AnimationFrame{
+		Source:   NewRectangle(frame.Frame.X, frame.Frame.Y, frame.Frame.W, frame.Frame.H),
+		Offset:   NewVector2(frame.SpriteSourceSize.X, frame.SpriteSourceSize.Y),
+		Duration: duration,
+	}
+}
+
+//ParseAsepriteClips reads the clips from the JSON exported by Aseprite (File > Export Sprite Sheet, with "Tags" in the meta).
+// Every tag becomes a clip, its direction sets the clip mode and its repeat the Loops (a repeat of 1 plays once). If there are no tags, all the frames are in a single looping clip named "default".
+// Also returns the file name of the atlas image, relative to the JSON.
+func ParseAsepriteClips(data []byte) ([]*AnimationClip, string, error) {
+	atlas, frames, err := parseAtlas(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	converted := make([]AnimationFrame, len(frames))
+	for i, frame := range frames {
+		converted[i] = frame.toAnimationFrame(frame.Duration / 1000)
+	}
+
+	if len(atlas.Meta.FrameTags) == 0 {
+		return []*AnimationClip{{Name: "default", Frames: converted}}, atlas.Meta.Image, nil
+	}
+
+	clips := make([]*AnimationClip, 0, len(atlas.Meta.FrameTags))
+	for _, tag := range atlas.Meta.FrameTags {
+		if tag.From < 0 || tag.To >= len(converted) || tag.From > tag.To {
+			return nil, "", fmt.Errorf("aseprite tag %q has frames %d to %d, but there are %d frames", tag.Name, tag.From, tag.To, len(converted))
+		}
+
+		clip := &AnimationClip{Name: tag.Name}
+		clip.Frames = append(clip.Frames, converted[tag.From:tag.To+1]...)
+		if strings.HasSuffix(tag.Direction, "reverse") {
+			for i, j := 0, len(clip.Frames)-1; i < j; i, j = i+1, j-1 {
+				clip.Frames[i], clip.Frames[j] = clip.Frames[j], clip.Frames[i]
+			}
+		}
+
+		//The repeat is how many times the tag plays, and is empty or 0 when it plays forever
+		repeat := 0
+		if tag.Repeat != "" {
+			if repeat, err = strconv.Atoi(tag.Repeat); err != nil || repeat < 0 {
+				return nil, "", fmt.Errorf("aseprite tag %q has a repeat of %q", tag.Name, tag.Repeat)
+			}
+		}
+
+		switch {
+		case repeat == 1:
+			clip.Mode = AnimationOnce
+		case strings.HasPrefix(tag.Direction, "pingpong"):
+			clip.Mode = AnimationPingPong
+		default:
+			clip.Mode = AnimationLoop
+		}
+		if repeat > 1 {
+			clip.Loops = repeat - 1
+		}
+		clips = append(clips, clip)
+	}
+	return clips, atlas.Meta.Image, nil
+}
+
+//ParseTexturePackerClips reads the clips from the JSON (Hash or Array) exported by TexturePacker.
+// TexturePacker has no timing, so the frames are grouped into looping clips by their name without the extension and frame number
+// (ie: "walk_01.png" and "walk_02.png" are the clip "walk") and all last for the frameDuration, in seconds.
+// Also returns the file name of the atlas image, relative to the JSON.
+func ParseTexturePackerClips(data []byte, frameDuration float32) ([]*AnimationClip, string, error) {
+	atlas, frames, err := parseAtlas(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	type numbered struct {
+		number int
+		frame  AnimationFrame
+	}
+
+	var names []string
+	groups := make(map[string][]numbered)
+	for _, frame := range frames {
+		name := strings.TrimSuffix(frame.Name, filepath.Ext(frame.Name))
+		trimmed := strings.TrimRight(name, "0123456789")
+		number, _ := strconv.Atoi(name[len(trimmed):])
+		if clipName := strings.TrimRight(trimmed, "_-. /"); clipName != "" {
+			trimmed = clipName
+		}
+
+		if _, ok := groups[trimmed]; !ok {
+			names = append(names, trimmed)
+		}
+		groups[trimmed] = append(groups[trimmed], numbered{number, frame.toAnimationFrame(frameDuration)})
+	}
+
+	clips := make([]*AnimationClip, len(names))
+	for i, name := range names {
+		group := groups[name]
+		sort.SliceStable(group, func(a, b int) bool { return group[a].number < group[b].number })
+
+		clip := &AnimationClip{Name: name, Frames: make([]AnimationFrame, len(group))}
+		for j, frame := range group {
+			clip.Frames[j] = frame.frame
+		}
+		clips[i] = clip
+	}
+	return clips, atlas.Meta.Image, nil
+}
+
+//LoadAsepriteAnimation loads the JSON exported by Aseprite and its atlas texture. See ParseAsepriteClips
+func LoadAsepriteAnimation(fileName string) (*SpriteAnimation, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	clips, image, err := ParseAsepriteClips(data)
+	if err != nil {
+		return nil, err
+	}
+	return loadAtlasAnimation(fileName, image, clips)
+}
+
+//LoadTexturePackerAnimation loads the JSON exported by TexturePacker and its atlas texture. See ParseTexturePackerClips
+func LoadTexturePackerAnimation(fileName string, frameDuration float32) (*SpriteAnimation, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	clips, image, err := ParseTexturePackerClips(data, frameDuration)
+	if err != nil {
+		return nil, err
+	}
+	return loadAtlasAnimation(fileName, image, clips)
+}
+
+//loadAtlasAnimation loads the image next to the JSON file
+func loadAtlasAnimation(fileName string, image string, clips []*AnimationClip) (*SpriteAnimation, error) {
+	if image == "" {
+		return nil, fmt.Errorf("%s does not have an image in its meta", fileName)
+	}
+
+	texture, err := LoadTextureE(filepath.Join(filepath.Dir(fileName), image))
+	if err != nil {
+		return nil, err
+	}
+	return NewSpriteAnimation(texture, clips...), nil
+}
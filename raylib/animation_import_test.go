@@ -0,0 +1,179 @@
+package raylib
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+//testAsepriteJSON is an Aseprite export of 4 frames in a row, with the frames as a hash and the tags in the meta
+const testAsepriteJSON = `{
+	"frames": {
+		"player 0.aseprite": {"frame": {"x": 0, "y": 0, "w": 16, "h": 16}, "rotated": false, "spriteSourceSize": {"x": 1, "y": 2, "w": 16, "h": 16}, "duration": 100},
+		"player 1.aseprite": {"frame": {"x": 16, "y": 0, "w": 16, "h": 16}, "rotated": false, "spriteSourceSize": {"x": 0, "y": 0, "w": 16, "h": 16}, "duration": 200},
+		"player 2.aseprite": {"frame": {"x": 32, "y": 0, "w": 16, "h": 16}, "rotated": false, "spriteSourceSize": {"x": 0, "y": 0, "w": 16, "h": 16}, "duration": 300},
+		"player 3.aseprite": {"frame": {"x": 48, "y": 0, "w": 16, "h": 16}, "rotated": false, "spriteSourceSize": {"x": 0, "y": 0, "w": 16, "h": 16}, "duration": 400}
+	},
+	"meta": {
+		"image": "player.png",
+		"frameTags": [
+			{"name": "idle", "from": 0, "to": 1, "direction": "forward"},
+			{"name": "walk", "from": 1, "to": 3, "direction": "reverse", "repeat": "3"},
+			{"name": "wave", "from": 0, "to": 2, "direction": "pingpong", "repeat": "2"},
+			{"name": "die", "from": 2, "to": 3, "direction": "forward", "repeat": "1"}
+		]
+	}
+}`
+
+//testClipSources gets the x of the source of each frame in the clip, which is the index of the frame times 16
+func testClipSources(clip *AnimationClip) []float32 {
+	sources := make([]float32, len(clip.Frames))
+	for i, frame := range clip.Frames {
+		sources[i] = frame.Source.X
+	}
+	return sources
+}
+
+func testEqualSources(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParseAsepriteClips(t *testing.T) {
+	clips, image, err := ParseAsepriteClips([]byte(testAsepriteJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image != "player.png" || len(clips) != 4 {
+		t.Fatalf("parsed %d clips with the image %q", len(clips), image)
+	}
+
+	tests := []struct {
+		name    string
+		sources []float32
+		mode    AnimationMode
+		loops   int
+	}{
+		{"idle", []float32{0, 16}, AnimationLoop, 0},
+		{"walk", []float32{48, 32, 16}, AnimationLoop, 2},
+		{"wave", []float32{0, 16, 32}, AnimationPingPong, 1},
+		{"die", []float32{32, 48}, AnimationOnce, 0},
+	}
+	for i, test := range tests {
+		clip := clips[i]
+		if clip.Name != test.name || clip.Mode != test.mode || clip.Loops != test.loops {
+			t.Errorf("clip %d is %q with mode %d and %d loops, expected %q with mode %d and %d loops", i, clip.Name, clip.Mode, clip.Loops, test.name, test.mode, test.loops)
+		}
+		if sources := testClipSources(clip); !testEqualSources(sources, test.sources) {
+			t.Errorf("%s has the frames %v, expected %v", clip.Name, sources, test.sources)
+		}
+	}
+
+	//the durations are in milliseconds, and the offset is where the trimmed frame goes
+	walk := clips[1]
+	if walk.Frames[0].Duration != 0.4 || walk.Frames[2].Duration != 0.2 || !testNear(walk.Duration(), 0.9) {
+		t.Errorf("walk has the durations %v, %v and %v", walk.Frames[0].Duration, walk.Frames[2].Duration, walk.Duration())
+	}
+	if offset := clips[0].Frames[0].Offset; offset != NewVector2(1, 2) {
+		t.Errorf("the first frame has the offset %v", offset)
+	}
+}
+
+func TestParseAsepriteClipsWithoutTags(t *testing.T) {
+	//the frames can also be an array, and without tags they are all in a looping clip
+	data := `{"frames": [
+		{"filename": "a", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}, "duration": 50},
+		{"filename": "b", "frame": {"x": 8, "y": 0, "w": 8, "h": 8}, "duration": 50}
+	], "meta": {"image": "a.png"}}`
+	clips, _, err := ParseAsepriteClips([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clips) != 1 || clips[0].Name != "default" || clips[0].Mode != AnimationLoop || len(clips[0].Frames) != 2 || clips[0].Frames[1].Duration != 0.05 {
+		t.Fatalf("parsed %+v", clips)
+	}
+}
+
+func TestParseAsepriteClipsMalformed(t *testing.T) {
+	frame := `{"frame": {"x": 0, "y": 0, "w": 8, "h": 8}, "duration": 100}`
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"invalid json", `{"frames": {`},
+		{"no frames", `{"meta": {"image": "a.png"}}`},
+		{"frames that are not frames", `{"frames": [1, 2]}`},
+		{"a rotated frame", `{"frames": [{"frame": {"x": 0, "y": 0, "w": 8, "h": 8}, "rotated": true}]}`},
+		{"a tag past the last frame", `{"frames": [` + frame + `], "meta": {"frameTags": [{"name": "a", "from": 0, "to": 1}]}}`},
+		{"a backwards tag", `{"frames": [` + frame + `,` + frame + `], "meta": {"frameTags": [{"name": "a", "from": 1, "to": 0}]}}`},
+		{"a repeat that is not a number", `{"frames": [` + frame + `], "meta": {"frameTags": [{"name": "a", "from": 0, "to": 0, "repeat": "often"}]}}`},
+		{"a negative repeat", `{"frames": [` + frame + `], "meta": {"frameTags": [{"name": "a", "from": 0, "to": 0, "repeat": "-2"}]}}`},
+	}
+	for _, test := range tests {
+		if clips, _, err := ParseAsepriteClips([]byte(test.data)); err == nil {
+			t.Errorf("%s parsed as %+v", test.name, clips)
+		}
+	}
+}
+
+func TestParseTexturePackerClips(t *testing.T) {
+	frame := func(name string, x int) string {
+		return `"` + name + `": {"frame": {"x": ` + strconv.Itoa(x) + `, "y": 0, "w": 16, "h": 16}, "rotated": false, "spriteSourceSize": {"x": 0, "y": 0}}`
+	}
+	hash := `{"frames": {` + strings.Join([]string{
+		frame("walk_10.png", 0),
+		frame("walk_2.png", 16),
+		frame("walk_1.png", 32),
+		frame("jump.png", 48),
+		frame("run-01.png", 64),
+		frame("run-02.png", 80),
+	}, ",") + `}, "meta": {"image": "sheet.png"}}`
+
+	clips, image, err := ParseTexturePackerClips([]byte(hash), 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image != "sheet.png" || len(clips) != 3 {
+		t.Fatalf("parsed %d clips with the image %q", len(clips), image)
+	}
+
+	//the clips are in the order they first appear, and their frames are sorted by number
+	tests := []struct {
+		name    string
+		sources []float32
+	}{
+		{"walk", []float32{32, 16, 0}},
+		{"jump", []float32{48}},
+		{"run", []float32{64, 80}},
+	}
+	for i, test := range tests {
+		clip := clips[i]
+		if clip.Name != test.name || clip.Mode != AnimationLoop || !testNear(clip.Duration(), float32(len(test.sources))*0.1) {
+			t.Errorf("clip %d is %q with mode %d and lasts %v", i, clip.Name, clip.Mode, clip.Duration())
+		}
+		if sources := testClipSources(clip); !testEqualSources(sources, test.sources) {
+			t.Errorf("%s has the frames %v, expected %v", clip.Name, sources, test.sources)
+		}
+	}
+
+	//the array export reads the same
+	array := `{"frames": [{"filename": "idle_0.png", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}}, {"filename": "idle_1.png", "frame": {"x": 8, "y": 0, "w": 8, "h": 8}}]}`
+	clips, _, err = ParseTexturePackerClips([]byte(array), 0.25)
+	if err != nil || len(clips) != 1 || clips[0].Name != "idle" || len(clips[0].Frames) != 2 {
+		t.Fatalf("the array parsed as %+v, %v", clips, err)
+	}
+
+	if _, _, err := ParseTexturePackerClips([]byte(`{"frames": {"a": {"rotated": true}}}`), 0.1); err == nil {
+		t.Error("a rotated frame was parsed")
+	}
+	if _, _, err := ParseTexturePackerClips([]byte(`[]`), 0.1); err == nil {
+		t.Error("an array without frames was parsed")
+	}
+}
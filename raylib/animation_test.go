@@ -0,0 +1,46 @@
+package raylib
+
+import "testing"
+
+func TestAnimationLoops(t *testing.T) {
+	tests := []struct {
+		mode   AnimationMode
+		loops  int
+		frames int
+	}{
+		//3 frames, shown 3 times
+		{AnimationLoop, 2, 9},
+		//0, 1, 2, 1, then 0, 1, 2, 1 again, and it stops on 0
+		{AnimationPingPong, 1, 9},
+		{AnimationLoop, 0, 101},
+	}
+	for _, test := range tests {
+		clip := &AnimationClip{Name: "clip", Mode: test.mode, Loops: test.loops, Frames: make([]AnimationFrame, 3)}
+		for i := range clip.Frames {
+			clip.Frames[i].Duration = 1
+		}
+
+		completed := 0
+		animation := NewSpriteAnimation(Texture2D{}, clip).OnComplete(func(string) { completed++ })
+		shown := 1
+		for i := 0; i < 100 && animation.IsPlaying(); i++ {
+			animation.Update(1)
+			if animation.IsPlaying() {
+				shown++
+			}
+		}
+		if shown != test.frames {
+			t.Errorf("mode %d with %d loops showed %d frames, not %d", test.mode, test.loops, shown, test.frames)
+		}
+		if test.loops > 0 && completed != 1 {
+			t.Errorf("mode %d with %d loops completed %d times", test.mode, test.loops, completed)
+		}
+
+		//restarting plays the loops again
+		animation.Restart("clip")
+		animation.Update(3)
+		if !animation.IsPlaying() {
+			t.Errorf("mode %d with %d loops did not restart", test.mode, test.loops)
+		}
+	}
+}
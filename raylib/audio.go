@@ -2,6 +2,7 @@ package raylib
 
 /*
 #include "raylib.h"
+#include "utils.h"
 #include <stdlib.h>
 */
 import "C"
@@ -9,6 +10,7 @@ import "unsafe"
 
 //Wave defines audio wave data
 type Wave struct {
+	//SampleCount is the number of frames, which have a sample for every channel. It is the same for every loader, WaveFormat, WaveCrop and LoadSoundFromWave.
 	SampleCount uint32
 	SampleRate  uint32
 	SampleSize  uint32
@@ -24,6 +26,11 @@ func (w *Wave) cptr() *C.Wave {
 	return (*C.Wave)(unsafe.Pointer(w))
 }
 
+//IsValid returns true if the wave has sample data
+func (w *Wave) IsValid() bool {
+	return w.data != nil
+}
+
 //Sound source type
 type Sound struct {
 	SampleCount uint32
@@ -65,6 +72,16 @@ func (as *AudioStream) IsValid() bool {
 	return as.Channels > 0
 }
 
+//queuedFrames copies up to the number of frames the stream has queued to play next, in the format of the stream
+func (as *AudioStream) queuedFrames(frames int) []byte {
+	data := make([]byte, frames*int(as.Channels*as.SampleSize/8))
+	if len(data) == 0 {
+		return nil
+	}
+	read := C.ReadAudioStreamBuffer(*as.cptr(), unsafe.Pointer(&data[0]), C.uint(frames))
+	return data[:int(read)*int(as.Channels*as.SampleSize/8)]
+}
+
 //Music stream type. Anything longer than ~10 seconds should be streamed.
 type Music struct {
 	CtxType     int32
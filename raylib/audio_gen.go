@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T15:35:38Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -40,6 +40,20 @@ func LoadWave(fileName string) *Wave {
 	return retval
 }
 
+//LoadWaveE : Load wave data from file
+//Returns an error instead of an invalid Wave if it cannot be loaded
+func LoadWaveE(fileName string) (*Wave, error) {
+	var retval *Wave
+	err := loadChecked("LoadWave", ".wav;.ogg;.flac;.mp3", "", func() Unloadable {
+		retval = LoadWave(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
 //LoadSound : Load sound from file
 func LoadSound(fileName string) *Sound {
 	cfileName := C.CString(fileName)
@@ -50,6 +64,20 @@ func LoadSound(fileName string) *Sound {
 	return retval
 }
 
+//LoadSoundE : Load sound from file
+//Returns an error instead of an invalid Sound if it cannot be loaded
+func LoadSoundE(fileName string) (*Sound, error) {
+	var retval *Sound
+	err := loadChecked("LoadSound", ".wav;.ogg;.flac;.mp3", "", func() Unloadable {
+		retval = LoadSound(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
 //LoadSoundFromWave : Load sound from wave data
 func LoadSoundFromWave(wave *Wave) *Sound {
 	cwave := *wave.cptr()
@@ -175,6 +203,7 @@ func ResumeSound(sound *Sound) {
 
 //PlayMulti : Play a sound (using multichannel buffer pool)
 func (sound *Sound) PlayMulti() {
+	checkMainThread("PlaySoundMulti")
 	csound := *sound.cptr()
 	C.PlaySoundMulti(csound)
 }
@@ -187,11 +216,13 @@ func PlaySoundMulti(sound *Sound) {
 
 //StopSoundMulti : Stop any sound playing (using multichannel buffer pool)
 func StopSoundMulti() {
+	checkMainThread("StopSoundMulti")
 	C.StopSoundMulti()
 }
 
 //GetSoundsPlaying : Get number of sounds playing in the multichannel
 func GetSoundsPlaying() int {
+	checkMainThread("GetSoundsPlaying")
 	res := C.GetSoundsPlaying()
 	return int(int32(res))
 }
@@ -233,6 +264,18 @@ func SetSoundPitch(sound *Sound, pitch float32) {
 	sound.SetPitch(pitch)
 }
 
+//SetPan : Set pan for a sound (0.0f left, 0.5f center, 1.0f right)
+func (sound *Sound) SetPan(pan float32) {
+	csound := *sound.cptr()
+	C.SetSoundPan(csound, C.float(pan))
+}
+
+//SetSoundPan : Set pan for a sound (0.0f left, 0.5f center, 1.0f right)
+//Recommended to use sound.SetPan(pan) instead
+func SetSoundPan(sound *Sound, pan float32) {
+	sound.SetPan(pan)
+}
+
 //Format : Convert wave data to desired format
 func (wave *Wave) Format(sampleRate int, sampleSize int, channels int) {
 	cwave := wave.cptr()
@@ -264,6 +307,11 @@ func WaveCopy(wave *Wave) *Wave {
 func (wave *Wave) Crop(initSample int, finalSample int) {
 	cwave := wave.cptr()
 	C.WaveCrop(cwave, C.int(int32(initSample)), C.int(int32(finalSample)))
+
+	//raylib crops the data but does not change the sample count
+	if initSample >= 0 && initSample < finalSample && finalSample > 0 && uint32(finalSample) < wave.SampleCount {
+		wave.SampleCount = uint32(finalSample - initSample)
+	}
 }
 
 //WaveCrop : Crop a wave to defined samples range
@@ -300,6 +348,20 @@ func LoadMusicStream(fileName string) *Music {
 	return retval
 }
 
+//LoadMusicStreamE : Load music stream from file
+//Returns an error instead of an invalid Music if it cannot be loaded
+func LoadMusicStreamE(fileName string) (*Music, error) {
+	var retval *Music
+	err := loadChecked("LoadMusicStream", ".wav;.ogg;.flac;.mp3;.xm;.mod", "", func() Unloadable {
+		retval = LoadMusicStream(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
 //UnloadStream : Unload music stream
 func (music *Music) Unload() {
 	UnloadMusicStream(music)
@@ -409,6 +471,18 @@ func SetMusicPitch(music *Music, pitch float32) {
 	music.SetPitch(pitch)
 }
 
+//SetPan : Set pan for a music (0.0f left, 0.5f center, 1.0f right)
+func (music *Music) SetPan(pan float32) {
+	cmusic := *music.cptr()
+	C.SetMusicPan(cmusic, C.float(pan))
+}
+
+//SetMusicPan : Set pan for a music (0.0f left, 0.5f center, 1.0f right)
+//Recommended to use music.SetPan(pan) instead
+func SetMusicPan(music *Music, pan float32) {
+	music.SetPan(pan)
+}
+
 //SetLoopCount : Set music loop count (loop repeats)
 func (music *Music) SetLoopCount(count int) {
 	cmusic := *music.cptr()
@@ -447,6 +521,33 @@ func GetMusicTimePlayed(music *Music) float32 {
 	return music.GetTimePlayed()
 }
 
+//Position : Get current music position (in seconds), of the audio being heard.
+//Unlike GetTimePlayed it does not count the audio that has been queued by UpdateStream but not played yet.
+func (music *Music) Position() float32 {
+	cmusic := *music.cptr()
+	res := C.GetMusicTimePosition(cmusic)
+	return float32(res)
+}
+
+//GetMusicTimePosition : Get current music position (in seconds), of the audio being heard
+//Recommended to use music.Position() instead
+func GetMusicTimePosition(music *Music) float32 {
+	return music.Position()
+}
+
+//Seek : Seek music to a position (in seconds). The audio already queued is dropped, so the new position is heard after the next UpdateStream.
+//Seeking an XM or MOD module plays it from the start up to the position without being heard, so it is slower the further in the position is.
+func (music *Music) Seek(position float32) {
+	cmusic := *music.cptr()
+	C.SeekMusicStream(cmusic, C.float(position))
+}
+
+//SeekMusicStream : Seek music to a position (in seconds)
+//Recommended to use music.Seek(position) instead
+func SeekMusicStream(music *Music, position float32) {
+	music.Seek(position)
+}
+
 //InitAudioStream : Init audio stream (to stream raw audio pcm data)
 func InitAudioStream(sampleRate uint32, sampleSize uint32, channels uint32) *AudioStream {
 	res := C.InitAudioStream(C.uint(sampleRate), C.uint(sampleSize), C.uint(channels))
@@ -492,6 +593,19 @@ func IsAudioStreamProcessed(stream *AudioStream) bool {
 	return stream.IsProcessed()
 }
 
+//GetBufferSize : Get the size of each of the two buffers of an audio stream (in frames)
+func (stream *AudioStream) GetBufferSize() uint32 {
+	cstream := *stream.cptr()
+	res := C.GetAudioStreamBufferSize(cstream)
+	return uint32(res)
+}
+
+//GetAudioStreamBufferSize : Get the size of each of the two buffers of an audio stream (in frames)
+//Recommended to use stream.GetBufferSize() instead
+func GetAudioStreamBufferSize(stream *AudioStream) uint32 {
+	return stream.GetBufferSize()
+}
+
 //Play : Play audio stream
 func (stream *AudioStream) Play() {
 	cstream := *stream.cptr()
@@ -576,3 +690,15 @@ func (stream *AudioStream) SetPitch(pitch float32) {
 func SetAudioStreamPitch(stream *AudioStream, pitch float32) {
 	stream.SetPitch(pitch)
 }
+
+//SetPan : Set pan for audio stream (0.0f left, 0.5f center, 1.0f right)
+func (stream *AudioStream) SetPan(pan float32) {
+	cstream := *stream.cptr()
+	C.SetAudioStreamPan(cstream, C.float(pan))
+}
+
+//SetAudioStreamPan : Set pan for audio stream (0.0f left, 0.5f center, 1.0f right)
+//Recommended to use stream.SetPan(pan) instead
+func SetAudioStreamPan(stream *AudioStream, pan float32) {
+	stream.SetPan(pan)
+}
@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -10,6 +10,7 @@ import "C"
 
 //SetMode : Set camera mode (multiple camera modes available)
 func (camera *Camera) SetMode(mode CameraMode) {
+	checkMainThread("SetCameraMode")
 	ccamera := *camera.cptr()
 	C.SetCameraMode(ccamera, C.int(mode))
 }
@@ -22,6 +23,7 @@ func SetCameraMode(camera *Camera, mode CameraMode) {
 
 //Update : Update camera position for selected mode
 func (camera *Camera) Update() {
+	checkMainThread("UpdateCamera")
 	ccamera := camera.cptr()
 	C.UpdateCamera(ccamera)
 }
@@ -34,20 +36,24 @@ func UpdateCamera(camera *Camera) {
 
 //SetCameraPanControl : Set camera pan key to combine with mouse movement (free camera)
 func SetCameraPanControl(panKey Key) {
+	checkMainThread("SetCameraPanControl")
 	C.SetCameraPanControl(C.int(panKey))
 }
 
 //SetCameraAltControl : Set camera alt key to combine with mouse movement (free camera)
 func SetCameraAltControl(altKey Key) {
+	checkMainThread("SetCameraAltControl")
 	C.SetCameraAltControl(C.int(altKey))
 }
 
 //SetCameraSmoothZoomControl : Set camera smooth zoom key to combine with mouse (free camera)
 func SetCameraSmoothZoomControl(szKey Key) {
+	checkMainThread("SetCameraSmoothZoomControl")
 	C.SetCameraSmoothZoomControl(C.int(szKey))
 }
 
 //SetCameraMoveControls : Set camera move controls (1st person and 3rd person cameras)
 func SetCameraMoveControls(frontKey Key, backKey Key, rightKey Key, leftKey Key, upKey Key, downKey Key) {
+	checkMainThread("SetCameraMoveControls")
 	C.SetCameraMoveControls(C.int(frontKey), C.int(backKey), C.int(rightKey), C.int(leftKey), C.int(upKey), C.int(downKey))
 }
@@ -1,6 +1,7 @@
 package raylib
 
 import (
+	"image/color"
 	"math"
 	"unsafe"
 )
@@ -145,6 +146,11 @@ func (c Color) ToInt() int {
 	return (int(c.R) << 24) | (int(c.G) << 16) | (int(c.B) << 8) | int(c.A)
 }
 
+//RGBA returns the alpha-premultiplied colour, so it can be used as a color.Color. raylib colours are not premultiplied.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	return color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}.RGBA()
+}
+
 //Normalize returns the normalized colour as floats [0..1]
 func (c Color) Normalize() Vector4 {
 	return NewVector4(float32(c.R)/255.0, float32(c.G)/255.0, float32(c.B)/255.0, float32(c.A)/255.0)
@@ -0,0 +1,622 @@
+package raylib
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+//ErrUnknownCommand is returned when executing a command the console does not have
+var ErrUnknownCommand = errors.New("unknown command")
+
+//consoleInputSize is the most characters the input box holds
+const consoleInputSize = 256
+
+//ConsoleEntry is a single line of the console log
+type ConsoleEntry struct {
+	Time time.Time
+	Type TraceLogType
+	Text string
+}
+
+//ConsoleCommand is a command that can be typed into the console
+type ConsoleCommand struct {
+	Name string
+	Help string
+	//Run is called with the arguments after the command name. Quoted arguments can have spaces in them.
+	Run func(console *Console, args []string) error
+	//Complete gets the values the last argument can be completed to. The last argument is the one being typed, and can be empty.
+	Complete func(args []string) []string
+}
+
+//SetCompleter sets the function used for tab completion of the arguments
+func (command *ConsoleCommand) SetCompleter(complete func(args []string) []string) *ConsoleCommand {
+	command.Complete = complete
+	return command
+}
+
+//consoleVar is a variable that can be read and set from the console
+type consoleVar struct {
+	name  string
+	help  string
+	value interface{}
+}
+
+//Console is an in-game developer console. It keeps the recent logs in a ring buffer and runs commands.
+// Update handles its input and Draw draws it as an overlay, which is opened and closed with the ToggleKey.
+// It has the help, clear and unloadables commands built in, and variables can be tweaked with RegisterVar.
+type Console struct {
+	//ToggleKey opens and closes the overlay. KeyGrave by default.
+	ToggleKey Key
+	//Font is used to draw the logs. The default font is used if it is nil.
+	Font *Font
+	//FontSize is the size the logs are drawn at. 10 by default.
+	FontSize float32
+	//Height is how much of the screen the overlay covers, from 0 to 1. 0.5 by default.
+	Height float32
+	//Background is the colour behind the logs
+	Background Color
+
+	mutex    sync.Mutex
+	entries  []ConsoleEntry
+	first    int
+	count    int
+	commands map[string]*ConsoleCommand
+	vars     map[string]*consoleVar
+
+	history      []string
+	historyIndex int
+	input        string
+	visible      bool
+	scroll       int
+	skipInput    bool
+}
+
+//NewConsole creates a console that keeps the last capacity log lines. It starts receiving logs straight away, until Close is called.
+// Consoles can be created, logged to and closed from any goroutine.
+func NewConsole(capacity int) *Console {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	console := &Console{
+		ToggleKey:  KeyGrave,
+		FontSize:   10,
+		Height:     0.5,
+		Background: NewColor(0, 0, 0, 200),
+		entries:    make([]ConsoleEntry, capacity),
+		commands:   make(map[string]*ConsoleCommand),
+		vars:       make(map[string]*consoleVar),
+	}
+
+	console.Register("help", "Lists the commands and variables, or describes one. help [name]", consoleHelp).SetCompleter(func(args []string) []string {
+		if len(args) == 1 {
+			return console.names()
+		}
+		return nil
+	})
+	console.Register("clear", "Clears the log", func(console *Console, args []string) error {
+		console.Clear()
+		return nil
+	})
+	console.Register("unloadables", "Counts the loaded Unloadables in each scope", consoleUnloadables)
+
+	addTraceListener(console)
+	return console
+}
+
+//Close stops the console receiving logs
+func (console *Console) Close() {
+	removeTraceListener(console)
+}
+
+func (console *Console) onTrace(logType TraceLogType, text string) {
+	console.Log(logType, text)
+}
+
+//Log adds a log to the console. Each line of the text is its own entry.
+func (console *Console) Log(logType TraceLogType, text string) {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+
+	now := time.Now()
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		index := (console.first + console.count) % len(console.entries)
+		console.entries[index] = ConsoleEntry{Time: now, Type: logType, Text: line}
+		if console.count < len(console.entries) {
+			console.count++
+		} else {
+			console.first = (console.first + 1) % len(console.entries)
+		}
+	}
+}
+
+//Print adds an info line to the console, without sending it to TraceLog. Commands use this for their output.
+func (console *Console) Print(a ...interface{}) {
+	console.Log(LogInfo, fmt.Sprint(a...))
+}
+
+//Printf adds a formatted info line to the console, without sending it to TraceLog
+func (console *Console) Printf(format string, a ...interface{}) {
+	console.Log(LogInfo, fmt.Sprintf(format, a...))
+}
+
+//Entries gets the lines in the console, oldest first
+func (console *Console) Entries() []ConsoleEntry {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+
+	entries := make([]ConsoleEntry, console.count)
+	for i := range entries {
+		entries[i] = console.entries[(console.first+i)%len(console.entries)]
+	}
+	return entries
+}
+
+//Clear removes all the lines from the console
+func (console *Console) Clear() {
+	console.mutex.Lock()
+	defer console.mutex.Unlock()
+	console.first = 0
+	console.count = 0
+	console.scroll = 0
+}
+
+//Register adds a command, replacing any command or variable with the same name
+func (console *Console) Register(name string, help string, run func(console *Console, args []string) error) *ConsoleCommand {
+	command := &ConsoleCommand{Name: name, Help: help, Run: run}
+	delete(console.vars, name)
+	console.commands[name] = command
+	return command
+}
+
+//RegisterVar adds a variable that can be read by typing its name, and set by typing its name and a value.
+// The value must be a pointer to a bool, int, int32, float32, float64 or string. It replaces any command or variable with the same name.
+func (console *Console) RegisterVar(name string, help string, value interface{}) error {
+	switch value.(type) {
+	case *bool, *int, *int32, *float32, *float64, *string:
+	default:
+		return fmt.Errorf("console variable %s cannot be a %T", name, value)
+	}
+
+	delete(console.commands, name)
+	console.vars[name] = &consoleVar{name: name, help: help, value: value}
+	return nil
+}
+
+//Execute runs a command line, ie: `speed 2.5` or `spawn "big tree" 3`. The line and any error are added to the console.
+func (console *Console) Execute(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+
+	console.Log(LogInfo, "> "+line)
+	if len(console.history) == 0 || console.history[len(console.history)-1] != line {
+		console.history = append(console.history, line)
+	}
+	console.historyIndex = len(console.history)
+
+	err := console.run(line)
+	if err != nil {
+		console.Log(LogError, err.Error())
+	}
+	return err
+}
+
+func (console *Console) run(line string) error {
+	args, err := parseConsoleArgs(line)
+	if err != nil {
+		return err
+	}
+
+	if command, ok := console.commands[args[0]]; ok {
+		return command.Run(console, args[1:])
+	}
+
+	if v, ok := console.vars[args[0]]; ok {
+		switch len(args) {
+		case 1:
+		case 2:
+			if err := v.set(args[1]); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%s takes a single value", v.name)
+		}
+		console.Printf("%s = %s", v.name, v.String())
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownCommand, args[0])
+}
+
+//Complete tab completes the last word of the line, which can be in an open quote. Returns the completed line and every value the word could be completed to.
+// The command and variable names are completed, then the arguments of commands that have a Complete function and bool variables.
+func (console *Console) Complete(line string) (string, []string) {
+	//The word being typed, which is a new empty one after a space
+	args, _, last := scanConsoleArgs(line)
+	if last < 0 {
+		args, last = append(args, ""), len(line)
+	}
+	prefix := args[len(args)-1]
+
+	var candidates []string
+	if len(args) == 1 {
+		candidates = console.names()
+	} else if command, ok := console.commands[args[0]]; ok && command.Complete != nil {
+		candidates = command.Complete(args[1:])
+	} else if v, ok := console.vars[args[0]]; ok && len(args) == 2 {
+		if _, ok := v.value.(*bool); ok {
+			candidates = []string{"false", "true"}
+		}
+	}
+
+	matches := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 0 {
+		return line, nil
+	}
+	sort.Strings(matches)
+
+	//The common prefix of the matches, taking whole runes off so a character is never split
+	completed := matches[0]
+	for _, match := range matches[1:] {
+		for !strings.HasPrefix(match, completed) {
+			_, size := utf8.DecodeLastRuneInString(completed)
+			completed = completed[:len(completed)-size]
+		}
+	}
+	if strings.ContainsAny(completed, " \t\"'\\") {
+		//The quote is left open while there is more than one match, so the word can still be typed
+		completed = strconv.Quote(completed)
+		if len(matches) > 1 {
+			completed = completed[:len(completed)-1]
+		}
+	}
+	if len(matches) == 1 {
+		completed += " "
+	}
+	return line[:last] + completed, matches
+}
+
+//names gets the names of the commands and variables, sorted
+func (console *Console) names() []string {
+	names := make([]string, 0, len(console.commands)+len(console.vars))
+	for name := range console.commands {
+		names = append(names, name)
+	}
+	for name := range console.vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//Toggle opens or closes the overlay
+func (console *Console) Toggle() {
+	console.SetVisible(!console.visible)
+}
+
+//SetVisible opens or closes the overlay
+func (console *Console) SetVisible(visible bool) {
+	console.visible = visible
+	console.skipInput = true
+}
+
+//IsVisible returns true if the overlay is open
+func (console *Console) IsVisible() bool {
+	return console.visible
+}
+
+//Update handles the keys of the overlay: the ToggleKey, Enter to execute, Tab to complete, Up and Down for the history and PageUp and PageDown to scroll.
+// Call it every frame before Draw.
+func (console *Console) Update() {
+	if IsKeyPressed(console.ToggleKey) {
+		console.Toggle()
+	}
+	if !console.visible {
+		return
+	}
+
+	switch {
+	case IsKeyPressed(KeyEnter):
+		console.Execute(console.input)
+		console.setInput("")
+		console.scroll = 0
+
+	case IsKeyPressed(KeyTab):
+		completed, matches := console.Complete(console.input)
+		if len(matches) > 1 {
+			console.Print(strings.Join(matches, "  "))
+		}
+		console.setInput(completed)
+
+	case IsKeyPressed(KeyUp) && console.historyIndex > 0:
+		console.historyIndex--
+		console.setInput(console.history[console.historyIndex])
+
+	case IsKeyPressed(KeyDown) && console.historyIndex < len(console.history):
+		console.historyIndex++
+		if console.historyIndex == len(console.history) {
+			console.setInput("")
+		} else {
+			console.setInput(console.history[console.historyIndex])
+		}
+
+	case IsKeyPressed(KeyPageUp):
+		console.scroll += console.rows() / 2
+
+	case IsKeyPressed(KeyPageDown):
+		console.scroll -= console.rows() / 2
+		if console.scroll < 0 {
+			console.scroll = 0
+		}
+	}
+}
+
+//setInput replaces the text in the input box and moves the cursor to its end
+func (console *Console) setInput(text string) {
+	console.input = text
+	GuiTextBoxSetCursor(len(text))
+}
+
+//rows gets the number of log lines that fit in the overlay
+func (console *Console) rows() int {
+	lineHeight := console.FontSize + 2
+	height := float32(GetScreenHeight())*console.Height - console.inputHeight() - 8
+	if rows := int(height / lineHeight); rows > 0 {
+		return rows
+	}
+	return 1
+}
+
+func (console *Console) inputHeight() float32 {
+	return console.FontSize + 14
+}
+
+//Draw draws the overlay at the top of the screen, if it is open. Call it last, so it is on top.
+func (console *Console) Draw() {
+	if !console.visible {
+		return
+	}
+
+	width := float32(GetScreenWidth())
+	height := float32(GetScreenHeight()) * console.Height
+	DrawRectangleRec(NewRectangle(0, 0, width, height), console.Background)
+
+	font := defaultFont()
+	if console.Font != nil {
+		font = *console.Font
+	}
+
+	//The latest lines are at the bottom, just above the input
+	entries := console.Entries()
+	rows := console.rows()
+	if console.scroll > len(entries)-rows {
+		console.scroll = len(entries) - rows
+	}
+	if console.scroll < 0 {
+		console.scroll = 0
+	}
+	end := len(entries) - console.scroll
+	start := end - rows
+	if start < 0 {
+		start = 0
+	}
+
+	lineHeight := console.FontSize + 2
+	y := height - console.inputHeight() - 4 - lineHeight*float32(end-start)
+	for _, entry := range entries[start:end] {
+		DrawTextEx(font, entry.Text, NewVector2(4, y), console.FontSize, 1, consoleColor(entry.Type))
+		y += lineHeight
+	}
+
+	//The key that opened the console is still in the key queue on the first frame, so the box is not edited then
+	bounds := NewRectangle(4, height-console.inputHeight()-2, width-8, console.inputHeight())
+	_, text := GuiTextBox(bounds, console.input, consoleInputSize, !console.skipInput)
+	if !console.skipInput {
+		console.input = text
+	}
+	console.skipInput = false
+}
+
+//consoleColor gets the colour a log type is drawn in
+func consoleColor(logType TraceLogType) Color {
+	switch {
+	case logType >= LogError:
+		return Red
+	case logType == LogWarning:
+		return Yellow
+	case logType <= LogDebug:
+		return Gray
+	default:
+		return RayWhite
+	}
+}
+
+//parseConsoleArgs splits a command line into its arguments. Arguments are split by spaces, unless they are in double or single quotes.
+// A backslash escapes the next character.
+func parseConsoleArgs(line string) ([]string, error) {
+	args, quote, _ := scanConsoleArgs(line)
+	if quote != 0 {
+		return nil, fmt.Errorf("missing closing %c", quote)
+	}
+	if len(args) == 0 {
+		args = append(args, "")
+	}
+	return args, nil
+}
+
+//scanConsoleArgs splits the line like parseConsoleArgs, but keeps the argument of a quote that is not closed.
+// Returns the quote that is still open, and the index in the line the last argument starts at, or -1 if the line does not end in one.
+func scanConsoleArgs(line string) ([]string, rune, int) {
+	args := make([]string, 0)
+	var current strings.Builder
+	inArg := false
+	start := -1
+	quote := rune(0)
+	escaped := false
+
+	for i, r := range line {
+		if !inArg && r != ' ' && r != '\t' {
+			start = i
+		}
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+			inArg = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if !inArg {
+		return args, quote, -1
+	}
+	return append(args, current.String()), quote, start
+}
+
+//String gets the value of the variable
+func (v *consoleVar) String() string {
+	switch value := v.value.(type) {
+	case *string:
+		return strconv.Quote(*value)
+	case *bool:
+		return strconv.FormatBool(*value)
+	case *int:
+		return strconv.Itoa(*value)
+	case *int32:
+		return strconv.FormatInt(int64(*value), 10)
+	case *float32:
+		return strconv.FormatFloat(float64(*value), 'g', -1, 32)
+	case *float64:
+		return strconv.FormatFloat(*value, 'g', -1, 64)
+	}
+	return ""
+}
+
+//set parses the text into the variable
+func (v *consoleVar) set(text string) error {
+	switch value := v.value.(type) {
+	case *string:
+		*value = text
+		return nil
+	case *bool:
+		parsed, err := strconv.ParseBool(text)
+		if err == nil {
+			*value = parsed
+		}
+		return consoleParseError(v, text, err)
+	case *int:
+		parsed, err := strconv.Atoi(text)
+		if err == nil {
+			*value = parsed
+		}
+		return consoleParseError(v, text, err)
+	case *int32:
+		parsed, err := strconv.ParseInt(text, 10, 32)
+		if err == nil {
+			*value = int32(parsed)
+		}
+		return consoleParseError(v, text, err)
+	case *float32:
+		parsed, err := strconv.ParseFloat(text, 32)
+		if err == nil {
+			*value = float32(parsed)
+		}
+		return consoleParseError(v, text, err)
+	case *float64:
+		parsed, err := strconv.ParseFloat(text, 64)
+		if err == nil {
+			*value = parsed
+		}
+		return consoleParseError(v, text, err)
+	}
+	return nil
+}
+
+func consoleParseError(v *consoleVar, text string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s cannot be set to %q, it is a %T", v.name, text, v.value)
+}
+
+//consoleHelp is the help command
+func consoleHelp(console *Console, args []string) error {
+	if len(args) > 0 {
+		if command, ok := console.commands[args[0]]; ok {
+			console.Printf("%s: %s", command.Name, command.Help)
+			return nil
+		}
+		if v, ok := console.vars[args[0]]; ok {
+			console.Printf("%s = %s: %s", v.name, v.String(), v.help)
+			return nil
+		}
+		return fmt.Errorf("%w: %s", ErrUnknownCommand, args[0])
+	}
+
+	for _, name := range console.names() {
+		if command, ok := console.commands[name]; ok {
+			console.Printf("%s - %s", name, command.Help)
+		} else {
+			v := console.vars[name]
+			console.Printf("%s = %s - %s", name, v.String(), v.help)
+		}
+	}
+	return nil
+}
+
+//consoleUnloadables is the unloadables command
+func consoleUnloadables(console *Console, args []string) error {
+	counts := resourceCounts()
+	if len(counts) == 0 {
+		console.Print("No unloadables are loaded")
+		return nil
+	}
+
+	scopes := make([]string, 0, len(counts))
+	for scope := range counts {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	for _, scope := range scopes {
+		types := make([]string, 0, len(counts[scope]))
+		total := 0
+		for name, count := range counts[scope] {
+			types = append(types, fmt.Sprintf("%s: %d", name, count))
+			total += count
+		}
+		sort.Strings(types)
+		console.Printf("%s (%d) %s", scope, total, strings.Join(types, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package raylib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConsoleFromManyGoroutines(t *testing.T) {
+	SetTraceLogCallback(func(logType TraceLogType, text string) {})
+	defer SetTraceLogCallback(nil)
+
+	var wait sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for j := 0; j < 20; j++ {
+				console := NewConsole(16)
+				TraceLog(LogInfo, "[TEST] ", j)
+				console.Close()
+			}
+		}()
+	}
+	wait.Wait()
+
+	//a closed console does not receive logs
+	console := NewConsole(16)
+	TraceLog(LogInfo, "[TEST] open")
+	console.Close()
+	TraceLog(LogInfo, "[TEST] closed")
+	if entries := console.Entries(); len(entries) != 1 || entries[0].Text != "[TEST] open" {
+		t.Fatalf("console has %v", entries)
+	}
+	if len(traceListeners) != 0 {
+		t.Fatalf("%d consoles are still listening", len(traceListeners))
+	}
+}
+
+func TestParseConsoleArgs(t *testing.T) {
+	tests := []struct {
+		line string
+		args []string
+	}{
+		{``, []string{""}},
+		{`   `, []string{""}},
+		{`speed 2.5`, []string{"speed", "2.5"}},
+		{"  spaced \t out  ", []string{"spaced", "out"}},
+		{`spawn "big tree" 'a b'`, []string{"spawn", "big tree", "a b"}},
+		{`say "it's" '"quoted"'`, []string{"say", "it's", `"quoted"`}},
+		{`empty "" ''`, []string{"empty", "", ""}},
+		{`joined"in the"middle`, []string{"joinedin themiddle"}},
+		{`escaped\ space \"quote\" back\\slash`, []string{"escaped space", `"quote"`, `back\slash`}},
+		{`"escaped \" in quotes"`, []string{`escaped " in quotes`}},
+		{`café "naïve résumé"`, []string{"café", "naïve résumé"}},
+	}
+	for _, test := range tests {
+		args, err := parseConsoleArgs(test.line)
+		if err != nil || strings.Join(args, "|") != strings.Join(test.args, "|") || len(args) != len(test.args) {
+			t.Errorf("%q parsed as %q, %v", test.line, args, err)
+		}
+	}
+
+	for _, line := range []string{`spawn "big tree`, `say 'it`, `"`, `a "b" "c`} {
+		if args, err := parseConsoleArgs(line); err == nil {
+			t.Errorf("the unterminated %q parsed as %q", line, args)
+		}
+	}
+}
+
+func TestConsoleComplete(t *testing.T) {
+	console := NewConsole(16)
+	defer console.Close()
+	god := false
+	console.RegisterVar("god", "", &god)
+	console.Register("spawn", "", func(console *Console, args []string) error { return nil }).SetCompleter(func(args []string) []string {
+		if len(args) == 1 {
+			return []string{"big tree", "big rock", "bush", "café", "cafë", `back\slash`}
+		}
+		return nil
+	})
+
+	tests := []struct {
+		line    string
+		want    string
+		matches string
+	}{
+		{"", "", "clear,god,help,spawn,unloadables"},
+		{"sp", "spawn ", "spawn"},
+		{"  sp", "  spawn ", "spawn"},
+		{"zz", "zz", ""},
+		{"spawn ", "spawn ", "back\\slash,big rock,big tree,bush,café,cafë"},
+		{"spawn bu", "spawn bush ", "bush"},
+		{"spawn bush x", "spawn bush x", ""},
+		//the common prefix needs quoting, so the quote is left open
+		{"spawn bi", `spawn "big `, "big rock,big tree"},
+		{"spawn big\\ t", `spawn "big tree" `, "big tree"},
+		//completing inside an open quote
+		{`spawn "big t`, `spawn "big tree" `, "big tree"},
+		{`spawn 'big r`, `spawn "big rock" `, "big rock"},
+		{`spawn "b`, `spawn b`, "back\\slash,big rock,big tree,bush"},
+		{`spawn "big `, `spawn "big `, "big rock,big tree"},
+		{`spawn ba`, `spawn "back\\slash" `, "back\\slash"},
+		//the common prefix never splits a rune
+		{"spawn ca", "spawn caf", "café,cafë"},
+		{"spawn café", "spawn café ", "café"},
+		{"god t", "god true ", "true"},
+		{"god true ", "god true ", ""},
+		{"help un", "help unloadables ", "unloadables"},
+	}
+	for _, test := range tests {
+		line, matches := console.Complete(test.line)
+		if line != test.want || strings.Join(matches, ",") != test.matches {
+			t.Errorf("%q completed to %q with %q, not %q with %q", test.line, line, matches, test.want, test.matches)
+		}
+	}
+}
+
+func TestConsoleVars(t *testing.T) {
+	console := NewConsole(16)
+	defer console.Close()
+
+	var (
+		flag    bool
+		count   int
+		small   int32
+		speed   float32
+		precise float64
+		name    string
+	)
+	for key, value := range map[string]interface{}{"flag": &flag, "count": &count, "small": &small, "speed": &speed, "precise": &precise, "name": &name} {
+		if err := console.RegisterVar(key, "", value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if console.RegisterVar("value", "", speed) == nil || console.RegisterVar("uint", "", new(uint)) == nil {
+		t.Fatal("a variable that is not a supported pointer was registered")
+	}
+
+	for _, line := range []string{"flag true", "count -3", "small 12", "speed 2.5", "precise 0.125", `name "big tree"`} {
+		if err := console.Execute(line); err != nil {
+			t.Fatalf("%s: %v", line, err)
+		}
+	}
+	if !flag || count != -3 || small != 12 || speed != 2.5 || precise != 0.125 || name != "big tree" {
+		t.Fatalf("the variables were set to %v %v %v %v %v %q", flag, count, small, speed, precise, name)
+	}
+	entries := console.Entries()
+	if entries[1].Text != "flag = true" || entries[len(entries)-1].Text != `name = "big tree"` {
+		t.Fatalf("the console printed %q and %q", entries[1].Text, entries[len(entries)-1].Text)
+	}
+
+	//a value that does not parse is an error, and leaves the variable as it was
+	for _, line := range []string{"flag maybe", "count 1.5", "small 3000000000", "speed fast", "precise 1e", "count 1 2"} {
+		if err := console.Execute(line); err == nil {
+			t.Errorf("%s did not fail", line)
+		}
+	}
+	if !flag || count != -3 || small != 12 || speed != 2.5 || precise != 0.125 {
+		t.Fatalf("failing to set the variables changed them to %v %v %v %v %v", flag, count, small, speed, precise)
+	}
+	if entries := console.Entries(); entries[len(entries)-1].Type != LogError || entries[len(entries)-1].Text != "count takes a single value" {
+		t.Fatalf("the error was logged as %+v", entries[len(entries)-1])
+	}
+
+	//typing the name prints the value, and commands and variables replace each other
+	console.Execute("speed")
+	if entries := console.Entries(); entries[len(entries)-1].Text != "speed = 2.5" {
+		t.Fatalf("reading the variable printed %q", entries[len(entries)-1].Text)
+	}
+	console.Register("speed", "", func(console *Console, args []string) error { return nil })
+	if err := console.Execute("speed 4"); err != nil || speed != 2.5 {
+		t.Fatalf("the command did not replace the variable, %v", err)
+	}
+	if err := console.Execute("missing"); !errors.Is(err, ErrUnknownCommand) {
+		t.Fatalf("an unknown command failed with %v", err)
+	}
+}
+
+func TestConsoleLog(t *testing.T) {
+	console := NewConsole(3)
+	console.Close()
+
+	//each line is its own entry, and trailing newlines are dropped
+	console.Log(LogWarning, "first\nsecond\n")
+	entries := console.Entries()
+	if len(entries) != 2 || entries[0].Text != "first" || entries[1].Text != "second" || entries[1].Type != LogWarning {
+		t.Fatalf("the lines were logged as %+v", entries)
+	}
+
+	//the ring buffer keeps the latest lines, oldest first, however far it has wrapped around
+	for i := 0; i < 7; i++ {
+		console.Log(LogInfo, fmt.Sprint(i))
+		texts := make([]string, 0)
+		for _, entry := range console.Entries() {
+			texts = append(texts, entry.Text)
+		}
+		want := []string{"first", "second"}
+		for j := 0; j <= i; j++ {
+			want = append(want, fmt.Sprint(j))
+		}
+		if got := strings.Join(texts, " "); got != strings.Join(want[len(want)-3:], " ") {
+			t.Fatalf("after logging %d the entries are %q", i, got)
+		}
+	}
+	console.Log(LogError, "a\nb\nc\nd")
+	if entries := console.Entries(); len(entries) != 3 || entries[0].Text != "b" || entries[2].Text != "d" {
+		t.Fatalf("a log longer than the buffer left %+v", entries)
+	}
+
+	console.Clear()
+	console.Log(LogInfo, "after")
+	if entries := console.Entries(); len(entries) != 1 || entries[0].Text != "after" {
+		t.Fatalf("clearing left %+v", entries)
+	}
+}
@@ -2030,7 +2030,14 @@ char **GetDirectoryFiles(const char *dirPath, int *fileCount)
 
         while ((ent = readdir(dir)) != NULL)
         {
-            strcpy(dirFilesPath[counter], ent->d_name);
+            if (counter >= MAX_DIRECTORY_FILES)
+            {
+                TraceLog(LOG_WARNING, "[%s] Directory has more than %i files, the rest are skipped", dirPath, MAX_DIRECTORY_FILES);
+                break;
+            }
+
+            strncpy(dirFilesPath[counter], ent->d_name, MAX_FILEPATH_LENGTH - 1);
+            dirFilesPath[counter][MAX_FILEPATH_LENGTH - 1] = '\0';
             counter++;
         }
 
@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -10,14 +10,24 @@ import "C"
 
 //DrawLine3D : Draw a line in 3D world space
 func DrawLine3D(startPos Vector3, endPos Vector3, color Color) {
+	checkMainThread("DrawLine3D")
 	ccolor := *color.cptr()
 	cendPos := *endPos.cptr()
 	cstartPos := *startPos.cptr()
 	C.DrawLine3D(cstartPos, cendPos, ccolor)
 }
 
+//DrawPoint3D : Draw a point in 3D space, actually a small line
+func DrawPoint3D(position Vector3, color Color) {
+	checkMainThread("DrawPoint3D")
+	ccolor := *color.cptr()
+	cposition := *position.cptr()
+	C.DrawPoint3D(cposition, ccolor)
+}
+
 //DrawCircle3D : Draw a circle in 3D world space
 func DrawCircle3D(center Vector3, radius float32, rotationAxis Vector3, rotationAngle float32, color Color) {
+	checkMainThread("DrawCircle3D")
 	ccolor := *color.cptr()
 	crotationAxis := *rotationAxis.cptr()
 	ccenter := *center.cptr()
@@ -26,6 +36,7 @@ func DrawCircle3D(center Vector3, radius float32, rotationAxis Vector3, rotation
 
 //DrawCube : Draw cube
 func DrawCube(position Vector3, width float32, height float32, length float32, color Color) {
+	checkMainThread("DrawCube")
 	ccolor := *color.cptr()
 	cposition := *position.cptr()
 	C.DrawCube(cposition, C.float(width), C.float(height), C.float(length), ccolor)
@@ -33,6 +44,7 @@ func DrawCube(position Vector3, width float32, height float32, length float32, c
 
 //DrawCubeV : Draw cube (Vector version)
 func DrawCubeV(position Vector3, size Vector3, color Color) {
+	checkMainThread("DrawCubeV")
 	ccolor := *color.cptr()
 	csize := *size.cptr()
 	cposition := *position.cptr()
@@ -41,6 +53,7 @@ func DrawCubeV(position Vector3, size Vector3, color Color) {
 
 //DrawCubeWires : Draw cube wires
 func DrawCubeWires(position Vector3, width float32, height float32, length float32, color Color) {
+	checkMainThread("DrawCubeWires")
 	ccolor := *color.cptr()
 	cposition := *position.cptr()
 	C.DrawCubeWires(cposition, C.float(width), C.float(height), C.float(length), ccolor)
@@ -48,6 +61,7 @@ func DrawCubeWires(position Vector3, width float32, height float32, length float
 
 //DrawCubeWiresV : Draw cube wires (Vector version)
 func DrawCubeWiresV(position Vector3, size Vector3, color Color) {
+	checkMainThread("DrawCubeWiresV")
 	ccolor := *color.cptr()
 	csize := *size.cptr()
 	cposition := *position.cptr()
@@ -56,6 +70,7 @@ func DrawCubeWiresV(position Vector3, size Vector3, color Color) {
 
 //DrawCubeTexture : Draw cube textured
 func DrawCubeTexture(texture Texture2D, position Vector3, width float32, height float32, length float32, color Color) {
+	checkMainThread("DrawCubeTexture")
 	ccolor := *color.cptr()
 	cposition := *position.cptr()
 	ctexture := *texture.cptr()
@@ -64,6 +79,7 @@ func DrawCubeTexture(texture Texture2D, position Vector3, width float32, height
 
 //DrawSphere : Draw sphere
 func DrawSphere(centerPos Vector3, radius float32, color Color) {
+	checkMainThread("DrawSphere")
 	ccolor := *color.cptr()
 	ccenterPos := *centerPos.cptr()
 	C.DrawSphere(ccenterPos, C.float(radius), ccolor)
@@ -71,6 +87,7 @@ func DrawSphere(centerPos Vector3, radius float32, color Color) {
 
 //DrawSphereEx : Draw sphere with extended parameters
 func DrawSphereEx(centerPos Vector3, radius float32, rings int, slices int, color Color) {
+	checkMainThread("DrawSphereEx")
 	ccolor := *color.cptr()
 	ccenterPos := *centerPos.cptr()
 	C.DrawSphereEx(ccenterPos, C.float(radius), C.int(int32(rings)), C.int(int32(slices)), ccolor)
@@ -78,6 +95,7 @@ func DrawSphereEx(centerPos Vector3, radius float32, rings int, slices int, colo
 
 //DrawSphereWires : Draw sphere wires
 func DrawSphereWires(centerPos Vector3, radius float32, rings int, slices int, color Color) {
+	checkMainThread("DrawSphereWires")
 	ccolor := *color.cptr()
 	ccenterPos := *centerPos.cptr()
 	C.DrawSphereWires(ccenterPos, C.float(radius), C.int(int32(rings)), C.int(int32(slices)), ccolor)
@@ -85,6 +103,7 @@ func DrawSphereWires(centerPos Vector3, radius float32, rings int, slices int, c
 
 //DrawCylinder : Draw a cylinder/cone
 func DrawCylinder(position Vector3, radiusTop float32, radiusBottom float32, height float32, slices int, color Color) {
+	checkMainThread("DrawCylinder")
 	ccolor := *color.cptr()
 	cposition := *position.cptr()
 	C.DrawCylinder(cposition, C.float(radiusTop), C.float(radiusBottom), C.float(height), C.int(int32(slices)), ccolor)
@@ -92,6 +111,7 @@ func DrawCylinder(position Vector3, radiusTop float32, radiusBottom float32, hei
 
 //DrawCylinderWires : Draw a cylinder/cone wires
 func DrawCylinderWires(position Vector3, radiusTop float32, radiusBottom float32, height float32, slices int, color Color) {
+	checkMainThread("DrawCylinderWires")
 	ccolor := *color.cptr()
 	cposition := *position.cptr()
 	C.DrawCylinderWires(cposition, C.float(radiusTop), C.float(radiusBottom), C.float(height), C.int(int32(slices)), ccolor)
@@ -99,6 +119,7 @@ func DrawCylinderWires(position Vector3, radiusTop float32, radiusBottom float32
 
 //DrawPlane : Draw a plane XZ
 func DrawPlane(centerPos Vector3, size Vector2, color Color) {
+	checkMainThread("DrawPlane")
 	ccolor := *color.cptr()
 	csize := *size.cptr()
 	ccenterPos := *centerPos.cptr()
@@ -107,6 +128,7 @@ func DrawPlane(centerPos Vector3, size Vector2, color Color) {
 
 //DrawRay : Draw a ray line
 func DrawRay(ray Ray, color Color) {
+	checkMainThread("DrawRay")
 	ccolor := *color.cptr()
 	cray := *ray.cptr()
 	C.DrawRay(cray, ccolor)
@@ -114,11 +136,13 @@ func DrawRay(ray Ray, color Color) {
 
 //DrawGrid : Draw a grid (centered at (0, 0, 0))
 func DrawGrid(slices int, spacing float32) {
+	checkMainThread("DrawGrid")
 	C.DrawGrid(C.int(int32(slices)), C.float(spacing))
 }
 
 //DrawGizmo : Draw simple gizmo
 func DrawGizmo(position Vector3) {
+	checkMainThread("DrawGizmo")
 	cposition := *position.cptr()
 	C.DrawGizmo(cposition)
 }
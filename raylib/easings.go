@@ -0,0 +1,247 @@
+package raylib
+
+/*
+Robert Penner's easing equations, ported from easings.h so they can be used without cgo.
+source: https://github.com/raysan5/raylib/blob/master/src/easings.h
+
+Every function takes the same arguments:
+	t: current time (from 0 to d)
+	b: starting value
+	c: change in value (end - b)
+	d: total duration
+*/
+
+import "math"
+
+//EasingFunction is the signature of the Penner easing functions, ie: EaseSineIn
+type EasingFunction func(t float32, b float32, c float32, d float32) float32
+
+func sinf(v float32) float32            { return float32(math.Sin(float64(v))) }
+func cosf(v float32) float32            { return float32(math.Cos(float64(v))) }
+func sqrtf(v float32) float32           { return float32(math.Sqrt(float64(v))) }
+func powf(x float32, y float32) float32 { return float32(math.Pow(float64(x), float64(y))) }
+
+//EaseLinearNone : Linear easing
+func EaseLinearNone(t float32, b float32, c float32, d float32) float32 { return c*t/d + b }
+
+//EaseLinearIn : Linear easing
+func EaseLinearIn(t float32, b float32, c float32, d float32) float32 { return c*t/d + b }
+
+//EaseLinearOut : Linear easing
+func EaseLinearOut(t float32, b float32, c float32, d float32) float32 { return c*t/d + b }
+
+//EaseLinearInOut : Linear easing
+func EaseLinearInOut(t float32, b float32, c float32, d float32) float32 { return c*t/d + b }
+
+//EaseSineIn : Sine easing in
+func EaseSineIn(t float32, b float32, c float32, d float32) float32 {
+	return -c*cosf(t/d*(PI/2)) + c + b
+}
+
+//EaseSineOut : Sine easing out
+func EaseSineOut(t float32, b float32, c float32, d float32) float32 {
+	return c*sinf(t/d*(PI/2)) + b
+}
+
+//EaseSineInOut : Sine easing in and out
+func EaseSineInOut(t float32, b float32, c float32, d float32) float32 {
+	return -c/2*(cosf(PI*t/d)-1) + b
+}
+
+//EaseCircIn : Circular easing in
+func EaseCircIn(t float32, b float32, c float32, d float32) float32 {
+	t /= d
+	return -c*(sqrtf(1-t*t)-1) + b
+}
+
+//EaseCircOut : Circular easing out
+func EaseCircOut(t float32, b float32, c float32, d float32) float32 {
+	t = t/d - 1
+	return c*sqrtf(1-t*t) + b
+}
+
+//EaseCircInOut : Circular easing in and out
+func EaseCircInOut(t float32, b float32, c float32, d float32) float32 {
+	if t /= d / 2; t < 1 {
+		return -c/2*(sqrtf(1-t*t)-1) + b
+	}
+	t -= 2
+	return c/2*(sqrtf(1-t*t)+1) + b
+}
+
+//EaseCubicIn : Cubic easing in
+func EaseCubicIn(t float32, b float32, c float32, d float32) float32 {
+	t /= d
+	return c*t*t*t + b
+}
+
+//EaseCubicOut : Cubic easing out
+func EaseCubicOut(t float32, b float32, c float32, d float32) float32 {
+	t = t/d - 1
+	return c*(t*t*t+1) + b
+}
+
+//EaseCubicInOut : Cubic easing in and out
+func EaseCubicInOut(t float32, b float32, c float32, d float32) float32 {
+	if t /= d / 2; t < 1 {
+		return c/2*t*t*t + b
+	}
+	t -= 2
+	return c/2*(t*t*t+2) + b
+}
+
+//EaseQuadIn : Quadratic easing in
+func EaseQuadIn(t float32, b float32, c float32, d float32) float32 {
+	t /= d
+	return c*t*t + b
+}
+
+//EaseQuadOut : Quadratic easing out
+func EaseQuadOut(t float32, b float32, c float32, d float32) float32 {
+	t /= d
+	return -c*t*(t-2) + b
+}
+
+//EaseQuadInOut : Quadratic easing in and out
+func EaseQuadInOut(t float32, b float32, c float32, d float32) float32 {
+	if t /= d / 2; t < 1 {
+		return c/2*(t*t) + b
+	}
+	return -c/2*((t-1)*(t-3)-1) + b
+}
+
+//EaseExpoIn : Exponential easing in
+func EaseExpoIn(t float32, b float32, c float32, d float32) float32 {
+	if t == 0 {
+		return b
+	}
+	return c*powf(2, 10*(t/d-1)) + b
+}
+
+//EaseExpoOut : Exponential easing out
+func EaseExpoOut(t float32, b float32, c float32, d float32) float32 {
+	if t == d {
+		return b + c
+	}
+	return c*(-powf(2, -10*t/d)+1) + b
+}
+
+//EaseExpoInOut : Exponential easing in and out
+func EaseExpoInOut(t float32, b float32, c float32, d float32) float32 {
+	if t == 0 {
+		return b
+	}
+	if t == d {
+		return b + c
+	}
+	if t /= d / 2; t < 1 {
+		return c/2*powf(2, 10*(t-1)) + b
+	}
+	return c/2*(-powf(2, -10*(t-1))+2) + b
+}
+
+//EaseBackIn : Back easing in (overshoots backwards first)
+func EaseBackIn(t float32, b float32, c float32, d float32) float32 {
+	s := float32(1.70158)
+	t /= d
+	return c*t*t*((s+1)*t-s) + b
+}
+
+//EaseBackOut : Back easing out (overshoots the end)
+func EaseBackOut(t float32, b float32, c float32, d float32) float32 {
+	s := float32(1.70158)
+	t = t/d - 1
+	return c*(t*t*((s+1)*t+s)+1) + b
+}
+
+//EaseBackInOut : Back easing in and out
+func EaseBackInOut(t float32, b float32, c float32, d float32) float32 {
+	s := float32(1.70158 * 1.525)
+	if t /= d / 2; t < 1 {
+		return c/2*(t*t*((s+1)*t-s)) + b
+	}
+	t -= 2
+	return c/2*(t*t*((s+1)*t+s)+2) + b
+}
+
+//EaseBounceOut : Bounce easing out
+func EaseBounceOut(t float32, b float32, c float32, d float32) float32 {
+	t /= d
+	switch {
+	case t < 1/2.75:
+		return c*(7.5625*t*t) + b
+	case t < 2/2.75:
+		t -= 1.5 / 2.75
+		return c*(7.5625*t*t+0.75) + b
+	case t < 2.5/2.75:
+		t -= 2.25 / 2.75
+		return c*(7.5625*t*t+0.9375) + b
+	default:
+		t -= 2.625 / 2.75
+		return c*(7.5625*t*t+0.984375) + b
+	}
+}
+
+//EaseBounceIn : Bounce easing in
+func EaseBounceIn(t float32, b float32, c float32, d float32) float32 {
+	return c - EaseBounceOut(d-t, 0, c, d) + b
+}
+
+//EaseBounceInOut : Bounce easing in and out
+func EaseBounceInOut(t float32, b float32, c float32, d float32) float32 {
+	if t < d/2 {
+		return EaseBounceIn(t*2, 0, c, d)*0.5 + b
+	}
+	return EaseBounceOut(t*2-d, 0, c, d)*0.5 + c*0.5 + b
+}
+
+//EaseElasticIn : Elastic easing in
+func EaseElasticIn(t float32, b float32, c float32, d float32) float32 {
+	if t == 0 {
+		return b
+	}
+	if t /= d; t == 1 {
+		return b + c
+	}
+
+	p := d * 0.3
+	s := p / 4
+	t--
+	postFix := c * powf(2, 10*t)
+	return -(postFix * sinf((t*d-s)*(2*PI)/p)) + b
+}
+
+//EaseElasticOut : Elastic easing out
+func EaseElasticOut(t float32, b float32, c float32, d float32) float32 {
+	if t == 0 {
+		return b
+	}
+	if t /= d; t == 1 {
+		return b + c
+	}
+
+	p := d * 0.3
+	s := p / 4
+	return c*powf(2, -10*t)*sinf((t*d-s)*(2*PI)/p) + c + b
+}
+
+//EaseElasticInOut : Elastic easing in and out
+func EaseElasticInOut(t float32, b float32, c float32, d float32) float32 {
+	if t == 0 {
+		return b
+	}
+	if t /= d / 2; t == 2 {
+		return b + c
+	}
+
+	p := d * (0.3 * 1.5)
+	s := p / 4
+	t--
+	if t < 0 {
+		postFix := c * powf(2, 10*t)
+		return -0.5*(postFix*sinf((t*d-s)*(2*PI)/p)) + b
+	}
+
+	postFix := c * powf(2, -10*t)
+	return postFix*sinf((t*d-s)*(2*PI)/p)*0.5 + c + b
+}
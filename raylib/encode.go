@@ -0,0 +1,163 @@
+package raylib
+
+/*
+#include "raylib.h"
+#include <stdlib.h>
+#include <stdint.h>
+#include "external/stb_image_write.h"
+#ifndef GO_ENCODE
+#define GO_ENCODE
+
+void onImageWrite(void *context, void *data, int size);
+
+// stb_image_write calls onImageWrite with the writer's handle as the context
+static int Go_WritePNG(uintptr_t writer, int width, int height, const void *data) {
+  return stbi_write_png_to_func(onImageWrite, (void *)writer, width, height, 4, data, width*4);
+}
+
+static int Go_WriteBMP(uintptr_t writer, int width, int height, const void *data) {
+  return stbi_write_bmp_to_func(onImageWrite, (void *)writer, width, height, 4, data);
+}
+
+#endif
+*/
+import "C"
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/cgo"
+	"unsafe"
+)
+
+//ErrEncodeFailed is returned by the Encode functions when the encoder failed without the writer failing
+var ErrEncodeFailed = errors.New("encode failed")
+
+//wavFormat is the format tag of the fmt chunk of a .wav file
+const (
+	wavFormatPCM   = 1
+	wavFormatFloat = 3
+)
+
+//EncodeWAV writes the wave as a .wav file. 8 and 16 bit waves are PCM, 32 bit waves are IEEE float.
+func (wave *Wave) EncodeWAV(w io.Writer) error {
+	format := uint16(wavFormatPCM)
+	switch wave.SampleSize {
+	case 8, 16:
+	case 32:
+		format = wavFormatFloat
+	default:
+		return fmt.Errorf("wav: %d bit samples: %w", wave.SampleSize, ErrUnsupportedFormat)
+	}
+
+	data := wave.Bytes()
+	blockAlign := wave.Channels * wave.SampleSize / 8
+	padding := len(data) % 2
+
+	header := struct {
+		Riff          [4]byte
+		RiffSize      uint32
+		Wave          [4]byte
+		Fmt           [4]byte
+		FmtSize       uint32
+		Format        uint16
+		Channels      uint16
+		SampleRate    uint32
+		ByteRate      uint32
+		BlockAlign    uint16
+		BitsPerSample uint16
+		Data          [4]byte
+		DataSize      uint32
+	}{
+		Riff:          [4]byte{'R', 'I', 'F', 'F'},
+		RiffSize:      uint32(36 + len(data) + padding),
+		Wave:          [4]byte{'W', 'A', 'V', 'E'},
+		Fmt:           [4]byte{'f', 'm', 't', ' '},
+		FmtSize:       16,
+		Format:        format,
+		Channels:      uint16(wave.Channels),
+		SampleRate:    wave.SampleRate,
+		ByteRate:      wave.SampleRate * blockAlign,
+		BlockAlign:    uint16(blockAlign),
+		BitsPerSample: uint16(wave.SampleSize),
+		Data:          [4]byte{'d', 'a', 't', 'a'},
+		DataSize:      uint32(len(data)),
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if padding > 0 {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	return nil
+}
+
+//EncodeWaveWAV writes the wave as a .wav file
+//Recommended to use wave.EncodeWAV(w) instead
+func EncodeWaveWAV(wave *Wave, w io.Writer) error {
+	return wave.EncodeWAV(w)
+}
+
+//EncodePNG writes the image as a .png file with stb_image_write, in RGBA
+func (image *Image) EncodePNG(w io.Writer) error {
+	return image.encode(w, "png", func(writer C.uintptr_t, data unsafe.Pointer) C.int {
+		return C.Go_WritePNG(writer, C.int(image.Width), C.int(image.Height), data)
+	})
+}
+
+//EncodeImagePNG writes the image as a .png file
+//Recommended to use image.EncodePNG(w) instead
+func EncodeImagePNG(image *Image, w io.Writer) error {
+	return image.EncodePNG(w)
+}
+
+//EncodeBMP writes the image as a .bmp file with stb_image_write. BMP files have no alpha, so it is dropped.
+func (image *Image) EncodeBMP(w io.Writer) error {
+	return image.encode(w, "bmp", func(writer C.uintptr_t, data unsafe.Pointer) C.int {
+		return C.Go_WriteBMP(writer, C.int(image.Width), C.int(image.Height), data)
+	})
+}
+
+//EncodeImageBMP writes the image as a .bmp file
+//Recommended to use image.EncodeBMP(w) instead
+func EncodeImageBMP(image *Image, w io.Writer) error {
+	return image.EncodeBMP(w)
+}
+
+//imageWriter is given to stb_image_write through a handle, it keeps the first error of the writer
+type imageWriter struct {
+	w   io.Writer
+	err error
+}
+
+//encode converts the image to RGBA like ExportImage does, and writes it with the stb_image_write function
+func (image *Image) encode(w io.Writer, format string, write func(writer C.uintptr_t, data unsafe.Pointer) C.int) error {
+	if !image.IsValid() || image.Width <= 0 || image.Height <= 0 {
+		return fmt.Errorf("%s: empty image: %w", format, ErrEncodeFailed)
+	}
+	if image.Format >= CompressedDxt1Rgb {
+		return fmt.Errorf("%s: compressed image: %w", format, ErrUnsupportedFormat)
+	}
+
+	pixels := unsafe.Pointer(C.GetImageData(*image.cptr()))
+	defer C.free(pixels)
+
+	writer := &imageWriter{w: w}
+	handle := cgo.NewHandle(writer)
+	defer handle.Delete()
+
+	success := write(C.uintptr_t(handle), pixels)
+	if writer.err != nil {
+		return writer.err
+	}
+	if success == 0 {
+		return fmt.Errorf("%s: %w", format, ErrEncodeFailed)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package raylib
+
+import "C"
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+//export onImageWrite
+func onImageWrite(context unsafe.Pointer, data unsafe.Pointer, size C.int) {
+	writer := cgo.Handle(uintptr(context)).Value().(*imageWriter)
+	if writer.err == nil {
+		_, writer.err = writer.w.Write(C.GoBytes(data, size))
+	}
+}
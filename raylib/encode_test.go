@@ -0,0 +1,205 @@
+package raylib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+//testFailWriter fails every write after the first ok ones, and counts the writes
+type testFailWriter struct {
+	ok     int
+	writes int
+}
+
+var errTestWrite = errors.New("test write failed")
+
+func (w *testFailWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.writes > w.ok {
+		return 0, errTestWrite
+	}
+	return len(p), nil
+}
+
+//testEncodeImage is a 3x2 image with a different colour in every pixel
+func testEncodeImage(t *testing.T, alpha uint8) (*Image, *image.NRGBA) {
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			src.SetNRGBA(x, y, color.NRGBA{uint8(x * 100), uint8(y * 200), uint8(50 + x*y*50), 255 - alpha*uint8(x)})
+		}
+	}
+	img := LoadImageFromGo(src)
+	t.Cleanup(img.Unload)
+	return img, src
+}
+
+func TestEncodePNG(t *testing.T) {
+	img, src := testEncodeImage(t, 60)
+	var buf bytes.Buffer
+	if err := img.EncodePNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds() != src.Bounds() {
+		t.Fatalf("decoded a %v image", decoded.Bounds())
+	}
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			if got := color.NRGBAModel.Convert(decoded.At(x, y)); got != src.NRGBAAt(x, y) {
+				t.Errorf("%d,%d decoded as %v, not %v", x, y, got, src.NRGBAAt(x, y))
+			}
+		}
+	}
+}
+
+func TestEncodeBMP(t *testing.T) {
+	img, src := testEncodeImage(t, 0)
+	var buf bytes.Buffer
+	if err := img.EncodeBMP(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	//24 bit rows padded to 4 bytes, after the 14 byte file header and the 40 byte info header
+	const rowSize = 12
+	data := buf.Bytes()
+	if len(data) != 54+rowSize*2 || string(data[:2]) != "BM" {
+		t.Fatalf("wrote %d bytes starting with %q", len(data), data[:2])
+	}
+	le := binary.LittleEndian
+	header := []struct {
+		name  string
+		got   uint32
+		value uint32
+	}{
+		{"file size", le.Uint32(data[2:]), uint32(len(data))},
+		{"pixel offset", le.Uint32(data[10:]), 54},
+		{"info size", le.Uint32(data[14:]), 40},
+		{"width", le.Uint32(data[18:]), 3},
+		{"height", le.Uint32(data[22:]), 2},
+		{"planes", uint32(le.Uint16(data[26:])), 1},
+		{"bits", uint32(le.Uint16(data[28:])), 24},
+		{"compression", le.Uint32(data[30:]), 0},
+	}
+	for _, field := range header {
+		if field.got != field.value {
+			t.Errorf("the %s is %d, not %d", field.name, field.got, field.value)
+		}
+	}
+
+	//the rows are bottom up, and the pixels are BGR
+	for y := 0; y < 2; y++ {
+		row := data[54+(1-y)*rowSize:]
+		for x := 0; x < 3; x++ {
+			want := src.NRGBAAt(x, y)
+			if got := (color.NRGBA{row[x*3+2], row[x*3+1], row[x*3], 255}); got != want {
+				t.Errorf("%d,%d is %v, not %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestEncodeImageErrors(t *testing.T) {
+	img, _ := testEncodeImage(t, 0)
+
+	//the first error of the writer is returned, and nothing more is written after it. PNGs are written at once, BMPs a row at a time.
+	tests := []struct {
+		name   string
+		encode func(w *testFailWriter) error
+		ok     int
+	}{
+		{"png", func(w *testFailWriter) error { return img.EncodePNG(w) }, 0},
+		{"bmp", func(w *testFailWriter) error { return img.EncodeBMP(w) }, 0},
+		{"bmp", func(w *testFailWriter) error { return img.EncodeBMP(w) }, 1},
+	}
+	for _, test := range tests {
+		w := &testFailWriter{ok: test.ok}
+		if err := test.encode(w); err != errTestWrite || w.writes != test.ok+1 {
+			t.Errorf("%s: failing after %d writes returned %v after %d writes", test.name, test.ok, err, w.writes)
+		}
+	}
+
+	if err := (&Image{}).EncodePNG(&bytes.Buffer{}); !errors.Is(err, ErrEncodeFailed) {
+		t.Errorf("an empty image returned %v", err)
+	}
+	img.Format = CompressedDxt1Rgb
+	err := img.EncodeBMP(&bytes.Buffer{})
+	img.Format = UncompressedR8g8b8a8
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("a compressed image returned %v", err)
+	}
+}
+
+func TestEncodeWAV(t *testing.T) {
+	tests := []struct {
+		name   string
+		wave   *Wave
+		format uint16
+		bits   uint16
+	}{
+		{"8 bit", NewWaveFromSamples([]uint8{0, 128, 255}, 8000, 1), wavFormatPCM, 8},
+		{"16 bit", NewWaveFromSamples([]int16{1, -2, 300, -400, 5, 6}, 22050, 2), wavFormatPCM, 16},
+		{"32 bit", NewWaveFromSamples([]float32{0.25, -0.5, 1}, 44100, 1), wavFormatFloat, 32},
+	}
+	for _, test := range tests {
+		wave := test.wave
+		defer wave.Unload()
+		var buf bytes.Buffer
+		if err := wave.EncodeWAV(&buf); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		//odd sized data is padded to an even size, which the RIFF size counts but the data size does not
+		samples := wave.Bytes()
+		padded := len(samples) + len(samples)%2
+		data := buf.Bytes()
+		if len(data) != 44+padded || !bytes.Equal(data[44:44+len(samples)], samples) {
+			t.Fatalf("%s: wrote %d bytes for %d bytes of samples", test.name, len(data), len(samples))
+		}
+
+		le := binary.LittleEndian
+		blockAlign := uint32(wave.Channels) * uint32(test.bits) / 8
+		header := []struct {
+			name  string
+			got   uint32
+			value uint32
+		}{
+			{"RIFF size", le.Uint32(data[4:]), uint32(36 + padded)},
+			{"fmt size", le.Uint32(data[16:]), 16},
+			{"format", uint32(le.Uint16(data[20:])), uint32(test.format)},
+			{"channels", uint32(le.Uint16(data[22:])), wave.Channels},
+			{"sample rate", le.Uint32(data[24:]), wave.SampleRate},
+			{"byte rate", le.Uint32(data[28:]), wave.SampleRate * blockAlign},
+			{"block align", uint32(le.Uint16(data[32:])), blockAlign},
+			{"bits", uint32(le.Uint16(data[34:])), uint32(test.bits)},
+			{"data size", le.Uint32(data[40:]), uint32(len(samples))},
+		}
+		if string(data[0:4]) != "RIFF" || string(data[8:16]) != "WAVEfmt " || string(data[36:40]) != "data" {
+			t.Errorf("%s: the chunks are %q", test.name, data[:40])
+		}
+		for _, field := range header {
+			if field.got != field.value {
+				t.Errorf("%s: the %s is %d, not %d", test.name, field.name, field.got, field.value)
+			}
+		}
+	}
+
+	//the header, the samples and the padding are written separately, and each can fail
+	for ok := 0; ok < 3; ok++ {
+		if err := tests[0].wave.EncodeWAV(&testFailWriter{ok: ok}); err != errTestWrite {
+			t.Errorf("failing after %d writes returned %v", ok, err)
+		}
+	}
+	if err := (&Wave{SampleSize: 24}).EncodeWAV(&bytes.Buffer{}); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("a 24 bit wave returned %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package raylib
+
+import (
+	"errors"
+	"os"
+	"regexp"
+)
+
+var (
+	//ErrFileNotFound is returned by the LoadXXXXE functions when the file does not exist
+	ErrFileNotFound = errors.New("file not found")
+	//ErrUnsupportedFormat is returned by the LoadXXXXE functions when raylib cannot load files with that extension
+	ErrUnsupportedFormat = errors.New("unsupported format")
+	//ErrDecodeFailed is returned by the LoadXXXXE functions when the file exists but raylib failed to load it
+	ErrDecodeFailed = errors.New("decode failed")
+)
+
+//LoadError is the error returned by the LoadXXXXE functions.
+// Use errors.Is with ErrFileNotFound, ErrUnsupportedFormat or ErrDecodeFailed to check what went wrong.
+type LoadError struct {
+	//Function is the Load function that failed, ie: LoadTexture
+	Function string
+	//FileName is the file that was being loaded
+	FileName string
+	//Err is one of ErrFileNotFound, ErrUnsupportedFormat or ErrDecodeFailed
+	Err error
+	//Reason is the warning raylib logged about the failure, if any
+	Reason string
+}
+
+func (e *LoadError) Error() string {
+	msg := e.Function + " " + e.FileName + ": " + e.Err.Error()
+	if e.Reason != "" {
+		msg += " (" + e.Reason + ")"
+	}
+	return msg
+}
+
+//Unwrap returns the underlying ErrXXXX
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+//validatable is implemented by the loaded types that can tell if they are valid
+type validatable interface {
+	IsValid() bool
+}
+
+//sharedDefault is implemented by the loaded types that raylib falls back to a shared default for, ie: the default shader.
+// The shared default is used by everything else, so a failed load must never unload it.
+type sharedDefault interface {
+	isSharedDefault() bool
+}
+
+//loadChecked is used by the generated LoadXXXXE functions. It checks the files exist and have one of the formats (ie: ".png;.gif") before calling load.
+// The load fails if the result is not valid, or raylib logged a warning that matches the failure pattern (for loaders that fall back to a default).
+// A failed result is unloaded if needed and removed from the unloadables.
+func loadChecked(function string, formats string, failure string, load func() Unloadable, fileNames ...string) error {
+	fileName := ""
+	for _, f := range fileNames {
+		if f == "" {
+			continue
+		}
+		if fileName == "" {
+			fileName = f
+		}
+
+		if _, err := os.Stat(f); err != nil {
+			return &LoadError{Function: function, FileName: f, Err: ErrFileNotFound, Reason: err.Error()}
+		}
+		if formats != "" && !IsFileExtension(f, formats) {
+			return &LoadError{Function: function, FileName: f, Err: ErrUnsupportedFormat, Reason: "expected one of " + formats}
+		}
+	}
+
+	var loaded Unloadable
+	warnings := captureTraceWarnings(func() { loaded = load() })
+
+	valid := true
+	if v, ok := loaded.(validatable); ok {
+		valid = v.IsValid()
+	}
+
+	matched := false
+	if failure != "" {
+		re := regexp.MustCompile(failure)
+		for _, w := range warnings {
+			if re.MatchString(w) {
+				matched = true
+				break
+			}
+		}
+	}
+
+	if valid && !matched {
+		return nil
+	}
+
+	//Valid results here are defaults that raylib fell back to, which are ours to unload unless every failed load shares them.
+	if d, ok := loaded.(sharedDefault); valid && !(ok && d.isSharedDefault()) {
+		loaded.Unload()
+	} else {
+		UnregisterUnloadable(loaded)
+	}
+
+	reason := ""
+	if len(warnings) > 0 {
+		reason = warnings[len(warnings)-1]
+	}
+	return &LoadError{Function: function, FileName: fileName, Err: ErrDecodeFailed, Reason: reason}
+}
@@ -0,0 +1,58 @@
+package raylib
+
+import (
+	"errors"
+	"testing"
+)
+
+//testLoaded is a loaded value that records if loadChecked unloaded it
+type testLoaded struct {
+	valid    bool
+	shared   bool
+	unloaded bool
+}
+
+func (l *testLoaded) Unload() {
+	l.unloaded = true
+	UnregisterUnloadable(l)
+}
+
+func (l *testLoaded) IsValid() bool { return l.valid }
+
+func (l *testLoaded) isSharedDefault() bool { return l.shared }
+
+func TestLoadCheckedFallbacks(t *testing.T) {
+	tests := []struct {
+		name     string
+		valid    bool
+		shared   bool
+		unloaded bool
+	}{
+		{"invalid", false, false, false},
+		{"own fallback", true, false, true},
+		{"shared default", true, true, false},
+	}
+
+	for _, test := range tests {
+		scope := NewResourceScope(test.name)
+		BeginResourceScope(scope)
+		loaded := &testLoaded{valid: test.valid, shared: test.shared}
+		err := loadChecked("LoadTest", "", "could not be", func() Unloadable {
+			//raylib warns that the image could not be loaded, like a failed load would
+			LoadImage("testdata/missing.png").Unload()
+			RegisterUnloadable(loaded)
+			return loaded
+		})
+		EndResourceScope()
+
+		if !errors.Is(err, ErrDecodeFailed) {
+			t.Errorf("%s: err = %v, want ErrDecodeFailed", test.name, err)
+		}
+		if loaded.unloaded != test.unloaded {
+			t.Errorf("%s: unloaded = %v, want %v", test.name, loaded.unloaded, test.unloaded)
+		}
+		if scope.Len() != 0 {
+			t.Errorf("%s: the result is still registered", test.name)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package raylib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetDirectoryFilesOver512(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 600; i++ {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("file%03d.txt", i)), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names, err := GetDirectoryFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 600 {
+		t.Fatalf("got %d names, want 600", len(names))
+	}
+	if names[0] != "file000.txt" || names[599] != "file599.txt" {
+		t.Errorf("names are not sorted: %s ... %s", names[0], names[599])
+	}
+
+	if _, err := GetDirectoryFiles(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error for a missing directory")
+	}
+}
@@ -5,24 +5,37 @@ package raylib
 import "C"
 import "unsafe"
 
+//CharInfo is the glyph information of a single character in a Font
 type CharInfo struct {
-	Value    uint32
-	OffsetX  uint32
-	OffsetY  uint32
-	AdvanceX uint32
-	Image    Image
+	// Character value (Unicode codepoint)
+	Value rune
+	// Character offset X when drawing
+	OffsetX int32
+	// Character offset Y when drawing
+	OffsetY int32
+	// Character advance position X
+	AdvanceX int32
+	// Character image data
+	Image Image
 }
 
 func newCharInfoFromPointer(ptr unsafe.Pointer) CharInfo {
 	return *(*CharInfo)(ptr)
 }
 
+//Font includes the texture atlas and the glyph information of each character.
+// The glyphs are in C memory, use GetChars and GetRecs to get them as slices that are CharCount long.
 type Font struct {
-	BaseSize  int32
+	// Base size (default chars height)
+	BaseSize int32
+	// Number of characters
 	CharCount int32
-	Texture   Texture2D
-	Chars     []CharInfo
-	Recs      *Rectangle
+	// Characters texture atlas
+	Texture Texture2D
+	// Characters rectangles in texture
+	recs *Rectangle
+	// Characters info data
+	chars *CharInfo
 }
 
 //FontType defines generation method of the font
@@ -44,3 +57,127 @@ func (f *Font) cptr() *C.Font {
 func newFontFromPointer(ptr unsafe.Pointer) *Font {
 	return (*Font)(ptr)
 }
+
+//IsValid returns true if the font has a texture
+func (f *Font) IsValid() bool {
+	return f.Texture.Id > 0
+}
+
+//isSharedDefault returns true if this is the default font, which LoadFont falls back to
+func (f *Font) isSharedDefault() bool {
+	return f.Texture.Id > 0 && f.Texture.Id == uint32(C.GetFontDefault().texture.id)
+}
+
+//NewFontFromGlyphs creates a font from the glyphs and their rectangles in the texture atlas, which must be the same length.
+// The glyphs are copied into C memory, and the font owns the texture and the images of the glyphs once it is made.
+func NewFontFromGlyphs(baseSize int32, texture Texture2D, chars []CharInfo, recs []Rectangle) *Font {
+	font := &Font{BaseSize: baseSize, CharCount: int32(len(chars)), Texture: texture}
+	if len(chars) > 0 {
+		font.chars = (*CharInfo)(C.calloc(C.size_t(len(chars)), C.size_t(unsafe.Sizeof(CharInfo{}))))
+		font.recs = (*Rectangle)(C.calloc(C.size_t(len(chars)), C.size_t(unsafe.Sizeof(Rectangle{}))))
+		copy(font.GetChars(), chars)
+		copy(font.GetRecs(), recs)
+	}
+	RegisterUnloadable(font)
+	return font
+}
+
+//GetChars returns the glyph information of the font as a slice. The slice points to the C memory of the font.
+func (f *Font) GetChars() []CharInfo {
+	if f.chars == nil {
+		return nil
+	}
+	return unsafe.Slice(f.chars, f.CharCount)
+}
+
+//GetRecs returns the rectangles of each glyph in the texture atlas as a slice. The slice points to the C memory of the font.
+func (f *Font) GetRecs() []Rectangle {
+	if f.recs == nil {
+		return nil
+	}
+	return unsafe.Slice(f.recs, f.CharCount)
+}
+
+//textCharacterNotFound is the index raylib falls back to when a font does not have a codepoint. From raylib/text.c
+const textCharacterNotFound = 63
+
+//GlyphIndex gets the index position of a codepoint in Chars and Recs.
+// This is the same lookup as GetGlyphIndex, including the fallback, but it is done on the Go side.
+func (f *Font) GlyphIndex(codepoint rune) int {
+	for i, c := range f.GetChars() {
+		if c.Value == codepoint {
+			return i
+		}
+	}
+	return textCharacterNotFound
+}
+
+//GetGlyph gets the glyph information and the rectangle in the texture atlas for a codepoint.
+func (f *Font) GetGlyph(codepoint rune) (CharInfo, Rectangle) {
+	index := f.GlyphIndex(codepoint)
+	if index >= int(f.CharCount) {
+		return CharInfo{}, Rectangle{}
+	}
+	return f.GetChars()[index], f.GetRecs()[index]
+}
+
+//TextGlyphs gets the glyph index of every character in the text, in the order they are drawn.
+// The text is decoded by GetCodepoints, which agrees with Go's rune iteration and with raylib's own decoding.
+func (f *Font) TextGlyphs(text string) []int {
+	codepoints := GetCodepoints(text)
+	glyphs := make([]int, len(codepoints))
+	for i, codepoint := range codepoints {
+		glyphs[i] = f.GlyphIndex(codepoint)
+	}
+	return glyphs
+}
+
+//MeasureCodepoints measures the size of already decoded text, in the same way as MeasureTextEx does.
+func (f *Font) MeasureCodepoints(codepoints []rune, fontSize float32, spacing float32) Vector2 {
+	textWidth := float32(0)
+	tempTextWidth := float32(0)
+	textHeight := float32(f.BaseSize)
+	scaleFactor := fontSize / float32(f.BaseSize)
+
+	lenCounter := 0
+	tempLen := 0
+
+	for _, codepoint := range codepoints {
+		lenCounter++
+
+		if codepoint != '\n' {
+			glyph, rec := f.GetGlyph(codepoint)
+			if glyph.AdvanceX != 0 {
+				textWidth += float32(glyph.AdvanceX)
+			} else {
+				textWidth += rec.Width + float32(glyph.OffsetX)
+			}
+		} else {
+			if tempTextWidth < textWidth {
+				tempTextWidth = textWidth
+			}
+			lenCounter = 0
+			textWidth = 0
+			textHeight += float32(f.BaseSize) * 1.5
+		}
+
+		if tempLen < lenCounter {
+			tempLen = lenCounter
+		}
+	}
+
+	if tempTextWidth < textWidth {
+		tempTextWidth = textWidth
+	}
+
+	return Vector2{
+		X: tempTextWidth*scaleFactor + float32(tempLen-1)*spacing,
+		Y: textHeight * scaleFactor,
+	}
+}
+
+//defaultFont gets the default font without registering it as an Unloadable, for drawing it every frame
+func defaultFont() Font {
+	res := C.GetFontDefault()
+	return *newFontFromPointer(unsafe.Pointer(&res))
+}
@@ -0,0 +1,285 @@
+package raylib
+
+/*
+#include "utils.h"
+#include "raylib.h"
+#include <stdlib.h>
+
+extern unsigned char *onVirtualFileRead(uintptr_t context, char *fileName, unsigned int *bytesRead);
+
+static unsigned char *virtualFileRead(uintptr_t context, const char *fileName, unsigned int *bytesRead) {
+	return onVirtualFileRead(context, (char *)fileName, bytesRead);
+}
+
+static void setVirtualFileSystem(uintptr_t context) {
+	SetVirtualFileSystem(context == 0 ? NULL : virtualFileRead, context);
+}
+*/
+import "C"
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"runtime"
+	"runtime/cgo"
+	"strings"
+	"unsafe"
+)
+
+//The formats of the loaders, the same as their LoadXXXXE functions check
+const (
+	imageFormats = ".png;.gif;.dds;.hdr"
+	fontFormats  = ".ttf;.otf;.fnt;.png;.gif;.dds;.hdr"
+	modelFormats = ".obj;.iqm;.gltf;.glb"
+	waveFormats  = ".wav;.ogg;.flac;.mp3"
+	musicFormats = ".wav;.ogg;.flac;.mp3;.xm;.mod"
+)
+
+//shaderInclude matches the #include "file" lines that LoadShaderFromFS replaces with the file
+var shaderInclude = regexp.MustCompile(`^\s*#include\s+["<]([^">]+)[">]`)
+
+//virtualFS is what the files raylib opens while loading from a file system or memory are read from
+type virtualFS struct {
+	//dir is the directory of the file being loaded, the files it depends on are looked for relative to it first
+	dir  string
+	read func(name string) ([]byte, error)
+}
+
+//open reads the file raylib asked for, relative to the directory of the file being loaded or from the root
+func (vfs *virtualFS) open(name string) ([]byte, bool) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	for _, candidate := range []string{path.Join(vfs.dir, name), path.Clean(name)} {
+		if !fs.ValidPath(candidate) {
+			continue
+		}
+		if data, err := vfs.read(candidate); err == nil {
+			return data, true
+		}
+	}
+	return nil, false
+}
+
+//LoadImageFromFS loads an image from the file system, ie: an embed.FS
+func LoadImageFromFS(fsys fs.FS, name string) (*Image, error) {
+	return loadFromFS("LoadImage", imageFormats, "", fsys, name, LoadImage)
+}
+
+//LoadImageFromMemory loads an image from the data of a file with the extension, ie: ".png"
+func LoadImageFromMemory(data []byte, ext string) (*Image, error) {
+	return loadFromMemory("LoadImage", imageFormats, "", data, ext, LoadImage)
+}
+
+//LoadTextureFromFS loads a texture from the file system, ie: an embed.FS
+func LoadTextureFromFS(fsys fs.FS, name string) (Texture2D, error) {
+	return loadFromFS("LoadTexture", imageFormats, "", fsys, name, LoadTexture)
+}
+
+//LoadTextureFromMemory loads a texture from the data of an image file with the extension, ie: ".png"
+func LoadTextureFromMemory(data []byte, ext string) (Texture2D, error) {
+	return loadFromMemory("LoadTexture", imageFormats, "", data, ext, LoadTexture)
+}
+
+//LoadWaveFromFS loads a wave from the file system, ie: an embed.FS
+func LoadWaveFromFS(fsys fs.FS, name string) (*Wave, error) {
+	return loadFromFS("LoadWave", waveFormats, "", fsys, name, LoadWave)
+}
+
+//LoadWaveFromMemory loads a wave from the data of a file with the extension, ie: ".ogg"
+func LoadWaveFromMemory(data []byte, ext string) (*Wave, error) {
+	return loadFromMemory("LoadWave", waveFormats, "", data, ext, LoadWave)
+}
+
+//LoadSoundFromFS loads a sound from the file system, ie: an embed.FS
+func LoadSoundFromFS(fsys fs.FS, name string) (*Sound, error) {
+	return loadFromFS("LoadSound", waveFormats, "", fsys, name, LoadSound)
+}
+
+//LoadSoundFromMemory loads a sound from the data of a file with the extension, ie: ".ogg"
+func LoadSoundFromMemory(data []byte, ext string) (*Sound, error) {
+	return loadFromMemory("LoadSound", waveFormats, "", data, ext, LoadSound)
+}
+
+//LoadMusicStreamFromFS loads a music stream from the file system, ie: an embed.FS. The file is read into memory and streamed from there.
+func LoadMusicStreamFromFS(fsys fs.FS, name string) (*Music, error) {
+	return loadFromFS("LoadMusicStream", musicFormats, "", fsys, name, LoadMusicStream)
+}
+
+//LoadMusicStreamFromMemory loads a music stream from the data of a file with the extension, ie: ".ogg". The data is copied, so it can be changed after.
+func LoadMusicStreamFromMemory(data []byte, ext string) (*Music, error) {
+	return loadFromMemory("LoadMusicStream", musicFormats, "", data, ext, LoadMusicStream)
+}
+
+//LoadFontFromFS loads a font from the file system, ie: an embed.FS. The image of a .fnt font is read relative to it.
+func LoadFontFromFS(fsys fs.FS, name string) (*Font, error) {
+	return loadFromFS("LoadFont", fontFormats, "Font could not be loaded", fsys, name, LoadFont)
+}
+
+//LoadFontFromMemory loads a font from the data of a file with the extension, ie: ".ttf"
+func LoadFontFromMemory(data []byte, ext string) (*Font, error) {
+	return loadFromMemory("LoadFont", fontFormats, "Font could not be loaded", data, ext, LoadFont)
+}
+
+//LoadModelFromFS loads a model from the file system, ie: an embed.FS.
+// The files it uses, like the .mtl of an .obj, the textures of its materials and the buffers of a .gltf, are read relative to it.
+func LoadModelFromFS(fsys fs.FS, name string) (*Model, error) {
+	return loadFromFS("LoadModel", modelFormats, "No meshes can be loaded", fsys, name, LoadModel)
+}
+
+//LoadModelFromMemory loads a model from the data of a file with the extension, ie: ".glb". Models that use other files cannot find them.
+func LoadModelFromMemory(data []byte, ext string) (*Model, error) {
+	return loadFromMemory("LoadModel", modelFormats, "No meshes can be loaded", data, ext, LoadModel)
+}
+
+//LoadShaderFromFS loads a shader from the file system, ie: an embed.FS. An empty name uses the default shader for that stage.
+// The shaders can #include "file" other files, relative to the including file.
+func LoadShaderFromFS(fsys fs.FS, vsName string, fsName string) (Shader, error) {
+	vsCode, err := loadShaderText(fsys, vsName)
+	if err != nil {
+		return Shader{}, err
+	}
+	fsCode, err := loadShaderText(fsys, fsName)
+	if err != nil {
+		return Shader{}, err
+	}
+	return LoadShaderFromMemory(vsCode, fsCode)
+}
+
+//LoadShaderFromMemory loads a shader from the code of each stage, like LoadShaderCode. Empty code uses the default shader for that stage.
+func LoadShaderFromMemory(vsCode []byte, fsCode []byte) (Shader, error) {
+	var shader Shader
+	err := loadChecked("LoadShaderCode", "", "Custom shader could not be loaded", func() Unloadable {
+		cvsCode, cfsCode := shaderCode(vsCode), shaderCode(fsCode)
+		defer C.free(unsafe.Pointer(cvsCode))
+		defer C.free(unsafe.Pointer(cfsCode))
+		res := C.LoadShaderCode(cvsCode, cfsCode)
+		shader = newShaderFromPointer(unsafe.Pointer(&res))
+		RegisterUnloadable(shader)
+		return shader
+	})
+	if err != nil {
+		return Shader{}, err
+	}
+	return shader, nil
+}
+
+//LoadTextFromFS loads the text of a file from the file system, ie: an embed.FS
+func LoadTextFromFS(fsys fs.FS, name string) (string, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return "", &LoadError{Function: "LoadText", FileName: name, Err: ErrFileNotFound, Reason: err.Error()}
+	}
+	return string(data), nil
+}
+
+//loadShaderText reads the shader and the files it includes, or nil for an empty name
+func loadShaderText(fsys fs.FS, name string) ([]byte, error) {
+	if name == "" {
+		return nil, nil
+	}
+	var text strings.Builder
+	if err := includeShaderText(fsys, path.Clean(name), &text, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	return []byte(text.String()), nil
+}
+
+//includeShaderText writes the shader into the text, replacing its #include lines with the files
+func includeShaderText(fsys fs.FS, name string, text *strings.Builder, including map[string]bool) error {
+	if including[name] {
+		return &LoadError{Function: "LoadShader", FileName: name, Err: ErrDecodeFailed, Reason: "#include cycle"}
+	}
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return &LoadError{Function: "LoadShader", FileName: name, Err: ErrFileNotFound, Reason: err.Error()}
+	}
+
+	including[name] = true
+	defer delete(including, name)
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for line := 1; scanner.Scan(); line++ {
+		match := shaderInclude.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			text.WriteString(scanner.Text())
+			text.WriteByte('\n')
+			continue
+		}
+		if err := includeShaderText(fsys, path.Join(path.Dir(name), match[1]), text, including); err != nil {
+			return err
+		}
+		//Put the line numbers of the errors back to this file, if the driver supports it
+		fmt.Fprintf(text, "#line %d\n", line+1)
+	}
+	return nil
+}
+
+//shaderCode gets the code as a C string, or NULL if it is empty so raylib uses the default shader
+func shaderCode(code []byte) *C.char {
+	if len(code) == 0 {
+		return nil
+	}
+	return C.CString(string(code))
+}
+
+//loadFromFS loads the file with the loader, with raylib reading the file and the files it depends on from the file system
+func loadFromFS[T Unloadable](function string, formats string, failure string, fsys fs.FS, name string, load func(fileName string) T) (T, error) {
+	var result T
+	name = path.Clean(name)
+	if _, err := fs.Stat(fsys, name); err != nil {
+		return result, &LoadError{Function: function, FileName: name, Err: ErrFileNotFound, Reason: err.Error()}
+	}
+	return loadVirtual(function, formats, failure, name, func(n string) ([]byte, error) { return fs.ReadFile(fsys, n) }, load)
+}
+
+//loadFromMemory loads the data with the loader, as if it was a file with the extension
+func loadFromMemory[T Unloadable](function string, formats string, failure string, data []byte, ext string, load func(fileName string) T) (T, error) {
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	name := "memory" + ext
+	return loadVirtual(function, formats, failure, name, func(n string) ([]byte, error) {
+		if n != name {
+			return nil, fs.ErrNotExist
+		}
+		return data, nil
+	}, load)
+}
+
+func loadVirtual[T Unloadable](function string, formats string, failure string, name string, read func(name string) ([]byte, error), load func(fileName string) T) (T, error) {
+	var result T
+	if formats != "" && !IsFileExtension(name, formats) {
+		return result, &LoadError{Function: function, FileName: name, Err: ErrUnsupportedFormat, Reason: "expected one of " + formats}
+	}
+
+	var err error
+	withVirtualFS(&virtualFS{dir: path.Dir(name), read: read}, func() {
+		err = loadChecked(function, "", failure, func() Unloadable {
+			result = load(name)
+			return result
+		})
+	})
+	if err != nil {
+		if loadErr, ok := err.(*LoadError); ok {
+			loadErr.FileName = name
+		}
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
+
+//withVirtualFS runs the function with the files raylib opens for reading on this thread read from the virtual file system
+func withVirtualFS(vfs *virtualFS, f func()) {
+	handle := cgo.NewHandle(vfs)
+	defer handle.Delete()
+
+	//The virtual file system is set on the thread, so other goroutines still load from the disk
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	C.setVirtualFileSystem(C.uintptr_t(handle))
+	defer C.setVirtualFileSystem(0)
+	f()
+}
@@ -0,0 +1,25 @@
+package raylib
+
+/*
+#include <stdint.h>
+*/
+import "C"
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+//export onVirtualFileRead
+func onVirtualFileRead(context C.uintptr_t, fileName *C.char, bytesRead *C.uint) *C.uchar {
+	vfs := cgo.Handle(context).Value().(*virtualFS)
+	data, ok := vfs.open(C.GoString(fileName))
+	if !ok {
+		return nil
+	}
+
+	//The FILE raylib reads frees the copy when it is closed
+	buffer := C.malloc(C.size_t(len(data) + 1))
+	copy(unsafe.Slice((*byte)(buffer), len(data)), data)
+	*bytesRead = C.uint(len(data))
+	return (*C.uchar)(buffer)
+}
@@ -0,0 +1,111 @@
+package raylib
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadModelFromFSResolvesRelativeFiles(t *testing.T) {
+	image := GenImageColor(2, 2, Red)
+	var png bytes.Buffer
+	err := image.EncodePNG(&png)
+	image.Unload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the .mtl and its texture are next to the model, and the ones at the root must not be used instead
+	fsys := fstest.MapFS{
+		"models/crate/crate.obj": {Data: []byte("mtllib crate.mtl\nv 0 0 0\nv 1 0 0\nv 0 1 0\nvt 0 0\nvt 1 0\nvt 0 1\nvn 0 0 1\nusemtl wood\nf 1/1/1 2/2/1 3/3/1\n")},
+		"models/crate/crate.mtl": {Data: []byte("newmtl wood\nKd 0 1 0\nmap_Kd crate.png\n")},
+		"models/crate/crate.png": {Data: png.Bytes()},
+		"crate.mtl":              {Data: []byte("newmtl wood\nKd 0 0 1\n")},
+	}
+
+	//the model is loaded on a worker, as only the uploads can be left for later without a GL context
+	loader := NewAssetLoader(context.Background(), fsys, 1)
+	loader.LoadModel("models/crate/crate.obj")
+	testDecoded(t, loader, 1)
+
+	loader.mutex.Lock()
+	decoded := loader.decoded
+	//the model is left in memory, as only the main thread can unload it in debug builds
+	loader.decoded = nil
+	loader.mutex.Unlock()
+	loader.Close()
+	if decoded[0].err != nil {
+		t.Fatal(decoded[0].err)
+	}
+
+	model := decoded[0].job.(*loadingAsset[deferredDecode[*Model], *Model]).decoded.value
+	if model.MeshCount != 1 || model.Meshes[0].VertexCount != 3 || model.MaterialCount != 1 {
+		t.Fatalf("loaded %d meshes and %d materials", model.MeshCount, model.MaterialCount)
+	}
+	diffuse := model.Materials[0].Maps[MapAlbedo]
+	if diffuse.Color != NewColor(0, 255, 0, 255) {
+		t.Fatalf("the material is %v, so the .mtl next to the model was not used", diffuse.Color)
+	}
+	if diffuse.Texture.Width != 2 || diffuse.Texture.Height != 2 {
+		t.Fatalf("the texture of the material was not loaded, it is %dx%d", diffuse.Texture.Width, diffuse.Texture.Height)
+	}
+
+	if _, err := LoadModelFromFS(fsys, "models/missing.obj"); !errors.Is(err, ErrFileNotFound) {
+		t.Fatalf("loading a missing model failed with %v", err)
+	}
+}
+
+func TestLoadShaderTextIncludes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"shaders/main.fs":         {Data: []byte("#version 330\n#include \"lib/light.glsl\"\nvoid main() {}\n")},
+		"shaders/lib/light.glsl":  {Data: []byte("#include \"common.glsl\"\nfloat light() { return 1.0; }\n")},
+		"shaders/lib/common.glsl": {Data: []byte("float common() { return 2.0; }\n")},
+		"shaders/twice.fs":        {Data: []byte("#include <lib/common.glsl>\n  #include \"lib/light.glsl\"\n")},
+		"shaders/self.fs":         {Data: []byte("#include \"self.fs\"\n")},
+		"shaders/a.glsl":          {Data: []byte("#include \"b.glsl\"\n")},
+		"shaders/b.glsl":          {Data: []byte("#include \"a.glsl\"\n")},
+		"shaders/broken.fs":       {Data: []byte("#include \"lib/missing.glsl\"\n")},
+	}
+
+	//the included files are relative to the file including them, and the line numbers go back to the including file after them
+	text, err := loadShaderText(fsys, "shaders/main.fs")
+	want := "#version 330\nfloat common() { return 2.0; }\n#line 2\nfloat light() { return 1.0; }\n#line 3\nvoid main() {}\n"
+	if err != nil || string(text) != want {
+		t.Fatalf("the shader was %q, %v", text, err)
+	}
+
+	//a file can be included more than once, as long as it does not include itself
+	text, err = loadShaderText(fsys, "shaders/twice.fs")
+	want = "float common() { return 2.0; }\n#line 2\nfloat common() { return 2.0; }\n#line 2\nfloat light() { return 1.0; }\n#line 3\n"
+	if err != nil || string(text) != want {
+		t.Fatalf("the shader was %q, %v", text, err)
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		fileName string
+	}{
+		{"shaders/self.fs", ErrDecodeFailed, "shaders/self.fs"},
+		{"shaders/a.glsl", ErrDecodeFailed, "shaders/a.glsl"},
+		{"shaders/broken.fs", ErrFileNotFound, "shaders/lib/missing.glsl"},
+		{"shaders/missing.fs", ErrFileNotFound, "shaders/missing.fs"},
+	}
+	for _, test := range tests {
+		_, err := loadShaderText(fsys, test.name)
+		var loadErr *LoadError
+		if !errors.Is(err, test.err) || !errors.As(err, &loadErr) || loadErr.FileName != test.fileName {
+			t.Errorf("%s failed with %v", test.name, err)
+		}
+	}
+
+	//the shader is not compiled when a file is missing
+	if _, err := LoadShaderFromFS(fsys, "", "shaders/broken.fs"); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("loading the shader failed with %v", err)
+	}
+	if text, err := loadShaderText(fsys, ""); text != nil || err != nil {
+		t.Errorf("an empty name read %q, %v", text, err)
+	}
+}
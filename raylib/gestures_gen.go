@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -11,53 +11,62 @@ import "unsafe"
 
 //SetGesturesEnabled : Enable a set of gestures using flags
 func SetGesturesEnabled(gestureFlags uint32) {
+	checkMainThread("SetGesturesEnabled")
 	C.SetGesturesEnabled(C.uint(gestureFlags))
 }
 
 //IsGestureDetected : Check if a gesture have been detected
 func IsGestureDetected(gesture GestureType) bool {
+	checkMainThread("IsGestureDetected")
 	res := C.IsGestureDetected(C.int(gesture))
 	return bool(res)
 }
 
 //GetGestureDetected : Get latest detected gesture
 func GetGestureDetected() GestureType {
+	checkMainThread("GetGestureDetected")
 	res := C.GetGestureDetected()
 	return GestureType(res)
 }
 
 //GetTouchPointsCount : Get touch points count
 func GetTouchPointsCount() int {
+	checkMainThread("GetTouchPointsCount")
 	res := C.GetTouchPointsCount()
 	return int(int32(res))
 }
 
 //GetGestureHoldDuration : Get gesture hold time in milliseconds
 func GetGestureHoldDuration() float32 {
+	checkMainThread("GetGestureHoldDuration")
 	res := C.GetGestureHoldDuration()
 	return float32(res)
 }
 
 //GetGestureDragVector : Get gesture drag vector
 func GetGestureDragVector() Vector2 {
+	checkMainThread("GetGestureDragVector")
 	res := C.GetGestureDragVector()
 	return newVector2FromPointer(unsafe.Pointer(&res))
 }
 
 //GetGestureDragAngle : Get gesture drag angle
 func GetGestureDragAngle() float32 {
+	checkMainThread("GetGestureDragAngle")
 	res := C.GetGestureDragAngle()
 	return float32(res)
 }
 
 //GetGesturePinchVector : Get gesture pinch delta
 func GetGesturePinchVector() Vector2 {
+	checkMainThread("GetGesturePinchVector")
 	res := C.GetGesturePinchVector()
 	return newVector2FromPointer(unsafe.Pointer(&res))
 }
 
 //GetGesturePinchAngle : Get gesture pinch angle
 func GetGesturePinchAngle() float32 {
+	checkMainThread("GetGesturePinchAngle")
 	res := C.GetGesturePinchAngle()
 	return float32(res)
 }
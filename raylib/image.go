@@ -1,10 +1,11 @@
 package raylib
 
 //#include "raylib.h"
-//#import <stdlib.h>
+//#include <stdlib.h>
 import "C"
 import (
 	"image"
+	"image/color"
 	"unsafe"
 )
 
@@ -32,20 +33,263 @@ func newImageFromPointer(ptr unsafe.Pointer) *Image {
 	return (*Image)(ptr)
 }
 
-//LoadImageFromGo Creates a new image from a Go Image
+//IsValid returns true if the image has pixel data
+func (i *Image) IsValid() bool {
+	return i.data != nil
+}
+
+//LoadImageFromGo creates a new R8G8B8A8 image from a Go image.
+//*image.NRGBA and *image.RGBA are copied straight into the raylib buffer, other images are converted pixel by pixel with color.NRGBAModel.
 func LoadImageFromGo(img image.Image) *Image {
-	size := img.Bounds().Size()
-	pixels := make([]Color, size.X*size.Y)
-
-	for y := 0; y < size.Y; y++ {
-		for x := 0; x < size.X; x++ {
-			color := img.At(x, y)
-			r, g, b, a := color.RGBA()
-			pixels[x+y*size.X] = NewColor(uint8(r), uint8(g), uint8(b), uint8(a))
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	retval := newImageRGBA(width, height)
+	RegisterUnloadable(retval)
+	if !retval.IsValid() {
+		return retval
+	}
+
+	pixels := retval.pixels()
+	stride := width * 4
+	switch src := img.(type) {
+	case *image.NRGBA:
+		for y := 0; y < height; y++ {
+			offset := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			copy(pixels[y*stride:(y+1)*stride], src.Pix[offset:offset+stride])
+		}
+
+	case *image.RGBA:
+		//Same maths as color.NRGBAModel, without boxing every pixel in a color.Color
+		for y := 0; y < height; y++ {
+			offset := src.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+			row := src.Pix[offset : offset+stride]
+			for x := 0; x < stride; x += 4 {
+				p := pixels[y*stride+x : y*stride+x+4]
+				switch a := row[x+3]; a {
+				case 0xff:
+					copy(p, row[x:x+4])
+				case 0:
+					p[0], p[1], p[2], p[3] = 0, 0, 0, 0
+				default:
+					a16 := uint32(a) * 0x101
+					p[0] = uint8((uint32(row[x]) * 0x101 * 0xffff / a16) >> 8)
+					p[1] = uint8((uint32(row[x+1]) * 0x101 * 0xffff / a16) >> 8)
+					p[2] = uint8((uint32(row[x+2]) * 0x101 * 0xffff / a16) >> 8)
+					p[3] = a
+				}
+			}
+		}
+
+	default:
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+				p := pixels[y*stride+x*4:]
+				p[0], p[1], p[2], p[3] = c.R, c.G, c.B, c.A
+			}
+		}
+	}
+
+	return retval
+}
+
+//newImageRGBA allocates an R8G8B8A8 image in C memory so raylib can free it when it is unloaded
+func newImageRGBA(width, height int) *Image {
+	retval := &Image{Width: int32(width), Height: int32(height), Mipmaps: 1, Format: UncompressedR8g8b8a8}
+	if width > 0 && height > 0 {
+		retval.data = C.malloc(C.size_t(width * height * 4))
+	}
+	return retval
+}
+
+//ToGo copies the base level of the image into a new Go image.
+//R8G8B8A8 and the 8bit formats become *image.NRGBA, Grayscale becomes *image.Gray, R32 becomes *image.Gray16 and the other float formats become *image.NRGBA64.
+//Returns nil if the image is empty or compressed.
+func (i *Image) ToGo() image.Image {
+	if !i.IsValid() || i.Format.pixelBytes() == 0 {
+		return nil
+	}
+
+	rect := i.Bounds()
+	switch i.Format {
+	case UncompressedR8g8b8a8:
+		dst := image.NewNRGBA(rect)
+		copy(dst.Pix, i.pixels())
+		return dst
+
+	case UncompressedGrayscale:
+		dst := image.NewGray(rect)
+		copy(dst.Pix, i.pixels())
+		return dst
+
+	case UncompressedR32:
+		dst := image.NewGray16(rect)
+		for y := 0; y < rect.Max.Y; y++ {
+			for x := 0; x < rect.Max.X; x++ {
+				dst.SetGray16(x, y, i.At(x, y).(color.Gray16))
+			}
+		}
+		return dst
+
+	case UncompressedR32g32b32, UncompressedR32g32b32a32:
+		dst := image.NewNRGBA64(rect)
+		for y := 0; y < rect.Max.Y; y++ {
+			for x := 0; x < rect.Max.X; x++ {
+				dst.SetNRGBA64(x, y, i.At(x, y).(color.NRGBA64))
+			}
+		}
+		return dst
+	}
+
+	dst := image.NewNRGBA(rect)
+	for y := 0; y < rect.Max.Y; y++ {
+		for x := 0; x < rect.Max.X; x++ {
+			dst.SetNRGBA(x, y, i.At(x, y).(color.NRGBA))
+		}
+	}
+	return dst
+}
+
+//ColorModel returns the Go colour model of the image format, so the image can be used as an image.Image.
+//Grayscale uses color.GrayModel, R32 uses color.Gray16Model, the other float formats use color.NRGBA64Model and everything else uses color.NRGBAModel.
+func (i *Image) ColorModel() color.Model {
+	switch i.Format {
+	case UncompressedGrayscale:
+		return color.GrayModel
+	case UncompressedR32:
+		return color.Gray16Model
+	case UncompressedR32g32b32, UncompressedR32g32b32a32:
+		return color.NRGBA64Model
+	}
+	return color.NRGBAModel
+}
+
+//Bounds returns the size of the base level of the image
+func (i *Image) Bounds() image.Rectangle {
+	return image.Rect(0, 0, int(i.Width), int(i.Height))
+}
+
+//At returns the colour of a pixel in the base level of the image, in the type of its ColorModel.
+//Float channels are clamped to [0..1]. Pixels out of bounds, and every pixel of a compressed image, are transparent.
+func (i *Image) At(x, y int) color.Color {
+	p := i.pixel(x, y)
+	if p == nil {
+		return i.ColorModel().Convert(color.Transparent)
+	}
+
+	switch i.Format {
+	case UncompressedGrayscale:
+		return color.Gray{Y: p[0]}
+	case UncompressedGrayAlpha:
+		return color.NRGBA{R: p[0], G: p[0], B: p[0], A: p[1]}
+	case UncompressedR5g6b5:
+		v := pixelUint16(p)
+		return color.NRGBA{R: expandBits(v>>11, 31), G: expandBits(v>>5&63, 63), B: expandBits(v&31, 31), A: 0xff}
+	case UncompressedR8g8b8:
+		return color.NRGBA{R: p[0], G: p[1], B: p[2], A: 0xff}
+	case UncompressedR5g5b5a1:
+		v := pixelUint16(p)
+		return color.NRGBA{R: expandBits(v>>11, 31), G: expandBits(v>>6&31, 31), B: expandBits(v>>1&31, 31), A: uint8(v&1) * 0xff}
+	case UncompressedR4g4b4a4:
+		v := pixelUint16(p)
+		return color.NRGBA{R: expandBits(v>>12, 15), G: expandBits(v>>8&15, 15), B: expandBits(v>>4&15, 15), A: expandBits(v&15, 15)}
+	case UncompressedR8g8b8a8:
+		return color.NRGBA{R: p[0], G: p[1], B: p[2], A: p[3]}
+	case UncompressedR32:
+		return color.Gray16{Y: unitToUint16(pixelFloats(p)[0])}
+	case UncompressedR32g32b32:
+		f := pixelFloats(p)
+		return color.NRGBA64{R: unitToUint16(f[0]), G: unitToUint16(f[1]), B: unitToUint16(f[2]), A: 0xffff}
+	case UncompressedR32g32b32a32:
+		f := pixelFloats(p)
+		return color.NRGBA64{R: unitToUint16(f[0]), G: unitToUint16(f[1]), B: unitToUint16(f[2]), A: unitToUint16(f[3])}
+	}
+	return color.NRGBA{}
+}
+
+//Set changes the colour of a pixel in the base level of the image, so the image can be used as a draw.Image.
+//Existing mipmaps are not regenerated. Pixels out of bounds, and compressed images, are left untouched.
+func (i *Image) Set(x, y int, c color.Color) {
+	p := i.pixel(x, y)
+	if p == nil {
+		return
+	}
+
+	switch i.Format {
+	case UncompressedGrayscale:
+		p[0] = color.GrayModel.Convert(c).(color.Gray).Y
+		return
+	case UncompressedR32:
+		pixelFloats(p)[0] = float32(color.Gray16Model.Convert(c).(color.Gray16).Y) / 0xffff
+		return
+	case UncompressedR32g32b32, UncompressedR32g32b32a32:
+		n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+		f := pixelFloats(p)
+		f[0], f[1], f[2] = float32(n.R)/0xffff, float32(n.G)/0xffff, float32(n.B)/0xffff
+		if len(f) > 3 {
+			f[3] = float32(n.A) / 0xffff
+		}
+		return
+	}
+
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	switch i.Format {
+	case UncompressedGrayAlpha:
+		p[0] = color.GrayModel.Convert(color.NRGBA{R: n.R, G: n.G, B: n.B, A: 0xff}).(color.Gray).Y
+		p[1] = n.A
+	case UncompressedR5g6b5:
+		setPixelUint16(p, reduceBits(n.R, 31)<<11|reduceBits(n.G, 63)<<5|reduceBits(n.B, 31))
+	case UncompressedR8g8b8:
+		p[0], p[1], p[2] = n.R, n.G, n.B
+	case UncompressedR5g5b5a1:
+		alpha := uint16(0)
+		if n.A >= 0x80 {
+			alpha = 1
 		}
+		setPixelUint16(p, reduceBits(n.R, 31)<<11|reduceBits(n.G, 31)<<6|reduceBits(n.B, 31)<<1|alpha)
+	case UncompressedR4g4b4a4:
+		setPixelUint16(p, reduceBits(n.R, 15)<<12|reduceBits(n.G, 15)<<8|reduceBits(n.B, 15)<<4|reduceBits(n.A, 15))
+	case UncompressedR8g8b8a8:
+		p[0], p[1], p[2], p[3] = n.R, n.G, n.B, n.A
 	}
+}
+
+//pixels returns the base level of an uncompressed image as bytes
+func (i *Image) pixels() []byte {
+	return unsafe.Slice((*byte)(i.data), int(i.Width)*int(i.Height)*i.Format.pixelBytes())
+}
+
+//pixel returns the bytes of a pixel in the base level, or nil if it is out of bounds or the image is compressed
+func (i *Image) pixel(x, y int) []byte {
+	size := i.Format.pixelBytes()
+	if size == 0 || i.data == nil || x < 0 || y < 0 || x >= int(i.Width) || y >= int(i.Height) {
+		return nil
+	}
+	offset := (y*int(i.Width) + x) * size
+	return unsafe.Slice((*byte)(unsafe.Add(i.data, offset)), size)
+}
+
+func pixelUint16(p []byte) uint16 { return *(*uint16)(unsafe.Pointer(&p[0])) }
+
+func setPixelUint16(p []byte, v uint16) { *(*uint16)(unsafe.Pointer(&p[0])) = v }
+
+func pixelFloats(p []byte) []float32 {
+	return unsafe.Slice((*float32)(unsafe.Pointer(&p[0])), len(p)/4)
+}
+
+//expandBits scales a channel of max bits up to 8 bits
+func expandBits(v uint16, max uint16) uint8 {
+	return uint8((uint32(v)*0xff + uint32(max)/2) / uint32(max))
+}
+
+//reduceBits scales an 8 bit channel down to max
+func reduceBits(v uint8, max uint16) uint16 {
+	return uint16((uint32(v)*uint32(max) + 0x7f) / 0xff)
+}
 
-	return LoadImageEx(pixels, int32(size.X), int32(size.Y))
+//unitToUint16 clamps a float channel to [0..1] and scales it to 16 bits
+func unitToUint16(v float32) uint16 {
+	return uint16(Clamp32(v, 0, 1)*0xffff + 0.5)
 }
 
 //void ExportImage(Image image, const char *fileName);
@@ -0,0 +1,195 @@
+package raylib
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+var _ draw.Image = (*Image)(nil)
+var _ color.Color = Color{}
+
+func TestImageFromGoStraightAlpha(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(2, 3, 6, 7))
+	for y := 3; y < 7; y++ {
+		for x := 2; x < 6; x++ {
+			src.SetNRGBA(x, y, color.NRGBA{uint8(x * 40), uint8(y * 30), 200, uint8(x*y*7 + 1)})
+		}
+	}
+	img := LoadImageFromGo(src)
+	defer img.Unload()
+	if img.Width != 4 || img.Height != 4 || img.Format != UncompressedR8g8b8a8 {
+		t.Fatalf("bad image %+v", img)
+	}
+	px := img.GetPixels()
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			want := src.NRGBAAt(x+2, y+3)
+			if got := img.At(x, y).(color.NRGBA); got != want {
+				t.Fatalf("At %d,%d %v != %v", x, y, got, want)
+			}
+			c := px[y*4+x]
+			if (color.NRGBA{c.R, c.G, c.B, c.A}) != want {
+				t.Fatalf("GetPixels %v != %v", c, want)
+			}
+		}
+	}
+	back := img.ToGo().(*image.NRGBA)
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if back.NRGBAAt(x, y) != src.NRGBAAt(x+2, y+3) {
+				t.Fatal("round trip")
+			}
+		}
+	}
+}
+
+func TestImageFromGoPremultipliedAlpha(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			a := uint8(y * 17)
+			src.Set(x, y, color.NRGBA{uint8(x * 17), 255 - uint8(x*17), 128, a})
+		}
+	}
+	img := LoadImageFromGo(src)
+	defer img.Unload()
+	generic := LoadImageFromGo(struct{ image.Image }{src})
+	defer generic.Unload()
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			want := color.NRGBAModel.Convert(src.At(x, y)).(color.NRGBA)
+			if got := img.At(x, y); got != want {
+				t.Fatalf("fast %d,%d %v != %v", x, y, got, want)
+			}
+			if got := generic.At(x, y); got != want {
+				t.Fatalf("generic %d,%d %v != %v", x, y, got, want)
+			}
+			r1, g1, b1, a1 := img.At(x, y).RGBA()
+			r2, g2, b2, a2 := src.At(x, y).RGBA()
+			d := func(a, b uint32) bool { return a > b+0x101 || b > a+0x101 }
+			if d(r1, r2) || d(g1, g2) || d(b1, b2) || a1 != a2 {
+				t.Fatalf("premultiplied %d,%d", x, y)
+			}
+		}
+	}
+	//16 bit sources are rounded to 8 bits, not truncated
+	g16 := image.NewGray16(image.Rect(0, 0, 1, 1))
+	g16.SetGray16(0, 0, color.Gray16{0x8000})
+	gi := LoadImageFromGo(g16)
+	defer gi.Unload()
+	if c := gi.At(0, 0).(color.NRGBA); c.R != 0x80 {
+		t.Fatalf("16 bit %v", c)
+	}
+}
+
+func TestImageFormatsAt(t *testing.T) {
+	formats := []PixelFormat{UncompressedGrayscale, UncompressedGrayAlpha, UncompressedR5g6b5, UncompressedR8g8b8,
+		UncompressedR5g5b5a1, UncompressedR4g4b4a4, UncompressedR8g8b8a8, UncompressedR32, UncompressedR32g32b32, UncompressedR32g32b32a32}
+	colors := []color.Color{color.NRGBA{255, 0, 0, 255}, color.NRGBA{0, 255, 0, 255}, color.NRGBA{0, 0, 255, 255},
+		color.White, color.Black, Color{255, 255, 255, 0}, Red}
+	for _, f := range formats {
+		img := GenImageColor(4, 2, Blank)
+		img.SetFormat(f)
+		if img.Format != f || img.Format.pixelBytes()*8 != GetPixelDataSize(4, 2, f) {
+			t.Fatalf("format %d size", f)
+		}
+		for i, c := range colors {
+			img.Set(i%4, i/4, c)
+		}
+		for i, c := range colors {
+			got := img.At(i%4, i/4)
+			want := img.ColorModel().Convert(c)
+			if f == UncompressedGrayAlpha {
+				n := color.NRGBAModel.Convert(c).(color.NRGBA)
+				y := color.GrayModel.Convert(color.NRGBA{n.R, n.G, n.B, 255}).(color.Gray).Y
+				want = color.NRGBA{y, y, y, n.A}
+			}
+			switch f {
+			case UncompressedR5g6b5, UncompressedR8g8b8, UncompressedR32g32b32:
+				n := color.NRGBAModel.Convert(c).(color.NRGBA)
+				n.A = 255
+				want = img.ColorModel().Convert(n)
+			}
+			if f == UncompressedR5g5b5a1 || f == UncompressedR32g32b32 {
+				if c == (Color{255, 255, 255, 0}) {
+					continue
+				}
+			}
+			if f == UncompressedR5g6b5 || f == UncompressedR5g5b5a1 || f == UncompressedR4g4b4a4 {
+				g, w := got.(color.NRGBA), want.(color.NRGBA)
+				d := func(a, b uint8) bool { return int(a) > int(b)+9 || int(b) > int(a)+9 }
+				if d(g.R, w.R) || d(g.G, w.G) || d(g.B, w.B) || d(g.A, w.A) {
+					t.Errorf("format %d colour %v: %v !~ %v", f, c, got, want)
+				}
+				img.Set(i%4, i/4, got)
+				if img.At(i%4, i/4) != got {
+					t.Errorf("format %d not stable", f)
+				}
+				continue
+			}
+			if got != want {
+				t.Errorf("format %d colour %v: %v != %v", f, c, got, want)
+			}
+		}
+		if img.At(-1, 0) != img.ColorModel().Convert(color.Transparent) || img.At(4, 0) == nil {
+			t.Error("out of bounds")
+		}
+		img.Set(100, 100, color.White)
+		if g := img.ToGo(); g == nil || g.Bounds() != img.Bounds() {
+			t.Errorf("ToGo %d", f)
+		} else {
+			for i := range colors {
+				r1, g1, b1, a1 := g.At(i%4, i/4).RGBA()
+				r2, g2, b2, a2 := img.At(i%4, i/4).RGBA()
+				if r1 != r2 || g1 != g2 || b1 != b2 || a1 != a2 {
+					t.Errorf("ToGo %d pixel %d", f, i)
+				}
+			}
+		}
+		img.Unload()
+	}
+}
+
+func TestImageDraw(t *testing.T) {
+	img := GenImageColor(8, 8, Blank)
+	defer img.Unload()
+	draw.Draw(img, image.Rect(2, 2, 6, 6), image.NewUniform(Red), image.Point{}, draw.Src)
+	if img.At(3, 3) != (color.NRGBA{230, 41, 55, 255}) || img.At(0, 0) != (color.NRGBA{}) {
+		t.Fatalf("draw %v %v", img.At(3, 3), img.At(0, 0))
+	}
+	hdr := GenImageColor(2, 2, White)
+	defer hdr.Unload()
+	hdr.SetFormat(UncompressedR32g32b32a32)
+	hdr.Set(0, 0, color.NRGBA64{0x1234, 0x5678, 0x9abc, 0xffff})
+	if hdr.At(0, 0) != (color.NRGBA64{0x1234, 0x5678, 0x9abc, 0xffff}) {
+		t.Fatalf("hdr %v", hdr.At(0, 0))
+	}
+}
+
+func TestExpandReduceBits(t *testing.T) {
+	//5, 6, 4 and 1 bit channels, of R5G6B5, R5G5B5A1 and R4G4B4A4
+	for _, max := range []uint16{31, 63, 15, 1} {
+		if expandBits(0, max) != 0 || expandBits(max, max) != 255 {
+			t.Errorf("%d levels do not expand to 0..255", max)
+		}
+		for v := uint16(0); v <= max; v++ {
+			if got := reduceBits(expandBits(v, max), max); got != v {
+				t.Errorf("%d of %d expands to %d and reduces to %d", v, max, expandBits(v, max), got)
+			}
+			if v > 0 && expandBits(v, max) <= expandBits(v-1, max) {
+				t.Errorf("%d of %d does not expand above %d", v, max, v-1)
+			}
+		}
+
+		//reducing rounds to the nearest level
+		step := 255 / float64(max)
+		for c := 0; c <= 255; c++ {
+			back := float64(expandBits(reduceBits(uint8(c), max), max))
+			if diff := back - float64(c); diff > step/2+1 || -diff > step/2+1 {
+				t.Errorf("%d reduced to %d of %d comes back as %v", c, reduceBits(uint8(c), max), max, back)
+			}
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -11,12 +11,14 @@ import "unsafe"
 
 //IsGamepadAvailable : Detect if a gamepad is available
 func IsGamepadAvailable(gamepad GamepadNumber) bool {
+	checkMainThread("IsGamepadAvailable")
 	res := C.IsGamepadAvailable(C.int(int32(gamepad)))
 	return bool(res)
 }
 
 //IsGamepadName : Check gamepad name (if available)
 func IsGamepadName(gamepad GamepadNumber, name string) bool {
+	checkMainThread("IsGamepadName")
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 	res := C.IsGamepadName(C.int(int32(gamepad)), cname)
@@ -25,129 +27,151 @@ func IsGamepadName(gamepad GamepadNumber, name string) bool {
 
 //GetGamepadName : Return gamepad internal name id
 func GetGamepadName(gamepad GamepadNumber) string {
+	checkMainThread("GetGamepadName")
 	res := C.GetGamepadName(C.int(int32(gamepad)))
 	return C.GoString(res)
 }
 
 //IsGamepadButtonPressed : Detect if a gamepad button has been pressed once
 func IsGamepadButtonPressed(gamepad GamepadNumber, button GamepadButton) bool {
+	checkMainThread("IsGamepadButtonPressed")
 	res := C.IsGamepadButtonPressed(C.int(int32(gamepad)), C.int(int32(button)))
 	return bool(res)
 }
 
 //IsGamepadButtonDown : Detect if a gamepad button is being pressed
 func IsGamepadButtonDown(gamepad GamepadNumber, button GamepadButton) bool {
+	checkMainThread("IsGamepadButtonDown")
 	res := C.IsGamepadButtonDown(C.int(int32(gamepad)), C.int(int32(button)))
 	return bool(res)
 }
 
 //IsGamepadButtonReleased : Detect if a gamepad button has been released once
 func IsGamepadButtonReleased(gamepad GamepadNumber, button GamepadButton) bool {
+	checkMainThread("IsGamepadButtonReleased")
 	res := C.IsGamepadButtonReleased(C.int(int32(gamepad)), C.int(int32(button)))
 	return bool(res)
 }
 
 //IsGamepadButtonUp : Detect if a gamepad button is NOT being pressed
 func IsGamepadButtonUp(gamepad GamepadNumber, button GamepadButton) bool {
+	checkMainThread("IsGamepadButtonUp")
 	res := C.IsGamepadButtonUp(C.int(int32(gamepad)), C.int(int32(button)))
 	return bool(res)
 }
 
 //GetGamepadButtonPressed : Get the last gamepad button pressed
 func GetGamepadButtonPressed() int {
+	checkMainThread("GetGamepadButtonPressed")
 	res := C.GetGamepadButtonPressed()
 	return int(int32(res))
 }
 
 //GetGamepadAxisCount : Return gamepad axis count for a gamepad
 func GetGamepadAxisCount(gamepad GamepadNumber) int {
+	checkMainThread("GetGamepadAxisCount")
 	res := C.GetGamepadAxisCount(C.int(int32(gamepad)))
 	return int(int32(res))
 }
 
 //GetGamepadAxisMovement : Return axis movement value for a gamepad axis
 func GetGamepadAxisMovement(gamepad GamepadNumber, axis GamepadAxis) float32 {
+	checkMainThread("GetGamepadAxisMovement")
 	res := C.GetGamepadAxisMovement(C.int(int32(gamepad)), C.int(int32(axis)))
 	return float32(res)
 }
 
 //IsMouseButtonPressed : Detect if a mouse button has been pressed once
 func IsMouseButtonPressed(button MouseButton) bool {
+	checkMainThread("IsMouseButtonPressed")
 	res := C.IsMouseButtonPressed(C.int(int32(button)))
 	return bool(res)
 }
 
 //IsMouseButtonDown : Detect if a mouse button is being pressed
 func IsMouseButtonDown(button MouseButton) bool {
+	checkMainThread("IsMouseButtonDown")
 	res := C.IsMouseButtonDown(C.int(int32(button)))
 	return bool(res)
 }
 
 //IsMouseButtonReleased : Detect if a mouse button has been released once
 func IsMouseButtonReleased(button MouseButton) bool {
+	checkMainThread("IsMouseButtonReleased")
 	res := C.IsMouseButtonReleased(C.int(int32(button)))
 	return bool(res)
 }
 
 //IsMouseButtonUp : Detect if a mouse button is NOT being pressed
 func IsMouseButtonUp(button MouseButton) bool {
+	checkMainThread("IsMouseButtonUp")
 	res := C.IsMouseButtonUp(C.int(int32(button)))
 	return bool(res)
 }
 
 //GetMouseX : Returns mouse position X
 func GetMouseX() int {
+	checkMainThread("GetMouseX")
 	res := C.GetMouseX()
 	return int(int32(res))
 }
 
 //GetMouseY : Returns mouse position Y
 func GetMouseY() int {
+	checkMainThread("GetMouseY")
 	res := C.GetMouseY()
 	return int(int32(res))
 }
 
 //GetMousePosition : Returns mouse position XY
 func GetMousePosition() Vector2 {
+	checkMainThread("GetMousePosition")
 	res := C.GetMousePosition()
 	return newVector2FromPointer(unsafe.Pointer(&res))
 }
 
 //SetMousePosition : Set mouse position XY
 func SetMousePosition(x int, y int) {
+	checkMainThread("SetMousePosition")
 	C.SetMousePosition(C.int(int32(x)), C.int(int32(y)))
 }
 
 //SetMouseOffset : Set mouse offset
 func SetMouseOffset(offsetX int, offsetY int) {
+	checkMainThread("SetMouseOffset")
 	C.SetMouseOffset(C.int(int32(offsetX)), C.int(int32(offsetY)))
 }
 
 //SetMouseScale : Set mouse scaling
 func SetMouseScale(scaleX float32, scaleY float32) {
+	checkMainThread("SetMouseScale")
 	C.SetMouseScale(C.float(scaleX), C.float(scaleY))
 }
 
 //GetMouseWheelMove : Returns mouse wheel movement Y
 func GetMouseWheelMove() int {
+	checkMainThread("GetMouseWheelMove")
 	res := C.GetMouseWheelMove()
 	return int(int32(res))
 }
 
 //GetTouchX : Returns touch position X for touch point 0 (relative to screen size)
 func GetTouchX() int {
+	checkMainThread("GetTouchX")
 	res := C.GetTouchX()
 	return int(int32(res))
 }
 
 //GetTouchY : Returns touch position Y for touch point 0 (relative to screen size)
 func GetTouchY() int {
+	checkMainThread("GetTouchY")
 	res := C.GetTouchY()
 	return int(int32(res))
 }
 
 //GetTouchPosition : Returns touch position XY for a touch point index (relative to screen size)
 func GetTouchPosition(index int) Vector2 {
+	checkMainThread("GetTouchPosition")
 	res := C.GetTouchPosition(C.int(int32(index)))
 	return newVector2FromPointer(unsafe.Pointer(&res))
 }
@@ -0,0 +1,476 @@
+package raylib
+
+/*
+#include "utils.h"
+#include "raylib.h"
+*/
+import "C"
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"runtime"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+//DefaultUploadBudget is the time an AssetLoader spends uploading each frame, a quarter of a frame at 60 FPS
+const DefaultUploadBudget = 4 * time.Millisecond
+
+//ErrAssetPending is returned by Asset.Get while the asset is still loading
+var ErrAssetPending = errors.New("asset is still loading")
+
+//Asset is a handle to an asset an AssetLoader is loading. It is done once the asset is ready, failed to load or was cancelled.
+type Asset[T any] struct {
+	//Name is the file the asset is loaded from
+	Name string
+
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func newAsset[T any](name string) *Asset[T] {
+	return &Asset[T]{Name: name, done: make(chan struct{})}
+}
+
+//Done is closed once the asset is done. Only wait on it from other goroutines, as the assets are finished by AssetLoader.Update on the main thread.
+func (asset *Asset[T]) Done() <-chan struct{} {
+	return asset.done
+}
+
+//IsDone returns true once the asset is ready, failed to load or was cancelled
+func (asset *Asset[T]) IsDone() bool {
+	select {
+	case <-asset.done:
+		return true
+	default:
+		return false
+	}
+}
+
+//Get gets the asset, or ErrAssetPending if it is still loading
+func (asset *Asset[T]) Get() (T, error) {
+	if !asset.IsDone() {
+		var zero T
+		return zero, ErrAssetPending
+	}
+	return asset.value, asset.err
+}
+
+//Value gets the asset, which is the zero value until it is ready
+func (asset *Asset[T]) Value() T {
+	value, _ := asset.Get()
+	return value
+}
+
+//Err gets why the asset failed to load, ErrAssetPending while it is loading or nil once it is ready.
+// Cancelled assets have the error of the context.
+func (asset *Asset[T]) Err() error {
+	_, err := asset.Get()
+	return err
+}
+
+func (asset *Asset[T]) complete(value T, err error) {
+	asset.value, asset.err = value, err
+	close(asset.done)
+}
+
+//assetJob is an asset moving through the loader. decode runs on a worker, then upload or discard runs on the main thread.
+type assetJob interface {
+	decode(fsys fs.FS) error
+	upload()
+	discard(err error)
+}
+
+//loadingAsset decodes into D on a worker, then uploads D into the asset on the main thread
+type loadingAsset[D any, T any] struct {
+	asset *Asset[T]
+	scope *ResourceScope
+
+	decoder  func(fsys fs.FS) (D, error)
+	uploader func(decoded D) (T, error)
+	unloader func(decoded D)
+
+	decoded    D
+	hasDecoded bool
+}
+
+func (job *loadingAsset[D, T]) decode(fsys fs.FS) error {
+	decoded, err := job.decoder(fsys)
+	job.decoded, job.hasDecoded = decoded, err == nil
+	return err
+}
+
+func (job *loadingAsset[D, T]) upload() {
+	value, err := job.uploader(job.decoded)
+	if err != nil {
+		job.asset.complete(value, err)
+		return
+	}
+
+	//Keep it in the scope that was current when it was queued, rather than the one current now
+	if unloadable, ok := any(value).(Unloadable); ok {
+		job.scope.Register(unloadable)
+	}
+	job.asset.complete(value, nil)
+}
+
+func (job *loadingAsset[D, T]) discard(err error) {
+	if job.hasDecoded && job.unloader != nil {
+		job.unloader(job.decoded)
+	}
+	var zero T
+	job.asset.complete(zero, err)
+}
+
+//decodedJob is a job the workers have finished with, and the error if it could not be decoded
+type decodedJob struct {
+	job assetJob
+	err error
+}
+
+//AssetLoader loads assets in the background so loading screens can keep drawing.
+// Workers read and decode the files, then Update finishes them on the main thread within the Budget, uploading textures and meshes to the GPU.
+// The assets register into the ResourceScope that was current when they were queued.
+type AssetLoader struct {
+	//Budget is the time Update spends finishing assets each frame. At least one asset is finished every Update.
+	Budget time.Duration
+
+	fsys    fs.FS
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stop    func() bool
+	workers sync.WaitGroup
+
+	mutex    sync.Mutex
+	wake     *sync.Cond
+	queued   []assetJob
+	decoded  []decodedJob
+	ready    chan struct{}
+	total    int
+	finished int
+}
+
+//NewAssetLoader creates a loader that reads from the file system with the number of workers, or one per CPU if it is 0.
+// Use os.DirFS to load from the disk. Cancelling the context cancels the assets that have not finished.
+func NewAssetLoader(ctx context.Context, fsys fs.FS, workers int) *AssetLoader {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	loader := &AssetLoader{
+		Budget: DefaultUploadBudget,
+		fsys:   fsys,
+		ctx:    ctx,
+		cancel: cancel,
+		ready:  make(chan struct{}, 1),
+	}
+	loader.wake = sync.NewCond(&loader.mutex)
+
+	//Wake the idle workers so they can stop
+	loader.stop = context.AfterFunc(ctx, func() {
+		loader.mutex.Lock()
+		loader.wake.Broadcast()
+		loader.mutex.Unlock()
+	})
+
+	loader.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go loader.work()
+	}
+	return loader
+}
+
+//LoadImage loads an image. It is only decoded, so Update has nothing to upload.
+func (loader *AssetLoader) LoadImage(name string) *Asset[*Image] {
+	return queueAsset(loader, name, func(fsys fs.FS) (*Image, error) {
+		return LoadImageFromFS(fsys, name)
+	}, keepDecoded[*Image], (*Image).Unload)
+}
+
+//LoadTexture loads a texture. The image is decoded on a worker and uploaded by Update.
+func (loader *AssetLoader) LoadTexture(name string) *Asset[Texture2D] {
+	return queueAsset(loader, name, func(fsys fs.FS) (*Image, error) {
+		return LoadImageFromFS(fsys, name)
+	}, func(image *Image) (Texture2D, error) {
+		defer image.Unload()
+		texture := LoadTextureFromImage(image)
+		if !texture.IsValid() {
+			return texture, &LoadError{Function: "LoadTexture", FileName: name, Err: ErrDecodeFailed, Reason: "Texture could not be uploaded"}
+		}
+		return texture, nil
+	}, (*Image).Unload)
+}
+
+//LoadWave loads a wave
+func (loader *AssetLoader) LoadWave(name string) *Asset[*Wave] {
+	return queueAsset(loader, name, func(fsys fs.FS) (*Wave, error) {
+		return LoadWaveFromFS(fsys, name)
+	}, keepDecoded[*Wave], (*Wave).Unload)
+}
+
+//LoadSound loads a sound. The wave is decoded and converted to the device format on a worker.
+func (loader *AssetLoader) LoadSound(name string) *Asset[*Sound] {
+	return queueAsset(loader, name, func(fsys fs.FS) (*Sound, error) {
+		return LoadSoundFromFS(fsys, name)
+	}, keepDecoded[*Sound], (*Sound).Unload)
+}
+
+//LoadMusicStream loads a music stream. The file is read into memory on a worker and streamed from there.
+func (loader *AssetLoader) LoadMusicStream(name string) *Asset[*Music] {
+	return queueAsset(loader, name, func(fsys fs.FS) (*Music, error) {
+		return LoadMusicStreamFromFS(fsys, name)
+	}, keepDecoded[*Music], (*Music).Unload)
+}
+
+//LoadFont loads a font. The glyphs are rasterized on a worker and the atlas is uploaded by Update.
+func (loader *AssetLoader) LoadFont(name string) *Asset[*Font] {
+	return queueDeferred(loader, name, func(fsys fs.FS) (*Font, error) {
+		return LoadFontFromFS(fsys, name)
+	}, func(font *Font, upload C.DeferredUpload) {
+		C.UploadDeferredTexture(font.Texture.cptr(), upload)
+		//LoadFont leaves the filter to be set here, as the worker has no GL context
+		if font.Texture.IsValid() {
+			font.Texture.SetTextureFilter(FilterPoint)
+		}
+	}, func(font *Font) {
+		//The texture was never uploaded, so there is nothing on the GPU to unload
+		font.Texture.Id = 0
+		font.Unload()
+	})
+}
+
+//LoadModel loads a model. The meshes and the images of its materials are parsed on a worker, then uploaded by Update.
+func (loader *AssetLoader) LoadModel(name string) *Asset[*Model] {
+	return queueDeferred(loader, name, func(fsys fs.FS) (*Model, error) {
+		return LoadModelFromFS(fsys, name)
+	}, func(model *Model, upload C.DeferredUpload) {
+		C.UploadDeferredModel((*C.Model)(unsafe.Pointer(model)), upload)
+	}, func(model *Model) {
+		//Models do not unload their textures and the meshes were never uploaded, so this only frees memory
+		model.Unload()
+	})
+}
+
+//LoadShader loads a shader, like LoadShaderFromFS. The files are read on a worker and the shader is compiled by Update.
+func (loader *AssetLoader) LoadShader(vsName string, fsName string) *Asset[Shader] {
+	type shaderCode struct{ vs, fs []byte }
+	name := fsName
+	if name == "" {
+		name = vsName
+	}
+
+	return queueAsset(loader, name, func(fsys fs.FS) (shaderCode, error) {
+		vsCode, err := loadShaderText(fsys, vsName)
+		if err != nil {
+			return shaderCode{}, err
+		}
+		fsCode, err := loadShaderText(fsys, fsName)
+		return shaderCode{vsCode, fsCode}, err
+	}, func(code shaderCode) (Shader, error) {
+		return LoadShaderFromMemory(code.vs, code.fs)
+	}, nil)
+}
+
+//Update finishes the assets the workers have decoded, uploading them until the Budget is spent. Call it every frame on the main thread.
+func (loader *AssetLoader) Update() {
+	loader.finish(loader.Budget)
+}
+
+//Wait finishes the assets on this thread as they are decoded until they are all done or the context is cancelled.
+// It must be called on the main thread, ie: to load everything before the game starts.
+func (loader *AssetLoader) Wait(ctx context.Context) error {
+	for {
+		loader.finish(0)
+		if loader.IsDone() {
+			return nil
+		}
+
+		select {
+		case <-loader.ready:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+//Progress is how much of the queued assets are done, from 0 to 1. It is 1 when nothing is queued.
+func (loader *AssetLoader) Progress() float32 {
+	finished, total := loader.Count()
+	if total == 0 {
+		return 1
+	}
+	return float32(finished) / float32(total)
+}
+
+//Count gets the number of assets that are done and the number that have been queued
+func (loader *AssetLoader) Count() (finished int, total int) {
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+	return loader.finished, loader.total
+}
+
+//IsDone returns true once every queued asset is done
+func (loader *AssetLoader) IsDone() bool {
+	finished, total := loader.Count()
+	return finished == total
+}
+
+//Close cancels the assets that have not finished and stops the workers. The assets that were decoded but not uploaded are unloaded.
+func (loader *AssetLoader) Close() {
+	loader.cancel()
+	loader.workers.Wait()
+	loader.stop()
+	loader.finish(0)
+}
+
+//queueAsset queues the asset for the workers to decode and Update to upload
+func queueAsset[D any, T any](loader *AssetLoader, name string, decode func(fsys fs.FS) (D, error), upload func(decoded D) (T, error), unload func(decoded D)) *Asset[T] {
+	asset := newAsset[T](name)
+	job := &loadingAsset[D, T]{
+		asset:    asset,
+		scope:    CurrentResourceScope(),
+		decoder:  decode,
+		uploader: upload,
+		unloader: unload,
+	}
+
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+	loader.total++
+
+	//Nothing will pick it up once the loader is cancelled
+	if err := loader.ctx.Err(); err != nil {
+		loader.finished++
+		job.discard(err)
+		return asset
+	}
+
+	loader.queued = append(loader.queued, job)
+	loader.wake.Signal()
+	return asset
+}
+
+//deferredDecode is what the workers decode with the uploads deferred, with the images of the textures that are left to upload
+type deferredDecode[T any] struct {
+	value  T
+	upload C.DeferredUpload
+}
+
+//queueDeferred queues an asset that makes textures or meshes while it loads. They are left in memory by the worker for upload to send to the GPU.
+// discard unloads the asset when its textures and meshes were never uploaded.
+func queueDeferred[T any](loader *AssetLoader, name string, decode func(fsys fs.FS) (T, error), upload func(value T, upload C.DeferredUpload), discard func(value T)) *Asset[T] {
+	return queueAsset(loader, name, func(fsys fs.FS) (deferredDecode[T], error) {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		var decoded deferredDecode[T]
+		var err error
+		C.BeginDeferredUpload()
+		decoded.value, err = decode(fsys)
+		decoded.upload = C.EndDeferredUpload()
+
+		if err != nil {
+			C.UnloadDeferredUpload(decoded.upload)
+		}
+		return decoded, err
+	}, func(decoded deferredDecode[T]) (T, error) {
+		defer C.UnloadDeferredUpload(decoded.upload)
+		upload(decoded.value, decoded.upload)
+		return decoded.value, nil
+	}, func(decoded deferredDecode[T]) {
+		defer C.UnloadDeferredUpload(decoded.upload)
+		discard(decoded.value)
+	})
+}
+
+//keepDecoded is the upload of assets that are finished once they are decoded
+func keepDecoded[T any](decoded T) (T, error) {
+	return decoded, nil
+}
+
+//work decodes the queued assets until the loader is cancelled
+func (loader *AssetLoader) work() {
+	defer loader.workers.Done()
+
+	//The logs raylib makes on this thread are passed on by Update, so the callback and console stay on the main thread
+	beginWorkerThread()
+	defer endWorkerThread()
+
+	for {
+		job := loader.next()
+		if job == nil {
+			return
+		}
+
+		err := loader.ctx.Err()
+		if err == nil {
+			err = job.decode(loader.fsys)
+		}
+
+		loader.mutex.Lock()
+		loader.decoded = append(loader.decoded, decodedJob{job: job, err: err})
+		loader.mutex.Unlock()
+
+		select {
+		case loader.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+//next waits for a queued asset, or returns nil once the loader is cancelled and there are none left
+func (loader *AssetLoader) next() assetJob {
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+	for len(loader.queued) == 0 {
+		if loader.ctx.Err() != nil {
+			return nil
+		}
+		loader.wake.Wait()
+	}
+
+	job := loader.queued[0]
+	loader.queued[0] = nil
+	loader.queued = loader.queued[1:]
+	return job
+}
+
+//finish uploads the decoded assets until the budget is spent, or all of them if it is 0.
+// Assets that failed complete with their error, and ones decoded after the loader was cancelled are discarded.
+func (loader *AssetLoader) finish(budget time.Duration) {
+	flushTraceLogs()
+
+	start := time.Now()
+	for {
+		loader.mutex.Lock()
+		if len(loader.decoded) == 0 {
+			loader.mutex.Unlock()
+			return
+		}
+		next := loader.decoded[0]
+		loader.decoded[0] = decodedJob{}
+		loader.decoded = loader.decoded[1:]
+		loader.mutex.Unlock()
+
+		switch {
+		case next.err != nil:
+			next.job.discard(next.err)
+		case loader.ctx.Err() != nil:
+			next.job.discard(loader.ctx.Err())
+		default:
+			next.job.upload()
+		}
+
+		loader.mutex.Lock()
+		loader.finished++
+		loader.mutex.Unlock()
+
+		if budget > 0 && time.Since(start) >= budget {
+			return
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package raylib
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+//testDecoded waits for the workers of the loader to decode count assets
+func testDecoded(t *testing.T, loader *AssetLoader, count int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		loader.mutex.Lock()
+		decoded := len(loader.decoded)
+		loader.mutex.Unlock()
+		if decoded >= count {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the workers decoded %d of %d assets", decoded, count)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+//testQueue queues an asset that decodes to its name once it can receive from release, and counts its uploads and unloads
+func testQueue(loader *AssetLoader, name string, release <-chan struct{}, uploaded, unloaded *atomic.Int32) *Asset[string] {
+	return queueAsset(loader, name, func(fs.FS) (string, error) {
+		<-release
+		return name, nil
+	}, func(decoded string) (string, error) {
+		uploaded.Add(1)
+		return decoded, nil
+	}, func(string) {
+		unloaded.Add(1)
+	})
+}
+
+func TestAssetLoaderProgress(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.wav": {Data: testRampWAV(t, 100, 22050, 1)},
+		"b.wav": {Data: testRampWAV(t, 200, 22050, 2)},
+	}
+	loader := NewAssetLoader(context.Background(), fsys, 2)
+	defer loader.Close()
+
+	if loader.Progress() != 1 || !loader.IsDone() {
+		t.Fatalf("an empty loader has progress %v", loader.Progress())
+	}
+
+	a, b, missing := loader.LoadWave("a.wav"), loader.LoadWave("b.wav"), loader.LoadWave("missing.wav")
+	if loader.Progress() != 0 || a.Err() != ErrAssetPending {
+		t.Fatalf("progress is %v before Update, and the asset has %v", loader.Progress(), a.Err())
+	}
+
+	if err := loader.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if finished, total := loader.Count(); loader.Progress() != 1 || finished != 3 || total != 3 {
+		t.Fatalf("progress is %v with %d of %d finished", loader.Progress(), finished, total)
+	}
+
+	for _, asset := range []*Asset[*Wave]{a, b} {
+		wave, err := asset.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer wave.Unload()
+	}
+	if a.Value().SampleCount != 100 || b.Value().SampleCount != 200 {
+		t.Errorf("loaded %d and %d samples", a.Value().SampleCount, b.Value().SampleCount)
+	}
+	if !errors.Is(missing.Err(), ErrFileNotFound) {
+		t.Errorf("the missing wave failed with %v", missing.Err())
+	}
+}
+
+func TestAssetLoaderBudget(t *testing.T) {
+	loader := NewAssetLoader(context.Background(), fstest.MapFS{}, 1)
+	defer loader.Close()
+
+	released := make(chan struct{})
+	close(released)
+	var uploaded, unloaded atomic.Int32
+	assets := []*Asset[string]{
+		testQueue(loader, "a", released, &uploaded, &unloaded),
+		testQueue(loader, "b", released, &uploaded, &unloaded),
+		testQueue(loader, "c", released, &uploaded, &unloaded),
+	}
+	testDecoded(t, loader, len(assets))
+
+	//a spent budget still finishes one asset each Update, in the order they were queued
+	loader.Budget = time.Nanosecond
+	for i, asset := range assets {
+		loader.Update()
+		if finished, _ := loader.Count(); finished != i+1 || asset.Value() != asset.Name {
+			t.Fatalf("update %d finished %d assets, and %s is %q", i, finished, asset.Name, asset.Value())
+		}
+	}
+
+	//a large one finishes everything that is decoded
+	more := []*Asset[string]{
+		testQueue(loader, "d", released, &uploaded, &unloaded),
+		testQueue(loader, "e", released, &uploaded, &unloaded),
+	}
+	testDecoded(t, loader, len(more))
+	loader.Budget = time.Hour
+	loader.Update()
+	if !loader.IsDone() || !more[0].IsDone() || !more[1].IsDone() {
+		t.Fatal("a large budget did not finish every asset")
+	}
+	if uploaded.Load() != 5 || unloaded.Load() != 0 {
+		t.Fatalf("uploaded %d and unloaded %d assets", uploaded.Load(), unloaded.Load())
+	}
+}
+
+func TestAssetLoaderCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	loader := NewAssetLoader(ctx, fstest.MapFS{}, 1)
+	defer loader.Close()
+
+	decoding, release := make(chan struct{}), make(chan struct{})
+	var uploaded, unloaded atomic.Int32
+	first := queueAsset(loader, "first", func(fs.FS) (string, error) {
+		close(decoding)
+		<-release
+		return "first", nil
+	}, keepDecoded[string], func(string) {
+		unloaded.Add(1)
+	})
+	queued := testQueue(loader, "queued", release, &uploaded, &unloaded)
+
+	//the asset being decoded is discarded once it is done, and the queued one is never decoded
+	<-decoding
+	cancel()
+	close(release)
+	if err := loader.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, asset := range []*Asset[string]{first, queued} {
+		if !errors.Is(asset.Err(), context.Canceled) {
+			t.Errorf("%s finished with %v", asset.Name, asset.Err())
+		}
+	}
+	if uploaded.Load() != 0 || unloaded.Load() != 1 {
+		t.Errorf("uploaded %d and unloaded %d assets", uploaded.Load(), unloaded.Load())
+	}
+
+	//assets queued once it is cancelled are done straight away
+	late := testQueue(loader, "late", release, &uploaded, &unloaded)
+	if !errors.Is(late.Err(), context.Canceled) || !loader.IsDone() {
+		t.Errorf("an asset queued after cancelling finished with %v", late.Err())
+	}
+}
+
+func TestAssetLoaderCloseDiscardsDecoded(t *testing.T) {
+	loader := NewAssetLoader(context.Background(), fstest.MapFS{}, 2)
+
+	released := make(chan struct{})
+	close(released)
+	var uploaded, unloaded atomic.Int32
+	assets := []*Asset[string]{
+		testQueue(loader, "a", released, &uploaded, &unloaded),
+		testQueue(loader, "b", released, &uploaded, &unloaded),
+	}
+	testDecoded(t, loader, len(assets))
+
+	//the decoded assets were never uploaded, so closing unloads them
+	loader.Close()
+	for _, asset := range assets {
+		if !errors.Is(asset.Err(), context.Canceled) {
+			t.Errorf("%s finished with %v", asset.Name, asset.Err())
+		}
+	}
+	if uploaded.Load() != 0 || unloaded.Load() != 2 || !loader.IsDone() {
+		t.Errorf("uploaded %d and unloaded %d assets", uploaded.Load(), unloaded.Load())
+	}
+}
@@ -71,9 +71,6 @@ func init() {
 	//We have to lock the OS Thread as raylib is sensitive to that stuff.
 	runtime.LockOSThread()
 
-	//Setup the unloadables so they finalize
-	runtime.SetFinalizer(&unloadables, finalizeUnloadables)
-
 	//Reset the scale, this seems to be broken on mac?
 	SetMouseScale(1, 1)
 }
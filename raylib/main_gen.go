@@ -1,20 +1,25 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T14:36:23Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
 */
 import "C"
 import (
+	"errors"
+	"fmt"
+	"os"
 	"os/exec"
 	"runtime"
+	"time"
 	"unsafe"
 )
 
 //InitWindow : Initialize window and OpenGL context
 func InitWindow(width int, height int, title string) {
+	checkMainThread("InitWindow")
 	ctitle := C.CString(title)
 	defer C.free(unsafe.Pointer(ctitle))
 	C.InitWindow(C.int(int32(width)), C.int(int32(height)), ctitle)
@@ -22,62 +27,73 @@ func InitWindow(width int, height int, title string) {
 
 //WindowShouldClose : Check if KEY_ESCAPE pressed or Close icon pressed
 func WindowShouldClose() bool {
+	checkMainThread("WindowShouldClose")
 	res := C.WindowShouldClose()
 	return bool(res)
 }
 
 //CloseWindow : Close window and unload OpenGL context
 func CloseWindow() {
+	checkMainThread("CloseWindow")
 	C.CloseWindow()
 }
 
 //IsWindowReady : Check if window has been initialized successfully
 func IsWindowReady() bool {
+	checkMainThread("IsWindowReady")
 	res := C.IsWindowReady()
 	return bool(res)
 }
 
 //IsWindowMinimized : Check if window has been minimized (or lost focus)
 func IsWindowMinimized() bool {
+	checkMainThread("IsWindowMinimized")
 	res := C.IsWindowMinimized()
 	return bool(res)
 }
 
 //IsWindowResized : Check if window has been resized
 func IsWindowResized() bool {
+	checkMainThread("IsWindowResized")
 	res := C.IsWindowResized()
 	return bool(res)
 }
 
 //IsWindowHidden : Check if window is currently hidden
 func IsWindowHidden() bool {
+	checkMainThread("IsWindowHidden")
 	res := C.IsWindowHidden()
 	return bool(res)
 }
 
 //ToggleFullscreen : Toggle fullscreen mode (only PLATFORM_DESKTOP)
 func ToggleFullscreen() {
+	checkMainThread("ToggleFullscreen")
 	C.ToggleFullscreen()
 }
 
 //UnhideWindow : Show the window
 func UnhideWindow() {
+	checkMainThread("UnhideWindow")
 	C.UnhideWindow()
 }
 
 //HideWindow : Hide the window
 func HideWindow() {
+	checkMainThread("HideWindow")
 	C.HideWindow()
 }
 
 //SetWindowIcon : Set icon for window (only PLATFORM_DESKTOP)
 func SetWindowIcon(image Image) {
+	checkMainThread("SetWindowIcon")
 	cimage := *image.cptr()
 	C.SetWindowIcon(cimage)
 }
 
 //SetWindowTitle : Set title for window (only PLATFORM_DESKTOP)
 func SetWindowTitle(title string) {
+	checkMainThread("SetWindowTitle")
 	ctitle := C.CString(title)
 	defer C.free(unsafe.Pointer(ctitle))
 	C.SetWindowTitle(ctitle)
@@ -85,91 +101,107 @@ func SetWindowTitle(title string) {
 
 //SetWindowPosition : Set window position on screen (only PLATFORM_DESKTOP)
 func SetWindowPosition(x int, y int) {
+	checkMainThread("SetWindowPosition")
 	C.SetWindowPosition(C.int(int32(x)), C.int(int32(y)))
 }
 
 //SetWindowMonitor : Set monitor for the current window (fullscreen mode)
 func SetWindowMonitor(monitor int) {
+	checkMainThread("SetWindowMonitor")
 	C.SetWindowMonitor(C.int(int32(monitor)))
 }
 
 //SetWindowMinSize : Set window minimum dimensions (for FLAG_WINDOW_RESIZABLE)
 func SetWindowMinSize(width int, height int) {
+	checkMainThread("SetWindowMinSize")
 	C.SetWindowMinSize(C.int(int32(width)), C.int(int32(height)))
 }
 
 //SetWindowSize : Set window dimensions
 func SetWindowSize(width int, height int) {
+	checkMainThread("SetWindowSize")
 	C.SetWindowSize(C.int(int32(width)), C.int(int32(height)))
 }
 
 //GetWindowHandle : Get native window handle
 func GetWindowHandle() {
+	checkMainThread("GetWindowHandle")
 	C.GetWindowHandle()
 }
 
 //GetScreenWidth : Get current screen width
 func GetScreenWidth() int {
+	checkMainThread("GetScreenWidth")
 	res := C.GetScreenWidth()
 	return int(int32(res))
 }
 
 //GetScreenHeight : Get current screen height
 func GetScreenHeight() int {
+	checkMainThread("GetScreenHeight")
 	res := C.GetScreenHeight()
 	return int(int32(res))
 }
 
 //GetMonitorCount : Get number of connected monitors
 func GetMonitorCount() int {
+	checkMainThread("GetMonitorCount")
 	res := C.GetMonitorCount()
 	return int(int32(res))
 }
 
 //GetMonitorWidth : Get primary monitor width
 func GetMonitorWidth(monitor int) int {
+	checkMainThread("GetMonitorWidth")
 	res := C.GetMonitorWidth(C.int(int32(monitor)))
 	return int(int32(res))
 }
 
 //GetMonitorHeight : Get primary monitor height
 func GetMonitorHeight(monitor int) int {
+	checkMainThread("GetMonitorHeight")
 	res := C.GetMonitorHeight(C.int(int32(monitor)))
 	return int(int32(res))
 }
 
 //GetMonitorPhysicalWidth : Get primary monitor physical width in millimetres
 func GetMonitorPhysicalWidth(monitor int) int {
+	checkMainThread("GetMonitorPhysicalWidth")
 	res := C.GetMonitorPhysicalWidth(C.int(int32(monitor)))
 	return int(int32(res))
 }
 
 //GetMonitorPhysicalHeight : Get primary monitor physical height in millimetres
 func GetMonitorPhysicalHeight(monitor int) int {
+	checkMainThread("GetMonitorPhysicalHeight")
 	res := C.GetMonitorPhysicalHeight(C.int(int32(monitor)))
 	return int(int32(res))
 }
 
 //GetWindowPosition : Get window position XY on monitor
 func GetWindowPosition() Vector2 {
+	checkMainThread("GetWindowPosition")
 	res := C.GetWindowPosition()
 	return newVector2FromPointer(unsafe.Pointer(&res))
 }
 
 //GetMonitorName : Get the human-readable, UTF-8 encoded name of the primary monitor
 func GetMonitorName(monitor int) string {
+	checkMainThread("GetMonitorName")
 	res := C.GetMonitorName(C.int(int32(monitor)))
 	return C.GoString(res)
 }
 
 //GetClipboardText : Get clipboard text content
 func GetClipboardText() string {
+	checkMainThread("GetClipboardText")
 	res := C.GetClipboardText()
 	return C.GoString(res)
 }
 
 //SetClipboardText : Set clipboard text content
 func SetClipboardText(text string) {
+	checkMainThread("SetClipboardText")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	C.SetClipboardText(ctext)
@@ -177,91 +209,110 @@ func SetClipboardText(text string) {
 
 //ShowCursor : Shows cursor
 func ShowCursor() {
+	checkMainThread("ShowCursor")
 	C.ShowCursor()
 }
 
 //HideCursor : Hides cursor
 func HideCursor() {
+	checkMainThread("HideCursor")
 	C.HideCursor()
 }
 
 //IsCursorHidden : Check if cursor is not visible
 func IsCursorHidden() bool {
+	checkMainThread("IsCursorHidden")
 	res := C.IsCursorHidden()
 	return bool(res)
 }
 
 //EnableCursor : Enables cursor (unlock cursor)
 func EnableCursor() {
+	checkMainThread("EnableCursor")
 	C.EnableCursor()
 }
 
 //DisableCursor : Disables cursor (lock cursor)
 func DisableCursor() {
+	checkMainThread("DisableCursor")
 	C.DisableCursor()
 }
 
 //ClearBackground : Set background color (framebuffer clear color)
 func ClearBackground(color Color) {
+	checkMainThread("ClearBackground")
 	ccolor := *color.cptr()
 	C.ClearBackground(ccolor)
 }
 
 //BeginDrawing : Setup canvas (framebuffer) to start drawing
 func BeginDrawing() {
+	checkMainThread("BeginDrawing")
 	C.BeginDrawing()
 }
 
 //EndDrawing : End canvas drawing and swap buffers (double buffering)
+//The functions queued by Do and DoAsync are run once the frame has been drawn
 func EndDrawing() {
+	checkMainThread("EndDrawing")
 	C.EndDrawing()
+	RunMainThreadQueue()
 }
 
 //BeginMode2D : Initialize 2D mode with custom camera (2D)
 func BeginMode2D(camera Camera2D) {
+	checkMainThread("BeginMode2D")
 	ccamera := *camera.cptr()
 	C.BeginMode2D(ccamera)
 }
 
 //EndMode2D : Ends 2D mode with custom camera
 func EndMode2D() {
+	checkMainThread("EndMode2D")
 	C.EndMode2D()
 }
 
 //BeginMode3D : Initializes 3D mode with custom camera (3D)
 func BeginMode3D(camera Camera) {
+	checkMainThread("BeginMode3D")
 	ccamera := *camera.cptr()
 	C.BeginMode3D(ccamera)
 }
 
 //EndMode3D : Ends 3D mode and returns to default 2D orthographic mode
 func EndMode3D() {
+	checkMainThread("EndMode3D")
 	C.EndMode3D()
 }
 
 //BeginTextureMode : Initializes render texture for drawing
 func BeginTextureMode(target RenderTexture2D) {
+	checkMainThread("BeginTextureMode")
 	ctarget := *target.cptr()
 	C.BeginTextureMode(ctarget)
 }
 
 //EndTextureMode : Ends drawing to render texture
 func EndTextureMode() {
+	checkMainThread("EndTextureMode")
 	C.EndTextureMode()
 }
 
 //BeginScissorMode : Begin scissor mode (define screen area for following drawing)
 func BeginScissorMode(x int, y int, width int, height int) {
+	checkMainThread("BeginScissorMode")
 	C.BeginScissorMode(C.int(int32(x)), C.int(int32(y)), C.int(int32(width)), C.int(int32(height)))
 }
 
 //EndScissorMode : End scissor mode
 func EndScissorMode() {
+	checkMainThread("EndScissorMode")
 	C.EndScissorMode()
 }
 
 //GetMouseRay : Returns a ray trace from mouse position
 func GetMouseRay(mousePosition Vector2, camera Camera) Ray {
+	checkMainThread("GetMouseRay")
 	ccamera := *camera.cptr()
 	cmousePosition := *mousePosition.cptr()
 	res := C.GetMouseRay(cmousePosition, ccamera)
@@ -270,6 +321,7 @@ func GetMouseRay(mousePosition Vector2, camera Camera) Ray {
 
 //GetCameraMatrix : Returns camera transform matrix (view matrix)
 func GetCameraMatrix(camera Camera) Matrix {
+	checkMainThread("GetCameraMatrix")
 	ccamera := *camera.cptr()
 	res := C.GetCameraMatrix(ccamera)
 	return newMatrixFromPointer(unsafe.Pointer(&res))
@@ -277,6 +329,7 @@ func GetCameraMatrix(camera Camera) Matrix {
 
 //GetCameraMatrix2D : Returns camera 2d transform matrix
 func GetCameraMatrix2D(camera Camera2D) Matrix {
+	checkMainThread("GetCameraMatrix2D")
 	ccamera := *camera.cptr()
 	res := C.GetCameraMatrix2D(ccamera)
 	return newMatrixFromPointer(unsafe.Pointer(&res))
@@ -284,14 +337,25 @@ func GetCameraMatrix2D(camera Camera2D) Matrix {
 
 //GetWorldToScreen : Returns the screen space position for a 3d world space position
 func GetWorldToScreen(position Vector3, camera Camera) Vector2 {
+	checkMainThread("GetWorldToScreen")
 	ccamera := *camera.cptr()
 	cposition := *position.cptr()
 	res := C.GetWorldToScreen(cposition, ccamera)
 	return newVector2FromPointer(unsafe.Pointer(&res))
 }
 
+//GetWorldToScreenEx : Returns size position for a 3d world space position
+func GetWorldToScreenEx(position Vector3, camera Camera, width int, height int) Vector2 {
+	checkMainThread("GetWorldToScreenEx")
+	ccamera := *camera.cptr()
+	cposition := *position.cptr()
+	res := C.GetWorldToScreenEx(cposition, ccamera, C.int(int32(width)), C.int(int32(height)))
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}
+
 //GetWorldToScreen2D : Returns the screen space position for a 2d camera world space position
 func GetWorldToScreen2D(position Vector2, camera Camera2D) Vector2 {
+	checkMainThread("GetWorldToScreen2D")
 	ccamera := *camera.cptr()
 	cposition := *position.cptr()
 	res := C.GetWorldToScreen2D(cposition, ccamera)
@@ -300,6 +364,7 @@ func GetWorldToScreen2D(position Vector2, camera Camera2D) Vector2 {
 
 //GetScreenToWorld2D : Returns the world space position for a 2d camera screen space position
 func GetScreenToWorld2D(position Vector2, camera Camera2D) Vector2 {
+	checkMainThread("GetScreenToWorld2D")
 	ccamera := *camera.cptr()
 	cposition := *position.cptr()
 	res := C.GetScreenToWorld2D(cposition, ccamera)
@@ -308,23 +373,27 @@ func GetScreenToWorld2D(position Vector2, camera Camera2D) Vector2 {
 
 //SetTargetFPS : Set target FPS (maximum)
 func SetTargetFPS(fps int) {
+	checkMainThread("SetTargetFPS")
 	C.SetTargetFPS(C.int(int32(fps)))
 }
 
 //GetFPS : Returns current FPS
 func GetFPS() int {
+	checkMainThread("GetFPS")
 	res := C.GetFPS()
 	return int(int32(res))
 }
 
 //GetFrameTime : Returns time in seconds for last frame drawn
 func GetFrameTime() float32 {
+	checkMainThread("GetFrameTime")
 	res := C.GetFrameTime()
 	return float32(res)
 }
 
 //GetTime : Returns elapsed time in seconds since InitWindow()
 func GetTime() float64 {
+	checkMainThread("GetTime")
 	res := C.GetTime()
 	return float64(res)
 }
@@ -343,6 +412,13 @@ func ColorNormalize(color Color) Vector4 {
 	return newVector4FromPointer(unsafe.Pointer(&res))
 }
 
+//ColorFromNormalized : Returns color from normalized values [0..1]
+func ColorFromNormalized(normalized Vector4) Color {
+	cnormalized := *normalized.cptr()
+	res := C.ColorFromNormalized(cnormalized)
+	return newColorFromPointer(unsafe.Pointer(&res))
+}
+
 //ColorToHSV : Returns HSV values for a Color
 func ColorToHSV(color Color) Vector3 {
 	ccolor := *color.cptr()
@@ -372,6 +448,7 @@ func Fade(color Color, alpha float32) Color {
 
 //SetConfigFlags : Setup window configuration flags (view FLAGS)
 func SetConfigFlags(flags uint32) {
+	checkMainThread("SetConfigFlags")
 	C.SetConfigFlags(C.uint(flags))
 }
 
@@ -382,6 +459,7 @@ func SetConfigFlags(flags uint32) {
 //SetTraceLogCallback is in trace.go
 //TakeScreenshot : Takes a screenshot of current screen (saved a .png)
 func TakeScreenshot(fileName string) {
+	checkMainThread("TakeScreenshot")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 	C.TakeScreenshot(cfileName)
@@ -389,18 +467,124 @@ func TakeScreenshot(fileName string) {
 
 //GetRandomValue : Returns a random value between min and max (both included)
 func GetRandomValue(min int, max int) int {
+	checkMainThread("GetRandomValue")
 	res := C.GetRandomValue(C.int(int32(min)), C.int(int32(max)))
 	return int(int32(res))
 }
 
+//FileExists : Check if file exists
+func FileExists(fileName string) bool {
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+	res := C.FileExists(cfileName)
+	return bool(res)
+}
+
+//IsFileExtension : Check file extension
+func IsFileExtension(fileName string, ext string) bool {
+	cext := C.CString(ext)
+	defer C.free(unsafe.Pointer(cext))
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+	res := C.IsFileExtension(cfileName, cext)
+	return bool(res)
+}
+
+//DirectoryExists : Check if a directory path exists
+func DirectoryExists(dirPath string) bool {
+	cdirPath := C.CString(dirPath)
+	defer C.free(unsafe.Pointer(cdirPath))
+	res := C.DirectoryExists(cdirPath)
+	return bool(res)
+}
+
+//GetExtension : Get pointer to extension for a filename string
+func GetExtension(fileName string) string {
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+	res := C.GetExtension(cfileName)
+	return C.GoString(res)
+}
+
+//GetFileName : Get pointer to filename for a path string
+func GetFileName(filePath string) string {
+	cfilePath := C.CString(filePath)
+	defer C.free(unsafe.Pointer(cfilePath))
+	res := C.GetFileName(cfilePath)
+	return C.GoString(res)
+}
+
+//GetFileNameWithoutExt : Get filename string without extension (uses static string)
+func GetFileNameWithoutExt(filePath string) string {
+	checkMainThread("GetFileNameWithoutExt")
+	cfilePath := C.CString(filePath)
+	defer C.free(unsafe.Pointer(cfilePath))
+	res := C.GetFileNameWithoutExt(cfilePath)
+	return C.GoString(res)
+}
+
+//GetDirectoryPath : Get full path for a given fileName with path (uses static string)
+func GetDirectoryPath(filePath string) string {
+	checkMainThread("GetDirectoryPath")
+	cfilePath := C.CString(filePath)
+	defer C.free(unsafe.Pointer(cfilePath))
+	res := C.GetDirectoryPath(cfilePath)
+	return C.GoString(res)
+}
+
+//GetPrevDirectoryPath : Get previous directory path for a given path (uses static string)
+func GetPrevDirectoryPath(dirPath string) string {
+	checkMainThread("GetPrevDirectoryPath")
+	cdirPath := C.CString(dirPath)
+	defer C.free(unsafe.Pointer(cdirPath))
+	res := C.GetPrevDirectoryPath(cdirPath)
+	return C.GoString(res)
+}
+
+//GetWorkingDirectory : Get current working directory (uses static string)
+func GetWorkingDirectory() string {
+	checkMainThread("GetWorkingDirectory")
+	res := C.GetWorkingDirectory()
+	return C.GoString(res)
+}
+
+//GetDirectoryFiles : Get filenames in a directory path.
+// The directory is read by Go, so there is no limit on the number of entries and no need for ClearDirectoryFiles.
+// The names are sorted, and unlike raylib the "." and ".." entries are not included.
+func GetDirectoryFiles(dirPath string) ([]string, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	return names, nil
+}
+
+//ChangeDirectory : Change working directory
+func ChangeDirectory(dir string) error {
+	checkMainThread("ChangeDirectory")
+	cdir := C.CString(dir)
+	defer C.free(unsafe.Pointer(cdir))
+	if !bool(C.ChangeDirectory(cdir)) {
+		return fmt.Errorf("failed to change directory to %s", dir)
+	}
+	return nil
+}
+
 //IsFileDropped : Check if a file has been dropped into window
 func IsFileDropped() bool {
+	checkMainThread("IsFileDropped")
 	res := C.IsFileDropped()
 	return bool(res)
 }
 
 //GetDroppedFiles : Get dropped files names (memory should be freed)
 func GetDroppedFiles() []string {
+	checkMainThread("GetDroppedFiles")
 	ccount := C.int(0)
 	res := C.GetDroppedFiles(&ccount)
 	count := int(ccount)
@@ -416,22 +600,70 @@ func GetDroppedFiles() []string {
 
 //ClearDroppedFiles : Clear dropped files paths buffer (free memory)
 func ClearDroppedFiles() {
+	checkMainThread("ClearDroppedFiles")
 	C.ClearDroppedFiles()
 }
 
+//GetFileModTime : Get file modification time (last write time)
+func GetFileModTime(fileName string) (time.Time, error) {
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+	res := C.GetFileModTime(cfileName)
+	if res == 0 {
+		return time.Time{}, fmt.Errorf("failed to get the modification time of %s", fileName)
+	}
+	return time.Unix(int64(res), 0), nil
+}
+
+//CompressData : Compress data (DEFLATE algorythm)
+func CompressData(data []byte) ([]byte, error) {
+	var cdata *C.uchar
+	if len(data) > 0 {
+		cdata = (*C.uchar)(unsafe.Pointer(&data[0]))
+	}
+
+	ccompDataLength := C.int(0)
+	res := C.CompressData(cdata, C.int(len(data)), &ccompDataLength)
+	if res == nil {
+		return nil, errors.New("failed to compress data")
+	}
+	defer C.free(unsafe.Pointer(res))
+
+	return C.GoBytes(unsafe.Pointer(res), ccompDataLength), nil
+}
+
+//DecompressData : Decompress data (DEFLATE algorythm)
+func DecompressData(compData []byte) ([]byte, error) {
+	if len(compData) == 0 {
+		return nil, errors.New("failed to decompress data: no data")
+	}
+
+	cdataLength := C.int(0)
+	res := C.DecompressData((*C.uchar)(unsafe.Pointer(&compData[0])), C.int(len(compData)), &cdataLength)
+	if res == nil {
+		return nil, errors.New("failed to decompress data: invalid or corrupt stream")
+	}
+	defer C.free(unsafe.Pointer(res))
+
+	return C.GoBytes(unsafe.Pointer(res), cdataLength), nil
+}
+
 //StorageSaveValue : Save integer value to storage file (to defined position)
 func StorageSaveValue(position int, value int) {
+	checkMainThread("StorageSaveValue")
 	C.StorageSaveValue(C.int(int32(position)), C.int(int32(value)))
 }
 
 //StorageLoadValue : Load integer value from storage file (from defined position)
 func StorageLoadValue(position int) int {
+	checkMainThread("StorageLoadValue")
 	res := C.StorageLoadValue(C.int(int32(position)))
 	return int(int32(res))
 }
 
 //OpenURL opens a URL in the system browser.
 func OpenURL(url string) error {
+	checkMainThread("OpenURL")
 	switch runtime.GOOS {
 	case "linux":
 		return exec.Command("xdg-open", url).Start()
@@ -0,0 +1,111 @@
+package raylib
+
+/*
+#include "utils.h"
+#include <stdint.h>
+#include <pthread.h>
+
+// Identifies the calling thread
+static uintptr_t Go_CurrentThread() {
+  return (uintptr_t)pthread_self();
+}
+*/
+import "C"
+import (
+	"runtime"
+	"sync"
+)
+
+//mainThread is the thread init locked the main goroutine to. raylib has to be called from it, as that is where the GL context is.
+var mainThread = currentThread()
+
+var mainThreadMutex sync.Mutex
+
+//mainThreadQueue are the functions queued by Do and DoAsync
+var mainThreadQueue []func()
+
+//workerThreads are the threads of the AssetLoader workers, which are allowed to call the loaders that defer their uploads to the GPU
+var workerThreads = make(map[uintptr]bool)
+
+//IsMainThread returns true if it is called from the main goroutine, which is the only one that can call raylib
+func IsMainThread() bool {
+	return currentThread() == mainThread
+}
+
+//Do runs the function on the main thread and waits for it to finish. On the main thread it runs straight away.
+// Other goroutines wait for the main thread to run the queue at the end of the frame (see RunMainThreadQueue), so the main thread must not be waiting on them.
+// If the function panics, Do panics with the same value.
+func Do(f func()) {
+	if IsMainThread() {
+		f()
+		return
+	}
+
+	done := make(chan interface{}, 1)
+	DoAsync(func() {
+		defer func() { done <- recover() }()
+		f()
+	})
+	if recovered := <-done; recovered != nil {
+		panic(recovered)
+	}
+}
+
+//DoAsync queues the function to run on the main thread at the end of the frame, and returns straight away.
+// The functions run in the order they were queued.
+func DoAsync(f func()) {
+	mainThreadMutex.Lock()
+	defer mainThreadMutex.Unlock()
+	mainThreadQueue = append(mainThreadQueue, f)
+}
+
+//RunMainThreadQueue runs the functions queued by Do and DoAsync. EndDrawing calls it, so only loops that do not draw need to call it themselves.
+// Functions queued while it is running are left for the next call.
+func RunMainThreadQueue() {
+	checkMainThread("RunMainThreadQueue")
+
+	mainThreadMutex.Lock()
+	queue := mainThreadQueue
+	mainThreadQueue = nil
+	mainThreadMutex.Unlock()
+
+	for _, f := range queue {
+		f()
+	}
+}
+
+//currentThread identifies the thread the goroutine is running on. The goroutine should be locked to it if it is not the main one.
+func currentThread() uintptr {
+	return uintptr(C.Go_CurrentThread())
+}
+
+//beginWorkerThread locks the goroutine to its thread and lets it call the raylib functions that do not use the GL context.
+// Its logs are held for the main thread to pass on, so the callback and console are only called from there.
+func beginWorkerThread() {
+	runtime.LockOSThread()
+	mainThreadMutex.Lock()
+	workerThreads[currentThread()] = true
+	mainThreadMutex.Unlock()
+	deferTraceLogs(true)
+}
+
+//endWorkerThread undoes beginWorkerThread
+func endWorkerThread() {
+	deferTraceLogs(false)
+	mainThreadMutex.Lock()
+	delete(workerThreads, currentThread())
+	mainThreadMutex.Unlock()
+	runtime.UnlockOSThread()
+}
+
+//isWorkerThread returns true if it is called from an AssetLoader worker
+func isWorkerThread() bool {
+	mainThreadMutex.Lock()
+	defer mainThreadMutex.Unlock()
+	return workerThreads[currentThread()]
+}
+
+//isDeferringUploads returns true if it is called from an AssetLoader worker that is leaving its textures and meshes for the main thread to upload
+func isDeferringUploads() bool {
+	return isWorkerThread() && bool(C.IsUploadDeferred())
+}
@@ -0,0 +1,20 @@
+// +build debug
+
+package raylib
+
+//checkMainThread panics if the raylib function is not called from the main goroutine.
+// The generated bindings that use the GL context or the window start with it, and it only checks in debug builds.
+func checkMainThread(function string) {
+	if IsMainThread() {
+		return
+	}
+	panic("raylib: " + function + " was called from a goroutine other than the main one. raylib can only be called from the goroutine running main, as init locked it to the thread the GL context is on. Use r.Do or r.DoAsync to run it there.")
+}
+
+//checkUploadThread is checkMainThread for the loaders that only use the GL context to upload, which AssetLoader workers can call while they defer the uploads
+func checkUploadThread(function string) {
+	if isDeferringUploads() {
+		return
+	}
+	checkMainThread(function)
+}
@@ -0,0 +1,77 @@
+// +build debug
+
+package raylib
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+//testPanics returns what f panicked with, or nil
+func testPanics(f func()) (recovered interface{}) {
+	defer func() { recovered = recover() }()
+	f()
+	return nil
+}
+
+//testOnWorker runs f on a new AssetLoader worker thread and returns what it panicked with
+func testOnWorker(f func()) interface{} {
+	done := make(chan interface{})
+	go func() {
+		beginWorkerThread()
+		defer endWorkerThread()
+		done <- testPanics(f)
+	}()
+	return <-done
+}
+
+func TestCheckMainThread(t *testing.T) {
+	//tests do not run on the main goroutine
+	recovered := testPanics(func() { GetScreenWidth() })
+	if s, ok := recovered.(string); !ok || !strings.Contains(s, "GetScreenWidth was called from a goroutine") {
+		t.Fatalf("GetScreenWidth panicked with %v", recovered)
+	}
+
+	//functions that do not use the GL context can be called from anywhere
+	image := GenImageColor(2, 2, Red)
+	image.Unload()
+	if !CheckCollisionRecs(NewRectangle(0, 0, 1, 1), NewRectangle(0, 0, 1, 1)) || TextLength("abc") != 3 {
+		t.Fatal("collision and text functions gave the wrong result")
+	}
+	if testPanics(func() { GetWorkingDirectory() }) == nil {
+		t.Fatal("GetWorkingDirectory returns a shared buffer, but was not checked")
+	}
+}
+
+func TestCheckMainThreadOnWorkers(t *testing.T) {
+	//workers cannot use the GL context, even through the loaders when they are not deferring the uploads
+	if testOnWorker(func() { GetScreenWidth() }) == nil {
+		t.Fatal("a worker called GetScreenWidth")
+	}
+	if testOnWorker(func() { LoadModel("missing.obj") }) == nil {
+		t.Fatal("a worker called LoadModel without deferring the uploads")
+	}
+
+	//but can load while they are deferring
+	obj := "v 0 0 0\nv 1 0 0\nv 0 1 0\nvt 0 0\nvt 1 0\nvt 0 1\nvn 0 0 1\nf 1/1/1 2/2/1 3/3/1\n"
+	loader := NewAssetLoader(context.Background(), fstest.MapFS{"m.obj": {Data: []byte(obj)}}, 1)
+	loader.LoadModel("m.obj")
+	select {
+	case <-loader.ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the worker did not load the model")
+	}
+
+	loader.mutex.Lock()
+	decoded := loader.decoded
+	//the model is left in memory, as only the main thread can unload it
+	loader.decoded = nil
+	loader.mutex.Unlock()
+	loader.Close()
+	if len(decoded) != 1 || decoded[0].err != nil {
+		t.Fatalf("the worker failed to load the model: %v", decoded)
+	}
+}
@@ -0,0 +1,9 @@
+// +build !debug
+
+package raylib
+
+//checkMainThread does nothing outside debug builds, where it checks the raylib function is called from the main goroutine
+func checkMainThread(function string) {}
+
+//checkUploadThread does nothing outside debug builds, where it checks the loader is called from the main goroutine or a worker deferring its uploads
+func checkUploadThread(function string) {}
@@ -0,0 +1,316 @@
+package raylib
+
+//Mixable is audio that a Mixer can route to a bus. Sound, Music, AudioStream and StreamPlayer are Mixable.
+type Mixable interface {
+	SetVolume(volume float32)
+	IsPlaying() bool
+}
+
+//BusMaster is the name of the bus every other bus of a Mixer is under
+const BusMaster = "master"
+
+//Mixer routes audio to named buses, ie: music, sfx and voice, which are under the master bus or each other.
+// The volume of the audio is its own volume, times its fade, times the gain of its bus and every bus above it.
+// The mixer sets the volume of the audio when it is updated, so SetVolume should not be called on routed audio directly.
+// Fades are Tweens, and like them the mixer is driven by calling Update with the time that has passed (or Tick, which uses GetFrameTime).
+type Mixer struct {
+	master   *Bus
+	buses    map[string]*Bus
+	channels map[Mixable]*mixerChannel
+	fades    map[*float32]*Tween
+	ducks    []*mixerDuck
+}
+
+//Bus is a group of audio in a Mixer with its own volume
+type Bus struct {
+	name   string
+	mixer  *Mixer
+	parent *Bus
+	volume float32
+	fade   float32
+	duck   float32
+	muted  bool
+}
+
+//mixerChannel is a Mixable routed to a bus
+type mixerChannel struct {
+	bus     *Bus
+	volume  float32
+	fade    float32
+	applied float32
+}
+
+//mixerDuck lowers a bus while audio on another is playing
+type mixerDuck struct {
+	target  *Bus
+	trigger *Bus
+	amount  float32
+	attack  float32
+	release float32
+	gain    float32
+}
+
+//NewMixer creates a mixer with only the master bus
+func NewMixer() *Mixer {
+	mixer := &Mixer{
+		buses:    make(map[string]*Bus),
+		channels: make(map[Mixable]*mixerChannel),
+		fades:    make(map[*float32]*Tween),
+	}
+	mixer.master = &Bus{name: BusMaster, mixer: mixer, volume: 1, fade: 1, duck: 1}
+	mixer.buses[BusMaster] = mixer.master
+	return mixer
+}
+
+//Master returns the master bus
+func (mixer *Mixer) Master() *Bus {
+	return mixer.master
+}
+
+//AddBus creates a bus under the parent bus. An empty parent is the master bus.
+// If the bus already exists it is returned as it is. Returns nil if the parent does not exist.
+func (mixer *Mixer) AddBus(name string, parent string) *Bus {
+	if bus, ok := mixer.buses[name]; ok {
+		return bus
+	}
+	if parent == "" {
+		parent = BusMaster
+	}
+	parentBus, ok := mixer.buses[parent]
+	if !ok {
+		return nil
+	}
+	bus := &Bus{name: name, mixer: mixer, parent: parentBus, volume: 1, fade: 1, duck: 1}
+	mixer.buses[name] = bus
+	return bus
+}
+
+//Bus returns the bus with the name, or nil if there is none
+func (mixer *Mixer) Bus(name string) *Bus {
+	return mixer.buses[name]
+}
+
+//Route puts the audio on the bus with the name, keeping its volume if it was already routed. Returns false if the bus does not exist.
+func (mixer *Mixer) Route(audio Mixable, bus string) bool {
+	target, ok := mixer.buses[bus]
+	if !ok {
+		return false
+	}
+	target.Route(audio)
+	return true
+}
+
+//Unroute removes the audio from the mixer, which stops changing its volume. Audio should be unrouted before it is unloaded.
+func (mixer *Mixer) Unroute(audio Mixable) {
+	if channel, ok := mixer.channels[audio]; ok {
+		delete(mixer.fades, &channel.fade)
+		delete(mixer.channels, audio)
+	}
+}
+
+//BusOf returns the bus the audio is routed to, or nil
+func (mixer *Mixer) BusOf(audio Mixable) *Bus {
+	if channel, ok := mixer.channels[audio]; ok {
+		return channel.bus
+	}
+	return nil
+}
+
+//SetVolume sets the volume of the audio itself, before its fade and bus. Audio that is not routed is put on the master bus.
+func (mixer *Mixer) SetVolume(audio Mixable, volume float32) {
+	mixer.channel(audio).volume = volume
+}
+
+//Volume gets the volume of the audio the mixer last set, including its fade and bus. Returns 0 if the audio is not routed.
+func (mixer *Mixer) Volume(audio Mixable) float32 {
+	channel, ok := mixer.channels[audio]
+	if !ok {
+		return 0
+	}
+	return channel.volume * channel.fade * channel.bus.Gain()
+}
+
+//FadeIn fades the audio in from silence over the duration in seconds. If the audio is already fading, it continues from where that fade is.
+// Audio that is not routed is put on the master bus.
+func (mixer *Mixer) FadeIn(audio Mixable, duration float32) *Tween {
+	channel := mixer.channel(audio)
+	if _, fading := mixer.fades[&channel.fade]; !fading {
+		channel.fade = 0
+	}
+	return mixer.fadeTo(&channel.fade, 1, duration)
+}
+
+//FadeOut fades the audio out to silence over the duration in seconds. The audio keeps playing, so stop it when the tween completes:
+//	mixer.FadeOut(music, 2).OnComplete(music.StopStream)
+func (mixer *Mixer) FadeOut(audio Mixable, duration float32) *Tween {
+	return mixer.fadeTo(&mixer.channel(audio).fade, 0, duration)
+}
+
+//Crossfade fades the from audio out and the to audio in over the duration in seconds. Returns the tween of from fading out.
+func (mixer *Mixer) Crossfade(from Mixable, to Mixable, duration float32) *Tween {
+	mixer.FadeIn(to, duration)
+	return mixer.FadeOut(from, duration)
+}
+
+//Duck lowers the target bus to the amount of its volume while any audio on the trigger bus (or a bus under it) is playing, ie: music while dialogue plays.
+// The target takes attack seconds to go down and release seconds to come back up.
+func (mixer *Mixer) Duck(target *Bus, trigger *Bus, amount float32, attack float32, release float32) {
+	mixer.ducks = append(mixer.ducks, &mixerDuck{target: target, trigger: trigger, amount: amount, attack: attack, release: release, gain: 1})
+}
+
+//ClearDucks removes every Duck that lowers the target bus
+func (mixer *Mixer) ClearDucks(target *Bus) {
+	ducks := mixer.ducks[:0]
+	for _, duck := range mixer.ducks {
+		if duck.target != target {
+			ducks = append(ducks, duck)
+		}
+	}
+	mixer.ducks = ducks
+	target.duck = 1
+}
+
+//Tick updates the mixer with GetFrameTime
+func (mixer *Mixer) Tick() {
+	mixer.Update(GetFrameTime())
+}
+
+//Update advances the fades and ducks by dt seconds, then sets the volume of the audio that has changed
+func (mixer *Mixer) Update(dt float32) {
+	for value, fade := range mixer.fades {
+		if !fade.Update(dt) {
+			delete(mixer.fades, value)
+		}
+	}
+
+	for _, bus := range mixer.buses {
+		bus.duck = 1
+	}
+	for _, duck := range mixer.ducks {
+		duck.update(mixer, dt)
+		duck.target.duck *= duck.gain
+	}
+
+	for audio, channel := range mixer.channels {
+		volume := channel.volume * channel.fade * channel.bus.Gain()
+		if volume != channel.applied {
+			audio.SetVolume(volume)
+			channel.applied = volume
+		}
+	}
+}
+
+//channel gets the channel of the audio, routing it to the master bus if it has none
+func (mixer *Mixer) channel(audio Mixable) *mixerChannel {
+	channel, ok := mixer.channels[audio]
+	if !ok {
+		channel = &mixerChannel{bus: mixer.master, volume: 1, fade: 1, applied: -1}
+		mixer.channels[audio] = channel
+	}
+	return channel
+}
+
+//fadeTo replaces any fade of the value with a linear tween to the target
+func (mixer *Mixer) fadeTo(value *float32, target float32, duration float32) *Tween {
+	fade := TweenFloat32(value, target, duration, nil)
+	mixer.fades[value] = fade
+	return fade
+}
+
+func (duck *mixerDuck) update(mixer *Mixer, dt float32) {
+	playing := false
+	for audio, channel := range mixer.channels {
+		if channel.bus.isUnder(duck.trigger) && audio.IsPlaying() {
+			playing = true
+			break
+		}
+	}
+
+	if playing {
+		duck.gain = approach(duck.gain, duck.amount, (1-duck.amount)*dt, duck.attack)
+	} else {
+		duck.gain = approach(duck.gain, 1, (1-duck.amount)*dt, duck.release)
+	}
+}
+
+//approach moves the value to the target by step divided by the time, or straight there if the time is 0
+func approach(value float32, target float32, step float32, time float32) float32 {
+	if time <= 0 {
+		return target
+	}
+	step /= time
+	if value < target {
+		return Clamp32(value+step, value, target)
+	}
+	return Clamp32(value-step, target, value)
+}
+
+//Name returns the name of the bus
+func (bus *Bus) Name() string {
+	return bus.name
+}
+
+//Parent returns the bus this one is under, or nil for the master bus
+func (bus *Bus) Parent() *Bus {
+	return bus.parent
+}
+
+//Route puts the audio on the bus, keeping its volume if it was already routed
+func (bus *Bus) Route(audio Mixable) {
+	bus.mixer.channel(audio).bus = bus
+}
+
+//SetVolume sets the volume of the bus
+func (bus *Bus) SetVolume(volume float32) {
+	bus.volume = volume
+}
+
+//Volume gets the volume of the bus, without its fade, ducking or the buses above it
+func (bus *Bus) Volume() float32 {
+	return bus.volume
+}
+
+//SetMuted mutes or unmutes the bus, and so everything under it
+func (bus *Bus) SetMuted(muted bool) {
+	bus.muted = muted
+}
+
+//IsMuted returns true if the bus is muted. The buses above it may be muted too.
+func (bus *Bus) IsMuted() bool {
+	return bus.muted
+}
+
+//Gain gets the volume the bus applies to its audio. This is its volume, fade and ducking, times the gain of the bus above it.
+func (bus *Bus) Gain() float32 {
+	if bus.muted {
+		return 0
+	}
+	gain := bus.volume * bus.fade * bus.duck
+	if bus.parent != nil {
+		gain *= bus.parent.Gain()
+	}
+	return gain
+}
+
+//FadeIn fades the bus in from silence over the duration in seconds. If the bus is already fading, it continues from where that fade is.
+func (bus *Bus) FadeIn(duration float32) *Tween {
+	if _, fading := bus.mixer.fades[&bus.fade]; !fading {
+		bus.fade = 0
+	}
+	return bus.mixer.fadeTo(&bus.fade, 1, duration)
+}
+
+//FadeOut fades the bus out to silence over the duration in seconds. The bus stays silent until it is faded in again.
+func (bus *Bus) FadeOut(duration float32) *Tween {
+	return bus.mixer.fadeTo(&bus.fade, 0, duration)
+}
+
+//isUnder returns true if the bus is the other bus or is under it
+func (bus *Bus) isUnder(other *Bus) bool {
+	for b := bus; b != nil; b = b.parent {
+		if b == other {
+			return true
+		}
+	}
+	return false
+}
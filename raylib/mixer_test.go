@@ -0,0 +1,204 @@
+package raylib
+
+import (
+	"math"
+	"testing"
+)
+
+//testMixable is audio that records the volume the mixer sets
+type testMixable struct {
+	volume  float32
+	playing bool
+	sets    int
+}
+
+func (audio *testMixable) SetVolume(volume float32) {
+	audio.volume = volume
+	audio.sets++
+}
+
+func (audio *testMixable) IsPlaying() bool { return audio.playing }
+
+func testNear(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
+
+func TestMixerBusGain(t *testing.T) {
+	mixer := NewMixer()
+	music := mixer.AddBus("music", "")
+	voice := mixer.AddBus("voice", BusMaster)
+	dialogue := mixer.AddBus("dialogue", "voice")
+	if mixer.AddBus("sfx", "missing") != nil || mixer.AddBus("music", "voice") != music || dialogue.Parent() != voice {
+		t.Fatal("the buses were not added under their parents")
+	}
+
+	song, line := &testMixable{}, &testMixable{}
+	music.Route(song)
+	if !mixer.Route(line, "dialogue") || mixer.Route(line, "missing") || mixer.BusOf(line) != dialogue {
+		t.Fatal("the line was not routed to the dialogue")
+	}
+
+	mixer.Master().SetVolume(0.5)
+	music.SetVolume(0.8)
+	voice.SetVolume(0.5)
+	mixer.SetVolume(song, 0.5)
+	mixer.Update(0)
+	if !testNear(song.volume, 0.5*0.8*0.5) || !testNear(line.volume, 0.5*0.5) || !testNear(mixer.Volume(line), line.volume) {
+		t.Fatalf("the song is at %v and the line at %v", song.volume, line.volume)
+	}
+
+	//the volume is only set when it changes
+	mixer.Update(0.1)
+	if song.sets != 1 || line.sets != 1 {
+		t.Fatalf("the volumes were set %d and %d times", song.sets, line.sets)
+	}
+
+	//muting a bus silences everything under it
+	voice.SetMuted(true)
+	mixer.Update(0)
+	if line.volume != 0 || !testNear(song.volume, 0.2) || dialogue.IsMuted() {
+		t.Fatalf("muting the voice bus left the line at %v and the song at %v", line.volume, song.volume)
+	}
+	voice.SetMuted(false)
+	mixer.Update(0)
+	if !testNear(line.volume, 0.25) {
+		t.Fatalf("unmuting the voice bus left the line at %v", line.volume)
+	}
+}
+
+func TestMixerFades(t *testing.T) {
+	mixer := NewMixer()
+	song, next := &testMixable{}, &testMixable{}
+
+	mixer.FadeIn(song, 2)
+	for _, want := range []float32{0.25, 0.5, 0.75, 1, 1} {
+		mixer.Update(0.5)
+		if !testNear(song.volume, want) {
+			t.Fatalf("fading in is at %v, not %v", song.volume, want)
+		}
+	}
+
+	//a crossfade is linear both ways, and only completes once
+	completed := 0
+	mixer.Crossfade(song, next, 1).OnComplete(func() { completed++ })
+	mixer.Update(0.25)
+	if !testNear(song.volume, 0.75) || !testNear(next.volume, 0.25) {
+		t.Fatalf("a quarter of the crossfade is at %v and %v", song.volume, next.volume)
+	}
+	mixer.Update(0.25)
+	if !testNear(song.volume, 0.5) || !testNear(next.volume, 0.5) {
+		t.Fatalf("half of the crossfade is at %v and %v", song.volume, next.volume)
+	}
+	mixer.Update(1)
+	mixer.Update(1)
+	if song.volume != 0 || next.volume != 1 || completed != 1 {
+		t.Fatalf("the crossfade finished at %v and %v, and completed %d times", song.volume, next.volume, completed)
+	}
+
+	//fading in while fading out continues from where the fade is
+	mixer.FadeOut(next, 1)
+	mixer.Update(0.5)
+	mixer.FadeIn(next, 1)
+	mixer.Update(0.5)
+	if !testNear(next.volume, 0.75) {
+		t.Fatalf("fading back in is at %v", next.volume)
+	}
+
+	//a faded out bus stays silent until it is faded in
+	mixer.Master().FadeOut(0.5)
+	mixer.Update(1)
+	mixer.Update(1)
+	if next.volume != 0 {
+		t.Fatalf("the faded out master bus is at %v", next.volume)
+	}
+	mixer.Master().FadeIn(0.5)
+	mixer.Update(0.25)
+	if !testNear(next.volume, 0.5) {
+		t.Fatalf("fading the master bus in is at %v", next.volume)
+	}
+}
+
+func TestMixerDuck(t *testing.T) {
+	mixer := NewMixer()
+	music := mixer.AddBus("music", "")
+	voice := mixer.AddBus("voice", "")
+	dialogue := mixer.AddBus("dialogue", "voice")
+	song, line := &testMixable{}, &testMixable{}
+	music.Route(song)
+	dialogue.Route(line)
+
+	//lowers the music to a quarter in half a second, and brings it back in a second
+	mixer.Duck(music, voice, 0.25, 0.5, 1)
+	mixer.Update(0.25)
+	if song.volume != 1 {
+		t.Fatalf("the music ducked to %v with nothing playing", song.volume)
+	}
+
+	line.playing = true
+	steps := []struct {
+		playing bool
+		dt      float32
+		want    float32
+	}{
+		{true, 0.25, 0.625},
+		{true, 0.25, 0.25},
+		{true, 1, 0.25},
+		{false, 0.5, 0.625},
+		{false, 0.5, 1},
+		{false, 1, 1},
+	}
+	for i, step := range steps {
+		line.playing = step.playing
+		mixer.Update(step.dt)
+		if !testNear(song.volume, step.want) || !testNear(music.Gain(), step.want) {
+			t.Fatalf("step %d: the music is at %v, not %v", i, song.volume, step.want)
+		}
+	}
+
+	//an attack of 0 ducks straight away, and clearing the ducks restores the bus
+	mixer.ClearDucks(music)
+	mixer.Duck(music, voice, 0.5, 0, 0)
+	line.playing = true
+	mixer.Update(0.01)
+	if !testNear(song.volume, 0.5) {
+		t.Fatalf("an instant duck is at %v", song.volume)
+	}
+	mixer.ClearDucks(music)
+	mixer.Update(0.01)
+	if song.volume != 1 {
+		t.Fatalf("clearing the ducks left the music at %v", song.volume)
+	}
+}
+
+func TestMixerUnroute(t *testing.T) {
+	mixer := NewMixer()
+	music := mixer.AddBus("music", "")
+	voice := mixer.AddBus("voice", "")
+	song, line := &testMixable{}, &testMixable{playing: true}
+	music.Route(song)
+	voice.Route(line)
+	mixer.Duck(music, voice, 0.5, 0, 0)
+	mixer.FadeOut(line, 1)
+	mixer.Update(0.5)
+	if !testNear(song.volume, 0.5) || !testNear(line.volume, 0.5) {
+		t.Fatalf("the song is at %v and the line at %v", song.volume, line.volume)
+	}
+
+	//unrouted audio keeps its volume, has no fade and no longer ducks other buses
+	mixer.Unroute(line)
+	sets := line.sets
+	mixer.Update(1)
+	if line.sets != sets || !testNear(line.volume, 0.5) || mixer.BusOf(line) != nil || mixer.Volume(line) != 0 {
+		t.Fatalf("the unrouted line was set to %v", line.volume)
+	}
+	if song.volume != 1 {
+		t.Fatalf("the unrouted line still ducks the song to %v", song.volume)
+	}
+
+	//routing it again starts from a full volume without the old fade
+	mixer.Route(line, "voice")
+	mixer.Update(0)
+	if line.volume != 1 {
+		t.Fatalf("the line was routed again at %v", line.volume)
+	}
+}
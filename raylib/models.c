@@ -662,7 +662,12 @@ Model LoadModel(const char *fileName)
     // Make sure model transform is set to identity matrix!
     model.transform = MatrixIdentity();
 
-    if (model.meshCount == 0)
+    if ((model.meshCount == 0) && IsUploadDeferred())
+    {
+        // The default mesh cannot be uploaded from this thread
+        TraceLog(LOG_WARNING, "[%s] No meshes can be loaded", fileName);
+    }
+    else if (model.meshCount == 0)
     {
         model.meshCount = 1;
         model.meshes = (Mesh *)RL_CALLOC(model.meshCount, sizeof(Mesh));
@@ -675,8 +680,8 @@ Model LoadModel(const char *fileName)
     }
     else
     {
-        // Upload vertex data to GPU (static mesh)
-        for (int i = 0; i < model.meshCount; i++) rlLoadMesh(&model.meshes[i], false);
+        // Upload vertex data to GPU (static mesh), unless it is left for UploadDeferredModel()
+        if (!IsUploadDeferred()) for (int i = 0; i < model.meshCount; i++) rlLoadMesh(&model.meshes[i], false);
     }
 
     if (model.materialCount == 0)
@@ -693,6 +698,17 @@ Model LoadModel(const char *fileName)
     return model;
 }
 
+// Upload the meshes and textures of a model loaded while uploads were deferred
+void UploadDeferredModel(Model *model, DeferredUpload upload)
+{
+    for (int i = 0; i < model->meshCount; i++) rlLoadMesh(&model->meshes[i], false);
+
+    for (int i = 0; i < model->materialCount; i++)
+    {
+        for (int j = 0; j < MAX_MATERIAL_MAPS; j++) UploadDeferredTexture(&model->materials[i].maps[j].texture, upload);
+    }
+}
+
 // Load model from generated mesh
 // WARNING: A shallow copy of mesh is generated, passed by value,
 // as long as struct contains pointers to data and some values, we get a copy
@@ -739,12 +755,40 @@ void UnloadModel(Model model)
 }
 
 // Load meshes from model file
+// NOTE: Meshes are loaded like LoadModel() does, but there is no default cube mesh and the materials are unloaded
 Mesh *LoadMeshes(const char *fileName, int *meshCount)
 {
     Mesh *meshes = NULL;
     int count = 0;
+    Model model = { 0 };
+
+#if defined(SUPPORT_FILEFORMAT_OBJ)
+    if (IsFileExtension(fileName, ".obj")) model = LoadOBJ(fileName);
+#endif
+#if defined(SUPPORT_FILEFORMAT_IQM)
+    if (IsFileExtension(fileName, ".iqm")) model = LoadIQM(fileName);
+#endif
+#if defined(SUPPORT_FILEFORMAT_GLTF)
+    if (IsFileExtension(fileName, ".gltf") || IsFileExtension(fileName, ".glb")) model = LoadGLTF(fileName);
+#endif
+
+    if (model.meshCount > 0)
+    {
+        meshes = model.meshes;
+        count = model.meshCount;
 
-    // TODO: Load meshes from file (OBJ, IQM, GLTF)
+        // Upload vertex data to GPU (static mesh), unless it is left for UploadDeferredModel()
+        if (!IsUploadDeferred()) for (int i = 0; i < count; i++) rlLoadMesh(&meshes[i], false);
+    }
+    else TraceLog(LOG_WARNING, "[%s] No meshes can be loaded", fileName);
+
+    // Only the meshes are returned, so nobody else can unload the materials and animation data
+    for (int i = 0; i < model.materialCount; i++) UnloadMaterial(model.materials[i]);
+
+    RL_FREE(model.materials);
+    RL_FREE(model.meshMaterial);
+    RL_FREE(model.bones);
+    RL_FREE(model.bindPose);
 
     *meshCount = count;
     return meshes;
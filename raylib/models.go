@@ -99,6 +99,11 @@ func newModelFromPointer(ptr unsafe.Pointer) *Model {
 	return (*Model)(ptr)
 }
 
+//IsValid returns true if the model has meshes
+func (s *Model) IsValid() bool {
+	return s.MeshCount > 0
+}
+
 func (s *Model) cptr() *C.Model {
 	return (*C.Model)(unsafe.Pointer(s))
 }
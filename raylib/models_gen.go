@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T14:36:23Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -11,6 +11,7 @@ import "unsafe"
 
 //LoadModel : Load model from files (meshes and materials)
 func LoadModel(fileName string) *Model {
+	checkUploadThread("LoadModel")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 	res := C.LoadModel(cfileName)
@@ -19,8 +20,23 @@ func LoadModel(fileName string) *Model {
 	return retval
 }
 
+//LoadModelE : Load model from files (meshes and materials)
+//Returns an error instead of an invalid Model if it cannot be loaded
+func LoadModelE(fileName string) (*Model, error) {
+	var retval *Model
+	err := loadChecked("LoadModel", ".obj;.iqm;.gltf;.glb", "No meshes can be loaded", func() Unloadable {
+		retval = LoadModel(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
 //LoadModelFromMesh : Load model from generated mesh (default material)
 func LoadModelFromMesh(mesh *Mesh) *Model {
+	checkMainThread("LoadModelFromMesh")
 	cmesh := *mesh.cptr()
 	res := C.LoadModelFromMesh(cmesh)
 	retval := newModelFromPointer(unsafe.Pointer(&res))
@@ -30,6 +46,7 @@ func LoadModelFromMesh(mesh *Mesh) *Model {
 
 //Unload : Unload model from memory (RAM and/or VRAM)
 func (model *Model) Unload() {
+	checkMainThread("UnloadModel")
 	cmodel := *model.cptr()
 	C.UnloadModel(cmodel)
 	UnregisterUnloadable(model)
@@ -41,8 +58,35 @@ func UnloadModel(model *Model) {
 	model.Unload()
 }
 
+//LoadMeshes : Load meshes from model file (OBJ, IQM, GLTF), without their materials. Every mesh has to be unloaded.
+// Unlike LoadModel, there is no default cube mesh if the file has none.
+func LoadMeshes(fileName string) []*Mesh {
+	checkMainThread("LoadMeshes")
+	cfileName := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cfileName))
+
+	cmeshCount := C.int(0)
+	res := C.LoadMeshes(cfileName, &cmeshCount)
+	defer C.free(unsafe.Pointer(res))
+
+	//Get the slice
+	count := int(cmeshCount)
+	tmpslice := (*[1 << 24]C.Mesh)(unsafe.Pointer(res))[:count:count]
+
+	//Copy the meshes out of the array, which is freed. Their data stays in C memory.
+	goslice := make([]*Mesh, count)
+	for i := range tmpslice {
+		mesh := *newMeshFromPointer(unsafe.Pointer(&tmpslice[i]))
+		goslice[i] = &mesh
+		RegisterUnloadable(goslice[i])
+	}
+
+	return goslice
+}
+
 //Export : Export mesh data to file
 func (mesh *Mesh) Export(fileName string) {
+	checkMainThread("ExportMesh")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 	cmesh := *mesh.cptr()
@@ -57,6 +101,7 @@ func ExportMesh(mesh *Mesh, fileName string) {
 
 //Unload : Unload mesh from memory (RAM and/or VRAM)
 func (mesh *Mesh) Unload() {
+	checkMainThread("UnloadMesh")
 	cmesh := *mesh.cptr()
 	C.UnloadMesh(cmesh)
 	UnregisterUnloadable(mesh)
@@ -70,6 +115,7 @@ func UnloadMesh(mesh *Mesh) {
 
 //LoadMaterialDefault : Load default material (Supports: DIFFUSE, SPECULAR, NORMAL maps)
 func LoadMaterialDefault() *Material {
+	checkMainThread("LoadMaterialDefault")
 	res := C.LoadMaterialDefault()
 	retval := newMaterialFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -78,6 +124,7 @@ func LoadMaterialDefault() *Material {
 
 //Unload : Unload material from GPU memory (VRAM)
 func (material *Material) Unload() {
+	checkMainThread("UnloadMaterial")
 	cmaterial := *material.cptr()
 	C.UnloadMaterial(cmaterial)
 	UnregisterUnloadable(material)
@@ -91,6 +138,7 @@ func UnloadMaterial(material *Material) {
 
 //SetTexture : Set texture for a material map type (MAP_DIFFUSE, MAP_SPECULAR...)
 func (material *Material) SetTexture(mapType MaterialMapType, texture Texture2D) {
+	checkMainThread("SetMaterialTexture")
 	ctexture := *texture.cptr()
 	cmaterial := material.cptr()
 	C.SetMaterialTexture(cmaterial, C.int(int32(mapType)), ctexture)
@@ -105,6 +153,7 @@ func SetMaterialTexture(material *Material, mapType MaterialMapType, texture Tex
 
 //SetMeshMaterial : Set material for a mesh
 func (model *Model) SetMeshMaterial(meshId int, materialId int) {
+	checkMainThread("SetModelMeshMaterial")
 	cmodel := model.cptr()
 	C.SetModelMeshMaterial(cmodel, C.int(int32(meshId)), C.int(int32(materialId)))
 }
@@ -117,6 +166,7 @@ func SetModelMeshMaterial(model *Model, meshId int, materialId int) {
 
 //LoadModelAnimations : Load model animations from file
 func LoadModelAnimations(fileName string) ([]ModelAnimation, int32) {
+	checkMainThread("LoadModelAnimations")
 	cfileName := C.CString(fileName)
 	ccount := C.int(0)
 	defer C.free(unsafe.Pointer(cfileName))
@@ -136,6 +186,7 @@ func LoadModelAnimations(fileName string) ([]ModelAnimation, int32) {
 
 //UpdateAnimation : Update model animation pose
 func (model *Model) UpdateAnimation(anim *ModelAnimation, frame int) {
+	checkMainThread("UpdateModelAnimation")
 	canim := *anim.cptr()
 	cmodel := *model.cptr()
 	C.UpdateModelAnimation(cmodel, canim, C.int(int32(frame)))
@@ -149,6 +200,7 @@ func UpdateModelAnimation(model *Model, anim *ModelAnimation, frame int) {
 
 //Unload : Unload animation data
 func (anim *ModelAnimation) Unload() {
+	checkMainThread("UnloadModelAnimation")
 	canim := *anim.cptr()
 	C.UnloadModelAnimation(canim)
 	UnregisterUnloadable(anim)
@@ -162,6 +214,7 @@ func UnloadModelAnimation(anim *ModelAnimation) {
 
 //IsAnimationValid : Check model animation skeleton match
 func (model *Model) IsAnimationValid(anim *ModelAnimation) bool {
+	checkMainThread("IsModelAnimationValid")
 	canim := *anim.cptr()
 	cmodel := *model.cptr()
 	res := C.IsModelAnimationValid(cmodel, canim)
@@ -176,6 +229,7 @@ func IsModelAnimationValid(model *Model, anim *ModelAnimation) bool {
 
 //GenMeshPoly : Generate polygonal mesh
 func GenMeshPoly(sides int, radius float32) *Mesh {
+	checkMainThread("GenMeshPoly")
 	res := C.GenMeshPoly(C.int(int32(sides)), C.float(radius))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -184,6 +238,7 @@ func GenMeshPoly(sides int, radius float32) *Mesh {
 
 //GenMeshPlane : Generate plane mesh (with subdivisions)
 func GenMeshPlane(width float32, length float32, resX int, resZ int) *Mesh {
+	checkMainThread("GenMeshPlane")
 	res := C.GenMeshPlane(C.float(width), C.float(length), C.int(int32(resX)), C.int(int32(resZ)))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -192,6 +247,7 @@ func GenMeshPlane(width float32, length float32, resX int, resZ int) *Mesh {
 
 //GenMeshCube : Generate cuboid mesh
 func GenMeshCube(width float32, height float32, length float32) *Mesh {
+	checkMainThread("GenMeshCube")
 	res := C.GenMeshCube(C.float(width), C.float(height), C.float(length))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -200,6 +256,7 @@ func GenMeshCube(width float32, height float32, length float32) *Mesh {
 
 //GenMeshSphere : Generate sphere mesh (standard sphere)
 func GenMeshSphere(radius float32, rings int, slices int) *Mesh {
+	checkMainThread("GenMeshSphere")
 	res := C.GenMeshSphere(C.float(radius), C.int(int32(rings)), C.int(int32(slices)))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -208,6 +265,7 @@ func GenMeshSphere(radius float32, rings int, slices int) *Mesh {
 
 //GenMeshHemiSphere : Generate half-sphere mesh (no bottom cap)
 func GenMeshHemiSphere(radius float32, rings int, slices int) *Mesh {
+	checkMainThread("GenMeshHemiSphere")
 	res := C.GenMeshHemiSphere(C.float(radius), C.int(int32(rings)), C.int(int32(slices)))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -216,6 +274,7 @@ func GenMeshHemiSphere(radius float32, rings int, slices int) *Mesh {
 
 //GenMeshCylinder : Generate cylinder mesh
 func GenMeshCylinder(radius float32, height float32, slices int) *Mesh {
+	checkMainThread("GenMeshCylinder")
 	res := C.GenMeshCylinder(C.float(radius), C.float(height), C.int(int32(slices)))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -224,6 +283,7 @@ func GenMeshCylinder(radius float32, height float32, slices int) *Mesh {
 
 //GenMeshTorus : Generate torus mesh
 func GenMeshTorus(radius float32, size float32, radSeg int, sides int) *Mesh {
+	checkMainThread("GenMeshTorus")
 	res := C.GenMeshTorus(C.float(radius), C.float(size), C.int(int32(radSeg)), C.int(int32(sides)))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -232,6 +292,7 @@ func GenMeshTorus(radius float32, size float32, radSeg int, sides int) *Mesh {
 
 //GenMeshKnot : Generate trefoil knot mesh
 func GenMeshKnot(radius float32, size float32, radSeg int, sides int) *Mesh {
+	checkMainThread("GenMeshKnot")
 	res := C.GenMeshKnot(C.float(radius), C.float(size), C.int(int32(radSeg)), C.int(int32(sides)))
 	retval := newMeshFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -240,6 +301,7 @@ func GenMeshKnot(radius float32, size float32, radSeg int, sides int) *Mesh {
 
 //GenMeshHeightmap : Generate heightmap mesh from image data
 func (heightmap *Image) GenMeshHeightmap(size Vector3) *Mesh {
+	checkMainThread("GenMeshHeightmap")
 	csize := *size.cptr()
 	cheightmap := *heightmap.cptr()
 	res := C.GenMeshHeightmap(cheightmap, csize)
@@ -256,6 +318,7 @@ func GenMeshHeightmap(heightmap *Image, size Vector3) *Mesh {
 
 //GenMeshCubicmap : Generate cubes-based map mesh from image data
 func (cubicmap *Image) GenMeshCubicmap(cubeSize Vector3) *Mesh {
+	checkMainThread("GenMeshCubicmap")
 	ccubeSize := *cubeSize.cptr()
 	ccubicmap := *cubicmap.cptr()
 	res := C.GenMeshCubicmap(ccubicmap, ccubeSize)
@@ -272,6 +335,7 @@ func GenMeshCubicmap(cubicmap *Image, cubeSize Vector3) *Mesh {
 
 //BoundingBox : Compute mesh bounding box limits
 func (mesh *Mesh) BoundingBox() BoundingBox {
+	checkMainThread("MeshBoundingBox")
 	cmesh := *mesh.cptr()
 	res := C.MeshBoundingBox(cmesh)
 	return newBoundingBoxFromPointer(unsafe.Pointer(&res))
@@ -285,6 +349,7 @@ func MeshBoundingBox(mesh *Mesh) BoundingBox {
 
 //ComputeTangents : Compute mesh tangents
 func (mesh *Mesh) ComputeTangents() {
+	checkMainThread("MeshTangents")
 	cmesh := mesh.cptr()
 	C.MeshTangents(cmesh)
 }
@@ -297,6 +362,7 @@ func MeshTangents(mesh *Mesh) {
 
 //ComputeBinormals : Compute mesh binormals
 func (mesh *Mesh) ComputeBinormals() {
+	checkMainThread("MeshBinormals")
 	cmesh := mesh.cptr()
 	C.MeshBinormals(cmesh)
 }
@@ -309,6 +375,7 @@ func MeshBinormals(mesh *Mesh) {
 
 //DrawModel : Draw a model (with texture if set)
 func DrawModel(model Model, position Vector3, scale float32, tint Color) {
+	checkMainThread("DrawModel")
 	ctint := *tint.cptr()
 	cposition := *position.cptr()
 	cmodel := *model.cptr()
@@ -317,6 +384,7 @@ func DrawModel(model Model, position Vector3, scale float32, tint Color) {
 
 //DrawModelEx : Draw a model with extended parameters
 func DrawModelEx(model Model, position Vector3, rotationAxis Vector3, rotationAngle float32, scale Vector3, tint Color) {
+	checkMainThread("DrawModelEx")
 	ctint := *tint.cptr()
 	cscale := *scale.cptr()
 	crotationAxis := *rotationAxis.cptr()
@@ -327,6 +395,7 @@ func DrawModelEx(model Model, position Vector3, rotationAxis Vector3, rotationAn
 
 //DrawModelWires : Draw a model wires (with texture if set)
 func DrawModelWires(model Model, position Vector3, scale float32, tint Color) {
+	checkMainThread("DrawModelWires")
 	ctint := *tint.cptr()
 	cposition := *position.cptr()
 	cmodel := *model.cptr()
@@ -335,6 +404,7 @@ func DrawModelWires(model Model, position Vector3, scale float32, tint Color) {
 
 //DrawModelWiresEx : Draw a model wires (with texture if set) with extended parameters
 func DrawModelWiresEx(model Model, position Vector3, rotationAxis Vector3, rotationAngle float32, scale Vector3, tint Color) {
+	checkMainThread("DrawModelWiresEx")
 	ctint := *tint.cptr()
 	cscale := *scale.cptr()
 	crotationAxis := *rotationAxis.cptr()
@@ -345,6 +415,7 @@ func DrawModelWiresEx(model Model, position Vector3, rotationAxis Vector3, rotat
 
 //DrawBoundingBox : Draw bounding box (wires)
 func DrawBoundingBox(box BoundingBox, color Color) {
+	checkMainThread("DrawBoundingBox")
 	ccolor := *color.cptr()
 	cbox := *box.cptr()
 	C.DrawBoundingBox(cbox, ccolor)
@@ -352,6 +423,7 @@ func DrawBoundingBox(box BoundingBox, color Color) {
 
 //DrawBillboard : Draw a billboard texture
 func DrawBillboard(camera Camera, texture Texture2D, center Vector3, size float32, tint Color) {
+	checkMainThread("DrawBillboard")
 	ctint := *tint.cptr()
 	ccenter := *center.cptr()
 	ctexture := *texture.cptr()
@@ -361,6 +433,7 @@ func DrawBillboard(camera Camera, texture Texture2D, center Vector3, size float3
 
 //DrawBillboardRec : Draw a billboard texture defined by sourceRec
 func DrawBillboardRec(camera Camera, texture Texture2D, sourceRec Rectangle, center Vector3, size float32, tint Color) {
+	checkMainThread("DrawBillboardRec")
 	ctint := *tint.cptr()
 	ccenter := *center.cptr()
 	csourceRec := *sourceRec.cptr()
@@ -0,0 +1,139 @@
+package raylib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+//testRampWAV encodes a wave whose every frame has the frame number (wrapped) in all of its channels
+func testRampWAV(t *testing.T, frames int, sampleRate uint32, channels int) []byte {
+	samples := make([]int16, frames*channels)
+	for i := range samples {
+		samples[i] = int16((i / channels) % 30000)
+	}
+	wave := NewWaveFromSamples(samples, sampleRate, uint32(channels))
+	defer wave.Unload()
+
+	var buffer bytes.Buffer
+	if err := wave.EncodeWAV(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+//testAudioDevice opens the audio device for a test, or skips it if there is none
+func testAudioDevice(t *testing.T) {
+	InitAudioDevice()
+	if !IsAudioDeviceReady() {
+		t.Skip("no audio device")
+	}
+	t.Cleanup(CloseAudioDevice)
+}
+
+func TestEncodeWAVDecodesEveryFrame(t *testing.T) {
+	data := testRampWAV(t, 44100, 22050, 2)
+	wave, err := LoadWaveFromMemory(data, ".wav")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wave.Unload()
+	if wave.SampleCount != 44100 || wave.SampleRate != 22050 || wave.Channels != 2 || wave.SampleSize != 16 {
+		t.Fatalf("decoded %d frames at %d Hz, %d channels of %d bits", wave.SampleCount, wave.SampleRate, wave.Channels, wave.SampleSize)
+	}
+	for i, s := range WaveSamples[int16](wave) {
+		if want := int16((i / 2) % 30000); s != want {
+			t.Fatalf("sample %d is %d, expected %d", i, s, want)
+		}
+	}
+}
+
+func TestMusicSeekIsSampleAccurate(t *testing.T) {
+	testAudioDevice(t)
+
+	tests := []struct {
+		sampleRate uint32
+		channels   int
+	}{
+		{44100, 1},
+		{22050, 2},
+		{48000, 2},
+	}
+	for _, test := range tests {
+		frames := int(test.sampleRate) * 4
+		music, err := LoadMusicStreamFromMemory(testRampWAV(t, frames, test.sampleRate, test.channels), ".wav")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if length := music.GetTimeLength(); length != 4 {
+			t.Errorf("%d Hz music is %v seconds long", test.sampleRate, length)
+		}
+
+		for _, frame := range []int{1, test.channels * 1000, frames / 3, frames - 1, 0, frames / 2} {
+			position := float32(frame) / float32(test.sampleRate)
+			music.Seek(position)
+			if got := music.Position(); got != position {
+				t.Errorf("%d Hz seek to frame %d is at %v, expected %v", test.sampleRate, frame, got, position)
+			}
+
+			//refilling the stream while stopped does not move it
+			music.UpdateStream()
+			if got := music.Position(); got != position {
+				t.Errorf("%d Hz seek to frame %d is at %v after an update, expected %v", test.sampleRate, frame, got, position)
+			}
+
+			//and the decoder lands on the frame, so the ramp continues from it in every channel.
+			// Past the end of the music the buffer is filled with silence.
+			queued := music.Stream.queuedFrames(64)
+			want := min(64, frames-frame) * test.channels * 2
+			if len(queued) < want {
+				t.Errorf("%d Hz seek to frame %d queued %d bytes, expected %d", test.sampleRate, frame, len(queued), want)
+				continue
+			}
+			for i := 0; i < want; i += 2 {
+				queuedFrame := frame + i/2/test.channels
+				if got, want := int16(binary.LittleEndian.Uint16(queued[i:])), int16(queuedFrame%30000); got != want {
+					t.Errorf("%d Hz seek to frame %d decoded %d for frame %d", test.sampleRate, frame, got, queuedFrame)
+					break
+				}
+			}
+		}
+
+		//seeking past the end stops at the end
+		music.Seek(10)
+		if got := music.Position(); got != 4 {
+			t.Errorf("%d Hz seek past the end is at %v", test.sampleRate, got)
+		}
+		music.Unload()
+	}
+}
+
+func TestMusicPositionWhilePlaying(t *testing.T) {
+	testAudioDevice(t)
+
+	music, err := LoadMusicStreamFromMemory(testRampWAV(t, 44100*4, 44100, 1), ".wav")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer music.Unload()
+
+	music.Seek(1)
+	music.PlayStream()
+	start := time.Now()
+	last := float32(1)
+	for i := 0; i < 20; i++ {
+		music.UpdateStream()
+		time.Sleep(10 * time.Millisecond)
+
+		//the position only moves forwards, and never past the audio that was queued or the time that went by
+		position := music.Position()
+		if position < last || position > music.GetTimePlayed() {
+			t.Fatalf("position %v after %v, with %v played", position, last, music.GetTimePlayed())
+		}
+		last = position
+	}
+	if elapsed := float32(time.Since(start).Seconds()); last > 1+elapsed+0.05 {
+		t.Fatalf("position %v after %v seconds", last, elapsed)
+	}
+}
@@ -0,0 +1,154 @@
+package raylib
+
+/*
+Function Bindings for physac.
+source: https://github.com/victorfisac/Physac/blob/master/src/physac.h
+*/
+
+/*
+#include "raylib.h"
+#include "physac.h"
+
+void Go_PhysicsStep(void); // Defined next to the physac implementation in physics_gen.go
+*/
+import "C"
+import "unsafe"
+
+const (
+	//PhysicsMaxBodies is the maximum amount of bodies physac can simulate at once
+	PhysicsMaxBodies = 64
+	//PhysicsMaxVertices is the maximum amount of vertices a polygon body can have
+	PhysicsMaxVertices = 24
+	//PhysicsCircleVertices is the amount of vertices used to approximate a circle body
+	PhysicsCircleVertices = 24
+)
+
+//PhysicsShapeType is the type of shape a body has
+type PhysicsShapeType int32
+
+const (
+	//PhysicsCircle is a circle shape
+	PhysicsCircle PhysicsShapeType = iota
+	//PhysicsPolygon is a polygon shape
+	PhysicsPolygon
+)
+
+//Matrix2x2 is used for polygon shape rotation
+type Matrix2x2 struct {
+	M00 float32
+	M01 float32
+	M10 float32
+	M11 float32
+}
+
+//PolygonData is the vertex data of a polygon shape
+type PolygonData struct {
+	// Current used vertex and normals count
+	VertexCount uint32
+	// Polygon vertex positions vectors
+	Positions [PhysicsMaxVertices]Vector2
+	// Polygon vertex normals vectors
+	Normals [PhysicsMaxVertices]Vector2
+}
+
+//PhysicsShape is the shape information of a body
+type PhysicsShape struct {
+	// Physics shape type (circle or polygon)
+	Type PhysicsShapeType
+	// Shape physics body reference
+	Body *PhysicsBody
+	// Circle shape radius (used for circle shapes)
+	Radius float32
+	// Vertices transform matrix 2x2
+	Transform Matrix2x2
+	// Polygon shape vertices position and normals data (just used for polygon shapes)
+	VertexData PolygonData
+}
+
+//PhysicsBody is a body simulated by physac.
+// Note that the body lives in C memory and is owned by physac. Changes to the fields are seen by the simulation.
+type PhysicsBody struct {
+	// Reference unique identifier
+	ID uint32
+	// Enabled dynamics state (collisions are calculated anyway)
+	Enabled bool
+	// Physics body shape pivot
+	Position Vector2
+	// Current linear velocity applied to position
+	Velocity Vector2
+	// Current linear force (reset to 0 every step)
+	Force Vector2
+	// Current angular velocity applied to orient
+	AngularVelocity float32
+	// Current angular force (reset to 0 every step)
+	Torque float32
+	// Rotation in radians
+	Orient float32
+	// Moment of inertia
+	Inertia float32
+	// Inverse value of inertia
+	InverseInertia float32
+	// Physics body mass
+	Mass float32
+	// Inverse value of mass
+	InverseMass float32
+	// Friction when the body has not movement (0 to 1)
+	StaticFriction float32
+	// Friction when the body has movement (0 to 1)
+	DynamicFriction float32
+	// Restitution coefficient of the body (0 to 1)
+	Restitution float32
+	// Apply gravity force to dynamics
+	UseGravity bool
+	// Physics grounded on other body state
+	IsGrounded bool
+	// Physics rotation constraint
+	FreezeOrient bool
+	// Physics body shape information (type, radius, vertices, normals)
+	Shape PhysicsShape
+}
+
+//newPhysicsBodyFromPointer takes a pointer to a C PhysicsBody, which itself is a pointer to the body data.
+func newPhysicsBodyFromPointer(ptr unsafe.Pointer) *PhysicsBody {
+	return (*PhysicsBody)(*(*unsafe.Pointer)(ptr))
+}
+
+func (body *PhysicsBody) cptr() *C.PhysicsBody {
+	cbody := C.PhysicsBody(unsafe.Pointer(body))
+	return &cbody
+}
+
+//physicsTimeStep mirrors the fixed time step (in milliseconds) given to physac
+var physicsTimeStep = 1.0 / 60.0 / 10.0 * 1000
+
+//physicsAccumulator is the time (in milliseconds) that UpdatePhysics has not simulated yet
+var physicsAccumulator = 0.0
+
+//StepPhysics runs exactly one fixed physics step, regardless of how much time has passed.
+// Physac is built without its own thread, so this or UpdatePhysics should be called from the game loop.
+func StepPhysics() {
+	C.Go_PhysicsStep()
+}
+
+//UpdatePhysics advances the simulation by deltaTime seconds (ie: GetFrameTime()) using fixed steps.
+// Time that does not fill a whole step is kept for the next call. Returns the number of steps that were run.
+// Unlike RunPhysicsStep, this does not read the clock so the simulation is deterministic.
+func UpdatePhysics(deltaTime float32) int {
+	physicsAccumulator += float64(deltaTime) * 1000
+	steps := 0
+	for physicsAccumulator >= physicsTimeStep {
+		C.Go_PhysicsStep()
+		physicsAccumulator -= physicsTimeStep
+		steps++
+	}
+	return steps
+}
+
+//unregisterPhysicsBodies removes every body in the pool from the unloadables, as physac is about to free them itself.
+func unregisterPhysicsBodies() {
+	for i := GetPhysicsBodiesCount() - 1; i >= 0; i-- {
+		if body := GetPhysicsBody(i); body != nil {
+			UnregisterUnloadable(body)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package raylib
+
+/*
+//Generated 2026-10-18T12:05:21Z
+#include "raylib.h"
+#include <stdlib.h>
+#include "go.h"
+#define PHYSAC_IMPLEMENTATION
+#define PHYSAC_NO_THREADS
+#include "physac.h"
+void Go_PhysicsStep(void) { PhysicsStep(); }
+*/
+import "C"
+import "unsafe"
+
+//InitPhysics : Initializes physics values, pointers and creates physics loop thread
+func InitPhysics() {
+	checkMainThread("InitPhysics")
+	C.InitPhysics()
+}
+
+//RunPhysicsStep : Run physics step, to be used if PHYSICS_NO_THREADS is set in your main loop
+func RunPhysicsStep() {
+	checkMainThread("RunPhysicsStep")
+	C.RunPhysicsStep()
+}
+
+//SetPhysicsTimeStep : Sets physics fixed time step in milliseconds. 1.666666 by default
+func SetPhysicsTimeStep(delta float64) {
+	checkMainThread("SetPhysicsTimeStep")
+	physicsTimeStep = delta
+	C.SetPhysicsTimeStep(C.double(delta))
+}
+
+//SetPhysicsGravity : Sets physics global gravity force
+func SetPhysicsGravity(x float32, y float32) {
+	checkMainThread("SetPhysicsGravity")
+	C.SetPhysicsGravity(C.float(x), C.float(y))
+}
+
+//CreatePhysicsBodyCircle : Creates a new circle physics body with generic parameters
+func CreatePhysicsBodyCircle(pos Vector2, radius float32, density float32) *PhysicsBody {
+	checkMainThread("CreatePhysicsBodyCircle")
+	cpos := *pos.cptr()
+	res := C.CreatePhysicsBodyCircle(cpos, C.float(radius), C.float(density))
+	retval := newPhysicsBodyFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+	return retval
+}
+
+//CreatePhysicsBodyRectangle : Creates a new rectangle physics body with generic parameters
+func CreatePhysicsBodyRectangle(pos Vector2, width float32, height float32, density float32) *PhysicsBody {
+	checkMainThread("CreatePhysicsBodyRectangle")
+	cpos := *pos.cptr()
+	res := C.CreatePhysicsBodyRectangle(cpos, C.float(width), C.float(height), C.float(density))
+	retval := newPhysicsBodyFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+	return retval
+}
+
+//CreatePhysicsBodyPolygon : Creates a new polygon physics body with generic parameters
+func CreatePhysicsBodyPolygon(pos Vector2, radius float32, sides int, density float32) *PhysicsBody {
+	checkMainThread("CreatePhysicsBodyPolygon")
+	cpos := *pos.cptr()
+	res := C.CreatePhysicsBodyPolygon(cpos, C.float(radius), C.int(int32(sides)), C.float(density))
+	retval := newPhysicsBodyFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+	return retval
+}
+
+//AddForce : Adds a force to a physics body
+func (body *PhysicsBody) AddForce(force Vector2) {
+	checkMainThread("PhysicsAddForce")
+	cforce := *force.cptr()
+	cbody := *body.cptr()
+	C.PhysicsAddForce(cbody, cforce)
+}
+
+//PhysicsAddForce : Adds a force to a physics body
+//Recommended to use body.AddForce(force) instead
+func PhysicsAddForce(body *PhysicsBody, force Vector2) {
+	body.AddForce(force)
+}
+
+//AddTorque : Adds an angular force to a physics body
+func (body *PhysicsBody) AddTorque(amount float32) {
+	checkMainThread("PhysicsAddTorque")
+	cbody := *body.cptr()
+	C.PhysicsAddTorque(cbody, C.float(amount))
+}
+
+//PhysicsAddTorque : Adds an angular force to a physics body
+//Recommended to use body.AddTorque(amount) instead
+func PhysicsAddTorque(body *PhysicsBody, amount float32) {
+	body.AddTorque(amount)
+}
+
+//Shatter : Shatters a polygon shape physics body to little physics bodies with explosion force.
+// If the body shatters it is destroyed and the new bodies are registered as unloadables in its place.
+func (body *PhysicsBody) Shatter(position Vector2, force float32) {
+	checkMainThread("PhysicsShatter")
+	cposition := *position.cptr()
+	cbody := *body.cptr()
+
+	before := GetPhysicsBodiesCount()
+	C.PhysicsShatter(cbody, cposition, C.float(force))
+	after := GetPhysicsBodiesCount()
+
+	//Nothing changed, so the position was not inside the body
+	if before == after {
+		return
+	}
+
+	//The body has been destroyed and the pieces have been appended to the end of the pool
+	UnregisterUnloadable(body)
+	for i := before - 1; i < after; i++ {
+		RegisterUnloadable(GetPhysicsBody(i))
+	}
+}
+
+//PhysicsShatter : Shatters a polygon shape physics body to little physics bodies with explosion force
+//Recommended to use body.Shatter(position, force) instead
+func PhysicsShatter(body *PhysicsBody, position Vector2, force float32) {
+	body.Shatter(position, force)
+}
+
+//GetPhysicsBodiesCount : Returns the current amount of created physics bodies
+func GetPhysicsBodiesCount() int {
+	checkMainThread("GetPhysicsBodiesCount")
+	res := C.GetPhysicsBodiesCount()
+	return int(int32(res))
+}
+
+//GetPhysicsBody : Returns a physics body of the bodies pool at a specific index
+func GetPhysicsBody(index int) *PhysicsBody {
+	checkMainThread("GetPhysicsBody")
+	res := C.GetPhysicsBody(C.int(int32(index)))
+	return newPhysicsBodyFromPointer(unsafe.Pointer(&res))
+}
+
+//GetPhysicsShapeType : Returns the physics body shape type (PHYSICS_CIRCLE or PHYSICS_POLYGON)
+func GetPhysicsShapeType(index int) int {
+	checkMainThread("GetPhysicsShapeType")
+	res := C.GetPhysicsShapeType(C.int(int32(index)))
+	return int(int32(res))
+}
+
+//GetPhysicsShapeVerticesCount : Returns the amount of vertices of a physics body shape
+func GetPhysicsShapeVerticesCount(index int) int {
+	checkMainThread("GetPhysicsShapeVerticesCount")
+	res := C.GetPhysicsShapeVerticesCount(C.int(int32(index)))
+	return int(int32(res))
+}
+
+//GetShapeVertex : Returns transformed position of a body shape (body position + vertex transformed position)
+func (body *PhysicsBody) GetShapeVertex(vertex int) Vector2 {
+	checkMainThread("GetPhysicsShapeVertex")
+	cbody := *body.cptr()
+	res := C.GetPhysicsShapeVertex(cbody, C.int(int32(vertex)))
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}
+
+//GetPhysicsShapeVertex : Returns transformed position of a body shape (body position + vertex transformed position)
+//Recommended to use body.GetShapeVertex(vertex) instead
+func GetPhysicsShapeVertex(body *PhysicsBody, vertex int) Vector2 {
+	return body.GetShapeVertex(vertex)
+}
+
+//SetRotation : Sets physics body shape transform based on radians parameter
+func (body *PhysicsBody) SetRotation(radians float32) {
+	checkMainThread("SetPhysicsBodyRotation")
+	cbody := *body.cptr()
+	C.SetPhysicsBodyRotation(cbody, C.float(radians))
+}
+
+//SetPhysicsBodyRotation : Sets physics body shape transform based on radians parameter
+//Recommended to use body.SetRotation(radians) instead
+func SetPhysicsBodyRotation(body *PhysicsBody, radians float32) {
+	body.SetRotation(radians)
+}
+
+//Unload : Unitializes and destroy a physics body
+func (body *PhysicsBody) Unload() {
+	checkMainThread("DestroyPhysicsBody")
+	cbody := *body.cptr()
+	C.DestroyPhysicsBody(cbody)
+	UnregisterUnloadable(body)
+}
+
+//DestroyPhysicsBody : Unitializes and destroy a physics body
+//Recommended to use body.Unload() instead
+func DestroyPhysicsBody(body *PhysicsBody) {
+	body.Unload()
+}
+
+//ResetPhysics : Destroys created physics bodies and manifolds and resets global values
+func ResetPhysics() {
+	checkMainThread("ResetPhysics")
+	unregisterPhysicsBodies()
+	physicsAccumulator = 0
+	C.ResetPhysics()
+}
+
+//ClosePhysics : Unitializes physics pointers and closes physics loop thread
+func ClosePhysics() {
+	checkMainThread("ClosePhysics")
+	unregisterPhysicsBodies()
+	physicsAccumulator = 0
+	C.ClosePhysics()
+}
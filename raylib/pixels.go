@@ -0,0 +1,296 @@
+package raylib
+
+//#include <stdlib.h>
+import "C"
+import "unsafe"
+
+//Pixel is the type of a pixel in an uncompressed PixelFormat, see PixelFormatOf
+type Pixel interface {
+	uint8 | PixelGrayAlpha | PixelR5g6b5 | PixelR8g8b8 | PixelR5g5b5a1 | PixelR4g4b4a4 | Color | float32 | Vector3 | Vector4
+}
+
+//PixelGrayAlpha is a pixel of an UncompressedGrayAlpha image
+type PixelGrayAlpha struct {
+	Gray  uint8
+	Alpha uint8
+}
+
+//PixelR5g6b5 is a pixel of an UncompressedR5g6b5 image, red is in the high bits
+type PixelR5g6b5 uint16
+
+//PixelR8g8b8 is a pixel of an UncompressedR8g8b8 image
+type PixelR8g8b8 struct {
+	R uint8
+	G uint8
+	B uint8
+}
+
+//PixelR5g5b5a1 is a pixel of an UncompressedR5g5b5a1 image, red is in the high bits and alpha is the lowest bit
+type PixelR5g5b5a1 uint16
+
+//PixelR4g4b4a4 is a pixel of an UncompressedR4g4b4a4 image, red is in the high bits
+type PixelR4g4b4a4 uint16
+
+//PixelFormatOf returns the format a pixel type views: uint8 is Grayscale, Color is R8G8B8A8, float32 is R32, Vector3 is R32G32B32 and Vector4 is R32G32B32A32
+func PixelFormatOf[T Pixel]() PixelFormat {
+	var pixel T
+	switch any(pixel).(type) {
+	case uint8:
+		return UncompressedGrayscale
+	case PixelGrayAlpha:
+		return UncompressedGrayAlpha
+	case PixelR5g6b5:
+		return UncompressedR5g6b5
+	case PixelR8g8b8:
+		return UncompressedR8g8b8
+	case PixelR5g5b5a1:
+		return UncompressedR5g5b5a1
+	case PixelR4g4b4a4:
+		return UncompressedR4g4b4a4
+	case Color:
+		return UncompressedR8g8b8a8
+	case float32:
+		return UncompressedR32
+	case Vector3:
+		return UncompressedR32g32b32
+	}
+	return UncompressedR32g32b32a32
+}
+
+//IsCompressed returns true if the format is a block compressed format, which cannot be viewed as pixels
+func (format PixelFormat) IsCompressed() bool {
+	return format >= CompressedDxt1Rgb
+}
+
+//BitsPerPixel returns the size of a pixel in bits, like GetPixelDataSize does
+func (format PixelFormat) BitsPerPixel() int {
+	switch format {
+	case UncompressedGrayscale:
+		return 8
+	case UncompressedGrayAlpha, UncompressedR5g6b5, UncompressedR5g5b5a1, UncompressedR4g4b4a4:
+		return 16
+	case UncompressedR8g8b8:
+		return 24
+	case UncompressedR8g8b8a8, UncompressedR32:
+		return 32
+	case UncompressedR32g32b32:
+		return 32 * 3
+	case UncompressedR32g32b32a32:
+		return 32 * 4
+	case CompressedDxt1Rgb, CompressedDxt1Rgba, CompressedEtc1Rgb, CompressedEtc2Rgb, CompressedPvrtRgb, CompressedPvrtRgba:
+		return 4
+	case CompressedDxt3Rgba, CompressedDxt5Rgba, CompressedEtc2EacRgba, CompressedAstc4x4Rgba:
+		return 8
+	case CompressedAstc8x8Rgba:
+		return 2
+	}
+	return 0
+}
+
+//pixelBytes returns the size of a pixel in an uncompressed format, or 0 if the format is compressed
+func (format PixelFormat) pixelBytes() int {
+	if format.IsCompressed() {
+		return 0
+	}
+	return format.BitsPerPixel() / 8
+}
+
+//dataSize is GetPixelDataSize without the cgo call
+func (format PixelFormat) dataSize(width, height int) int {
+	return width * height * format.BitsPerPixel() / 8
+}
+
+//MipmapSize returns the size of a mipmap level, the base level is 0. Each level is half the size of the previous one, down to 1x1.
+func (image *Image) MipmapSize(level int) (width, height int) {
+	width, height = int(image.Width), int(image.Height)
+	for ; level > 0; level-- {
+		width, height = max(width/2, 1), max(height/2, 1)
+	}
+	return width, height
+}
+
+//mipmapOffset returns the offset of a mipmap level in the image data
+func (image *Image) mipmapOffset(level int) int {
+	offset := 0
+	for i := 0; i < level; i++ {
+		offset += image.Format.dataSize(image.MipmapSize(i))
+	}
+	return offset
+}
+
+//Bytes gets the data of the image, with every mipmap level, without copying it. Compressed images are supported.
+// The slice must not be used after the image is unloaded, or its data is replaced by SetFormat, Resize, CreateMipmaps...
+func (image *Image) Bytes() []byte {
+	if image.data == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(image.data), image.mipmapOffset(int(image.Mipmaps)))
+}
+
+//Pixels gets the base level of the image without copying it, so changing the pixels changes the image.
+// Returns nil if T is not the pixel type of the image format (see PixelFormatOf). The same rules as Bytes apply.
+func Pixels[T Pixel](image *Image) []T {
+	return MipmapPixels[T](image, 0)
+}
+
+//MipmapPixels gets a mipmap level of the image without copying it, the base level is 0.
+// Returns nil if the level does not exist, or T is not the pixel type of the image format. The same rules as Bytes apply.
+func MipmapPixels[T Pixel](image *Image, level int) []T {
+	if image.data == nil || image.Format != PixelFormatOf[T]() || level < 0 || level >= int(image.Mipmaps) {
+		return nil
+	}
+	width, height := image.MipmapSize(level)
+	return unsafe.Slice((*T)(unsafe.Add(image.data, image.mipmapOffset(level))), width*height)
+}
+
+//PixelsAs copies the base level of the image into a new slice, converted to the pixel type T.
+// Returns nil if the image is empty or compressed. See ConvertPixels for how formats are converted.
+func PixelsAs[T Pixel](image *Image) []T {
+	if image.data == nil || image.Format.IsCompressed() {
+		return nil
+	}
+	pixels := make([]T, int(image.Width)*int(image.Height))
+	switch image.Format {
+	case UncompressedGrayscale:
+		ConvertPixels(pixels, Pixels[uint8](image))
+	case UncompressedGrayAlpha:
+		ConvertPixels(pixels, Pixels[PixelGrayAlpha](image))
+	case UncompressedR5g6b5:
+		ConvertPixels(pixels, Pixels[PixelR5g6b5](image))
+	case UncompressedR8g8b8:
+		ConvertPixels(pixels, Pixels[PixelR8g8b8](image))
+	case UncompressedR5g5b5a1:
+		ConvertPixels(pixels, Pixels[PixelR5g5b5a1](image))
+	case UncompressedR4g4b4a4:
+		ConvertPixels(pixels, Pixels[PixelR4g4b4a4](image))
+	case UncompressedR8g8b8a8:
+		ConvertPixels(pixels, Pixels[Color](image))
+	case UncompressedR32:
+		ConvertPixels(pixels, Pixels[float32](image))
+	case UncompressedR32g32b32:
+		ConvertPixels(pixels, Pixels[Vector3](image))
+	case UncompressedR32g32b32a32:
+		ConvertPixels(pixels, Pixels[Vector4](image))
+	}
+	return pixels
+}
+
+//NewImageFromPixels creates an image in the format of T (see PixelFormatOf) with a copy of the pixels.
+// Pixels missing from the slice are zero.
+func NewImageFromPixels[T Pixel](pixels []T, width, height int32) *Image {
+	format := PixelFormatOf[T]()
+	image := &Image{Width: width, Height: height, Mipmaps: 1, Format: format}
+	if width > 0 && height > 0 {
+		image.data = C.calloc(1, C.size_t(format.dataSize(int(width), int(height))))
+		copy(Pixels[T](image), pixels)
+	}
+	RegisterUnloadable(image)
+	return image
+}
+
+//ConvertPixels converts pixels between any two pixel types, and returns how many were converted, like copy.
+// Pixels go through normalized RGBA: float channels are not clamped between float types, so HDR values survive, and are clamped to [0..1] otherwise.
+// Gray formats use the luminance of the colour (0.299 R + 0.587 G + 0.114 B) like ImageFormat, and R32 is a gray format. Alpha is 1 in formats without it.
+func ConvertPixels[Dst, Src Pixel](dst []Dst, src []Src) int {
+	n := min(len(dst), len(src))
+	if PixelFormatOf[Dst]() == PixelFormatOf[Src]() {
+		return copy(dst, unsafe.Slice((*Dst)(unsafe.Pointer(unsafe.SliceData(src))), len(src)))
+	}
+	read, write := pixelReader(src), pixelWriter(dst)
+	for i := 0; i < n; i++ {
+		write(i, read(i))
+	}
+	return n
+}
+
+//pixelReader returns a function reading the pixels as normalized RGBA
+func pixelReader[T Pixel](pixels []T) func(i int) Vector4 {
+	switch p := any(pixels).(type) {
+	case []uint8:
+		return func(i int) Vector4 {
+			v := float32(p[i]) / 255
+			return Vector4{v, v, v, 1}
+		}
+	case []PixelGrayAlpha:
+		return func(i int) Vector4 {
+			v := float32(p[i].Gray) / 255
+			return Vector4{v, v, v, float32(p[i].Alpha) / 255}
+		}
+	case []PixelR5g6b5:
+		return func(i int) Vector4 {
+			v := p[i]
+			return Vector4{float32(v>>11) / 31, float32(v>>5&63) / 63, float32(v&31) / 31, 1}
+		}
+	case []PixelR8g8b8:
+		return func(i int) Vector4 {
+			return Vector4{float32(p[i].R) / 255, float32(p[i].G) / 255, float32(p[i].B) / 255, 1}
+		}
+	case []PixelR5g5b5a1:
+		return func(i int) Vector4 {
+			v := p[i]
+			return Vector4{float32(v>>11) / 31, float32(v>>6&31) / 31, float32(v>>1&31) / 31, float32(v & 1)}
+		}
+	case []PixelR4g4b4a4:
+		return func(i int) Vector4 {
+			v := p[i]
+			return Vector4{float32(v>>12) / 15, float32(v>>8&15) / 15, float32(v>>4&15) / 15, float32(v&15) / 15}
+		}
+	case []Color:
+		return func(i int) Vector4 { return p[i].Normalize() }
+	case []float32:
+		return func(i int) Vector4 { return Vector4{p[i], p[i], p[i], 1} }
+	case []Vector3:
+		return func(i int) Vector4 { return Vector4{p[i].X, p[i].Y, p[i].Z, 1} }
+	case []Vector4:
+		return func(i int) Vector4 { return p[i] }
+	}
+	panic("unreachable")
+}
+
+//pixelWriter returns a function writing normalized RGBA into the pixels
+func pixelWriter[T Pixel](pixels []T) func(i int, v Vector4) {
+	switch p := any(pixels).(type) {
+	case []uint8:
+		return func(i int, v Vector4) { p[i] = unitToUint8(luminance(v)) }
+	case []PixelGrayAlpha:
+		return func(i int, v Vector4) { p[i] = PixelGrayAlpha{unitToUint8(luminance(v)), unitToUint8(v.W)} }
+	case []PixelR5g6b5:
+		return func(i int, v Vector4) {
+			p[i] = PixelR5g6b5(unitToBits(v.X, 31)<<11 | unitToBits(v.Y, 63)<<5 | unitToBits(v.Z, 31))
+		}
+	case []PixelR8g8b8:
+		return func(i int, v Vector4) { p[i] = PixelR8g8b8{unitToUint8(v.X), unitToUint8(v.Y), unitToUint8(v.Z)} }
+	case []PixelR5g5b5a1:
+		return func(i int, v Vector4) {
+			p[i] = PixelR5g5b5a1(unitToBits(v.X, 31)<<11 | unitToBits(v.Y, 31)<<6 | unitToBits(v.Z, 31)<<1 | unitToBits(v.W, 1))
+		}
+	case []PixelR4g4b4a4:
+		return func(i int, v Vector4) {
+			p[i] = PixelR4g4b4a4(unitToBits(v.X, 15)<<12 | unitToBits(v.Y, 15)<<8 | unitToBits(v.Z, 15)<<4 | unitToBits(v.W, 15))
+		}
+	case []Color:
+		return func(i int, v Vector4) {
+			p[i] = Color{unitToUint8(v.X), unitToUint8(v.Y), unitToUint8(v.Z), unitToUint8(v.W)}
+		}
+	case []float32:
+		return func(i int, v Vector4) { p[i] = luminance(v) }
+	case []Vector3:
+		return func(i int, v Vector4) { p[i] = Vector3{v.X, v.Y, v.Z} }
+	case []Vector4:
+		return func(i int, v Vector4) { p[i] = v }
+	}
+	panic("unreachable")
+}
+
+func luminance(v Vector4) float32 {
+	return v.X*0.299 + v.Y*0.587 + v.Z*0.114
+}
+
+func unitToUint8(v float32) uint8 {
+	return uint8(Clamp32(v, 0, 1)*255 + 0.5)
+}
+
+//unitToBits clamps a channel to [0..1] and scales it to max
+func unitToBits(v float32, max uint16) uint16 {
+	return uint16(Clamp32(v, 0, 1)*float32(max) + 0.5)
+}
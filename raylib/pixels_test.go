@@ -0,0 +1,274 @@
+package raylib
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+	"unsafe"
+)
+
+var testFormats = []PixelFormat{UncompressedGrayscale, UncompressedGrayAlpha, UncompressedR5g6b5, UncompressedR8g8b8,
+	UncompressedR5g5b5a1, UncompressedR4g4b4a4, UncompressedR8g8b8a8, UncompressedR32, UncompressedR32g32b32, UncompressedR32g32b32a32}
+
+//testPixelsOf views the pixels of the image in the type of its format
+func testPixelsOf(img *Image) any {
+	switch img.Format {
+	case UncompressedGrayscale:
+		return Pixels[uint8](img)
+	case UncompressedGrayAlpha:
+		return Pixels[PixelGrayAlpha](img)
+	case UncompressedR5g6b5:
+		return Pixels[PixelR5g6b5](img)
+	case UncompressedR8g8b8:
+		return Pixels[PixelR8g8b8](img)
+	case UncompressedR5g5b5a1:
+		return Pixels[PixelR5g5b5a1](img)
+	case UncompressedR4g4b4a4:
+		return Pixels[PixelR4g4b4a4](img)
+	case UncompressedR8g8b8a8:
+		return Pixels[Color](img)
+	case UncompressedR32:
+		return Pixels[float32](img)
+	case UncompressedR32g32b32:
+		return Pixels[Vector3](img)
+	}
+	return Pixels[Vector4](img)
+}
+
+func TestPixelFormatSizes(t *testing.T) {
+	sizes := [][2]int{{1, 1}, {4, 4}, {7, 3}, {64, 32}, {300, 17}}
+	for format := UncompressedGrayscale; format <= CompressedAstc8x8Rgba; format++ {
+		if got, want := format.BitsPerPixel(), GetPixelDataSize(8, 1, format); got != want {
+			t.Errorf("format %d has %d bits per pixel, raylib has %d", format, got, want)
+		}
+		for _, size := range sizes {
+			if got, want := format.dataSize(size[0], size[1]), GetPixelDataSize(size[0], size[1], format); got != want {
+				t.Errorf("format %d at %dx%d is %d bytes, raylib has %d", format, size[0], size[1], got, want)
+			}
+		}
+
+		//every level is after the ones before it, in the size raylib gives it
+		image := &Image{Width: 300, Height: 17, Mipmaps: 10, Format: format}
+		offset := 0
+		for level := 0; level <= int(image.Mipmaps); level++ {
+			if got := image.mipmapOffset(level); got != offset {
+				t.Errorf("format %d level %d is at %d, expected %d", format, level, got, offset)
+			}
+			width, height := image.MipmapSize(level)
+			offset += GetPixelDataSize(width, height, format)
+		}
+	}
+}
+
+func TestPixelsOfEveryFormat(t *testing.T) {
+	for _, f := range testFormats {
+		img := GenImageColor(13, 7, Red)
+		img.SetFormat(f)
+		px := testPixelsOf(img)
+		var n int
+		var size uintptr
+		switch p := px.(type) {
+		case []uint8:
+			n, size = len(p), 1
+		case []PixelGrayAlpha:
+			n, size = len(p), unsafe.Sizeof(p[0])
+		case []PixelR5g6b5:
+			n, size = len(p), 2
+		case []PixelR8g8b8:
+			n, size = len(p), unsafe.Sizeof(p[0])
+		case []PixelR5g5b5a1:
+			n, size = len(p), 2
+		case []PixelR4g4b4a4:
+			n, size = len(p), 2
+		case []Color:
+			n, size = len(p), 4
+		case []float32:
+			n, size = len(p), 4
+		case []Vector3:
+			n, size = len(p), 12
+		case []Vector4:
+			n, size = len(p), 16
+		}
+		if n != 13*7 || int(size)*n != GetPixelDataSize(13, 7, f) || len(img.Bytes()) != GetPixelDataSize(13, 7, f) {
+			t.Errorf("format %d: %d pixels of %d", f, n, size)
+		}
+		if Pixels[Color](img) != nil && f != UncompressedR8g8b8a8 {
+			t.Errorf("format %d viewed as Color", f)
+		}
+		img.CreateMipmaps()
+		if img.Mipmaps != 4 {
+			t.Fatalf("mipmaps %d", img.Mipmaps)
+		}
+		total := 0
+		for l := 0; l < int(img.Mipmaps); l++ {
+			w, h := img.MipmapSize(l)
+			total += GetPixelDataSize(w, h, f)
+		}
+		if len(img.Bytes()) != total {
+			t.Errorf("format %d mip bytes %d != %d", f, len(img.Bytes()), total)
+		}
+		if w, h := img.MipmapSize(3); w != 1 || h != 1 {
+			t.Errorf("mip 3 %dx%d", w, h)
+		}
+		img.Unload()
+	}
+}
+
+func TestMipmapPixels(t *testing.T) {
+	img := GenImageColor(8, 4, Red)
+	defer img.Unload()
+	img.SetFormat(UncompressedR32g32b32a32)
+	img.CreateMipmaps()
+	for l := 0; l < int(img.Mipmaps); l++ {
+		px := MipmapPixels[Vector4](img, l)
+		w, h := img.MipmapSize(l)
+		if len(px) != w*h {
+			t.Fatal("mip len")
+		}
+		for _, p := range px {
+			if d := p.X - 230.0/255; d > 0.01 || d < -0.01 || p.W < 0.99 {
+				t.Fatalf("level %d %v", l, p)
+			}
+		}
+	}
+	if MipmapPixels[Vector4](img, int(img.Mipmaps)) != nil || MipmapPixels[Vector4](img, -1) != nil {
+		t.Fatal("bad level")
+	}
+	last := MipmapPixels[Vector4](img, int(img.Mipmaps)-1)
+	end := unsafe.Add(unsafe.Pointer(&last[len(last)-1]), 16)
+	if uintptr(end)-uintptr(img.data) != uintptr(len(img.Bytes())) {
+		t.Fatal("last mip not at end")
+	}
+}
+
+func TestConvertPixels(t *testing.T) {
+	src := []Vector4{{1, 0, 0, 1}, {0, 1, 0, 1}, {0, 0, 1, 1}, {1, 1, 1, 1}, {0, 0, 0, 1}, {0.5, 0.25, 0.75, 0.5}, {4, 2, 8, 1}}
+	hdr := NewImageFromPixels(src, 7, 1)
+	defer hdr.Unload()
+	for _, f := range testFormats {
+		img := hdr.Copy()
+		img.SetFormat(f)
+		conv := PixelsAs[Vector4](img)
+		if len(conv) != 7 {
+			t.Fatalf("format %d len", f)
+		}
+		for i := range conv {
+			want := img.At(i, 0)
+			if f == UncompressedR32 || f == UncompressedR32g32b32 || f == UncompressedR32g32b32a32 {
+				v := conv[i]
+				got := color.NRGBA64{unitToUint16(v.X), unitToUint16(v.Y), unitToUint16(v.Z), unitToUint16(v.W)}
+				if f == UncompressedR32 {
+					if (color.Gray16{got.R}) != want {
+						t.Errorf("format %d pixel %d %v != %v", f, i, got, want)
+					}
+				} else if got != want {
+					t.Errorf("format %d pixel %d %v != %v", f, i, got, want)
+				}
+				continue
+			}
+			c := PixelsAs[Color](img)[i]
+			r1, g1, b1, a1 := c.RGBA()
+			r2, g2, b2, a2 := want.RGBA()
+			if r1 != r2 || g1 != g2 || b1 != b2 || a1 != a2 {
+				t.Errorf("format %d pixel %d %v != %v", f, i, c, want)
+			}
+		}
+		img.Unload()
+	}
+	//HDR survives between float types and clamps otherwise
+	v3 := make([]Vector3, 7)
+	ConvertPixels(v3, src)
+	if v3[6] != (Vector3{4, 2, 8}) {
+		t.Fatalf("hdr %v", v3[6])
+	}
+	c := make([]Color, 7)
+	ConvertPixels(c, v3)
+	if c[6] != (Color{255, 255, 255, 255}) || c[0] != (Color{255, 0, 0, 255}) {
+		t.Fatalf("clamp %v", c)
+	}
+	//every format round trips a colour that every format can hold
+	white := []Vector4{{1, 1, 1, 1}, {0, 0, 0, 1}}
+	var round func(f PixelFormat) []Vector4
+	round = func(f PixelFormat) []Vector4 {
+		img := NewImageFromPixels(white, 2, 1)
+		defer img.Unload()
+		img.SetFormat(f)
+		return PixelsAs[Vector4](img)
+	}
+	for _, f := range testFormats {
+		if got := round(f); got[0] != white[0] || got[1] != white[1] {
+			t.Errorf("format %d %v", f, got)
+		}
+	}
+	gray := []uint8{10, 200}
+	ga := make([]PixelGrayAlpha, 2)
+	f32 := make([]float32, 2)
+	back := make([]uint8, 2)
+	ConvertPixels(ga, gray)
+	ConvertPixels(f32, ga)
+	ConvertPixels(back, f32)
+	if back[0] != 10 || back[1] != 200 || ga[1] != (PixelGrayAlpha{200, 255}) {
+		t.Fatalf("gray %v %v %v", ga, f32, back)
+	}
+	if n := ConvertPixels(make([]Color, 1), src); n != 1 {
+		t.Fatal("count")
+	}
+}
+
+func TestGetPixelsMatchesPixelsAs(t *testing.T) {
+	img := GenImageGradientH(16, 4, Red, Blue)
+	defer img.Unload()
+	want := PixelsAs[Color](img)
+	got := img.GetPixels()
+	norm := img.GetPixelsNormalized()
+	for i := range want {
+		if got[i] != want[i] || Pixels[Color](img)[i] != want[i] || norm[i] != want[i].Normalize() {
+			t.Fatal("GetPixels")
+		}
+	}
+	Pixels[Color](img)[0] = Green
+	if img.At(0, 0) != (color.NRGBA{0, 228, 48, 255}) {
+		t.Fatal("view does not alias")
+	}
+}
+
+func TestR32IsGrayEverywhere(t *testing.T) {
+	image := NewImageFromPixels([]float32{0, 0.25, 0.5, 1}, 2, 2)
+	defer image.Unload()
+
+	//the Go views
+	want := []Color{{0, 0, 0, 255}, {64, 64, 64, 255}, {128, 128, 128, 255}, {255, 255, 255, 255}}
+	for i, c := range PixelsAs[Color](image) {
+		if c != want[i] {
+			t.Errorf("PixelsAs pixel %d is %v, expected %v", i, c, want[i])
+		}
+		if gray := image.At(i%2, i/2).(color.Gray16); gray.Y>>8 != uint16(want[i].R) {
+			t.Errorf("At pixel %d is %v, expected %v", i, gray, want[i])
+		}
+	}
+
+	//raylib's GetImageData, which EncodePNG uses, reads it as gray too. It truncates where Go rounds.
+	var buffer bytes.Buffer
+	if err := image.EncodePNG(&buffer); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		c := color.NRGBAModel.Convert(decoded.At(i%2, i/2)).(color.NRGBA)
+		if c.R != c.G || c.R != c.B || int(want[i].R)-int(c.R) > 1 {
+			t.Errorf("encoded pixel %d is %v, expected %v", i, c, want[i])
+		}
+	}
+
+	//mipmaps are made through GetImageData, so they keep the gray value
+	mipmapped := NewImageFromPixels([]float32{0.5, 0.5, 0.5, 0.5}, 2, 2)
+	defer mipmapped.Unload()
+	mipmapped.CreateMipmaps()
+	if level := MipmapPixels[float32](mipmapped, 1); len(level) != 1 || level[0] < 0.49 || level[0] > 0.51 {
+		t.Errorf("mipmap level 1 is %v", level)
+	}
+}
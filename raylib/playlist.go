@@ -0,0 +1,464 @@
+package raylib
+
+import "math/rand"
+
+//PlaylistRepeat is what a Playlist does when a track ends
+type PlaylistRepeat int
+
+//Repeat modes
+const (
+	//RepeatNone stops after the last track
+	RepeatNone PlaylistRepeat = iota
+	//RepeatAll goes back to the first track after the last, shuffling them again if the playlist shuffles
+	RepeatAll
+	//RepeatOne plays the current track again
+	RepeatOne
+)
+
+//PlaylistEventType is what happened to a track
+type PlaylistEventType int
+
+//Playlist events
+const (
+	TrackStarted PlaylistEventType = iota
+	TrackEnded
+)
+
+//PlaylistEvent is sent on the Events channel of a Playlist when a track starts or ends
+type PlaylistEvent struct {
+	Type PlaylistEventType
+	//Track is the index of the track, in the order it was added
+	Track int
+	Music *Music
+}
+
+//playlistTrack is what a Playlist uses of a Music
+type playlistTrack interface {
+	PlayStream()
+	UpdateStream()
+	StopStream()
+	PauseStream()
+	ResumeStream()
+	IsPlaying() bool
+	SetVolume(volume float32)
+	GetTimePlayed() float32
+	GetTimeLength() float32
+	Unload()
+	setLooping(looping bool)
+}
+
+//setLooping makes UpdateStream loop the music forever, or stop it at the end.
+// SetLoopCount cannot do this as raylib gets the music by value, so the count has to be set on the Go side.
+func (music *Music) setLooping(looping bool) {
+	if looping {
+		music.LoopCount = 0
+	} else {
+		music.LoopCount = 1
+	}
+}
+
+//Playlist owns a list of Music streams and plays them one after another, calling UpdateStream on them itself.
+// The next track starts in the same Update that the last one ends in, or fades in over the last one with a crossfade.
+// Like a Tween, it is driven by calling Update with the time that has passed (or Tick, which uses GetFrameTime).
+type Playlist struct {
+	//Volume is the volume of the playlist, the fades are applied on top of it
+	Volume float32
+	//Crossfade is the seconds the next track fades in over the end of the current one. 0 does not fade.
+	Crossfade float32
+	//Repeat is what happens when a track ends
+	Repeat PlaylistRepeat
+
+	tracks   []playlistTrack
+	order    []int
+	position int
+	shuffle  bool
+	random   *rand.Rand
+
+	current      int
+	currentGain  float32
+	previous     int
+	previousGain float32
+	fades        []*Tween
+	lastPlayed   float32
+
+	playing bool
+	paused  bool
+
+	onStart func(track int, music *Music)
+	onEnd   func(track int, music *Music)
+	events  chan PlaylistEvent
+}
+
+//NewPlaylist creates an empty playlist, that repeats all of its tracks
+func NewPlaylist() *Playlist {
+	playlist := &Playlist{Volume: 1, Repeat: RepeatAll, current: -1, previous: -1}
+	RegisterUnloadable(playlist)
+	return playlist
+}
+
+//Add adds the music to the end of the playlist. The playlist owns the music and unloads it with itself.
+func (playlist *Playlist) Add(music *Music) {
+	UnregisterUnloadable(music)
+	playlist.add(music)
+}
+
+//Load loads the music stream and adds it to the end of the playlist
+func (playlist *Playlist) Load(fileName string) error {
+	music, err := LoadMusicStreamE(fileName)
+	if err != nil {
+		return err
+	}
+	playlist.Add(music)
+	return nil
+}
+
+func (playlist *Playlist) add(track playlistTrack) {
+	playlist.tracks = append(playlist.tracks, track)
+	playlist.order = append(playlist.order, len(playlist.tracks)-1)
+	if playlist.shuffle {
+		playlist.reshuffle()
+	}
+}
+
+//Len is the number of tracks in the playlist
+func (playlist *Playlist) Len() int {
+	return len(playlist.tracks)
+}
+
+//Track gets the music of the track, in the order it was added
+func (playlist *Playlist) Track(index int) *Music {
+	music, _ := playlist.tracks[index].(*Music)
+	return music
+}
+
+//SetShuffle sets if the tracks play in a random order. The order is shuffled now, and again every time the playlist repeats.
+// The random source can be nil for the default source.
+func (playlist *Playlist) SetShuffle(shuffle bool, random *rand.Rand) {
+	playlist.shuffle = shuffle
+	playlist.random = random
+	if shuffle {
+		playlist.reshuffle()
+		return
+	}
+
+	for i := range playlist.order {
+		playlist.order[i] = i
+	}
+	if playlist.current >= 0 {
+		playlist.position = playlist.current
+	}
+}
+
+//IsShuffled returns true if the tracks play in a random order
+func (playlist *Playlist) IsShuffled() bool {
+	return playlist.shuffle
+}
+
+//OnTrackStart sets the function called when a track starts
+func (playlist *Playlist) OnTrackStart(callback func(track int, music *Music)) {
+	playlist.onStart = callback
+}
+
+//OnTrackEnd sets the function called when a track ends, or is stopped
+func (playlist *Playlist) OnTrackEnd(callback func(track int, music *Music)) {
+	playlist.onEnd = callback
+}
+
+//Events gets a channel the start and end of the tracks are sent on. The events are dropped if the channel is full, which holds 16.
+func (playlist *Playlist) Events() <-chan PlaylistEvent {
+	if playlist.events == nil {
+		playlist.events = make(chan PlaylistEvent, 16)
+	}
+	return playlist.events
+}
+
+//Play starts the playlist from the first track in its order, or resumes it if it was paused
+func (playlist *Playlist) Play() {
+	if playlist.paused {
+		playlist.Resume()
+		return
+	}
+	if playlist.playing || len(playlist.tracks) == 0 {
+		return
+	}
+	playlist.playing = true
+	playlist.position = 0
+	playlist.start(playlist.order[0], false)
+}
+
+//PlayTrack stops what is playing and starts the track, in the order it was added
+func (playlist *Playlist) PlayTrack(index int) {
+	if index < 0 || index >= len(playlist.tracks) {
+		return
+	}
+	playlist.stopAll()
+	playlist.playing, playlist.paused = true, false
+	playlist.position = playlist.orderOf(index)
+	playlist.start(index, false)
+}
+
+//Next skips to the next track, crossfading into it
+func (playlist *Playlist) Next() {
+	if playlist.playing {
+		playlist.advance(1, playlist.Crossfade > 0)
+	}
+}
+
+//Previous skips back to the previous track, crossfading into it
+func (playlist *Playlist) Previous() {
+	if playlist.playing {
+		playlist.advance(-1, playlist.Crossfade > 0)
+	}
+}
+
+//Stop stops the playlist. Playing again starts from the first track.
+func (playlist *Playlist) Stop() {
+	playlist.stopAll()
+	playlist.playing, playlist.paused = false, false
+}
+
+//Pause pauses the playlist
+func (playlist *Playlist) Pause() {
+	if !playlist.playing || playlist.paused {
+		return
+	}
+	playlist.paused = true
+	playlist.each(playlistTrack.PauseStream)
+}
+
+//Resume resumes the paused playlist
+func (playlist *Playlist) Resume() {
+	if !playlist.paused {
+		return
+	}
+	playlist.paused = false
+	playlist.each(playlistTrack.ResumeStream)
+}
+
+//IsPlaying returns true if the playlist is playing, even if it is paused
+func (playlist *Playlist) IsPlaying() bool {
+	return playlist.playing
+}
+
+//IsPaused returns true if the playlist is paused
+func (playlist *Playlist) IsPaused() bool {
+	return playlist.paused
+}
+
+//Current gets the index of the current track (in the order it was added) and its music, or -1 and nil if nothing is playing
+func (playlist *Playlist) Current() (int, *Music) {
+	if playlist.current < 0 {
+		return -1, nil
+	}
+	return playlist.current, playlist.Track(playlist.current)
+}
+
+//Position gets the seconds played of the current track, with GetMusicTimePlayed
+func (playlist *Playlist) Position() float32 {
+	if playlist.current < 0 {
+		return 0
+	}
+	return playlist.tracks[playlist.current].GetTimePlayed()
+}
+
+//Length gets the length in seconds of the current track, with GetMusicTimeLength
+func (playlist *Playlist) Length() float32 {
+	if playlist.current < 0 {
+		return 0
+	}
+	return playlist.tracks[playlist.current].GetTimeLength()
+}
+
+//Tick updates the playlist with GetFrameTime
+func (playlist *Playlist) Tick() {
+	playlist.Update(GetFrameTime())
+}
+
+//Update streams the tracks that are playing, advances the crossfade by dt seconds, and moves on to the next track when the current one ends
+func (playlist *Playlist) Update(dt float32) {
+	if !playlist.playing || playlist.paused {
+		return
+	}
+
+	fades := playlist.fades[:0]
+	for _, fade := range playlist.fades {
+		if fade.Update(dt) {
+			fades = append(fades, fade)
+		}
+	}
+	playlist.fades = fades
+
+	//The track being faded out
+	if playlist.previous >= 0 {
+		previous := playlist.tracks[playlist.previous]
+		previous.UpdateStream()
+		previous.SetVolume(playlist.Volume * playlist.previousGain)
+		if playlist.previousGain <= 0 || !previous.IsPlaying() {
+			previous.StopStream()
+			playlist.emit(TrackEnded, playlist.previous)
+			playlist.previous = -1
+		}
+	}
+
+	current := playlist.tracks[playlist.current]
+	current.setLooping(playlist.Repeat == RepeatOne)
+	current.UpdateStream()
+	current.SetVolume(playlist.Volume * playlist.currentGain)
+
+	played := current.GetTimePlayed()
+	if playlist.Repeat == RepeatOne {
+		//The track loops by itself, without a gap
+		if played < playlist.lastPlayed {
+			playlist.emit(TrackEnded, playlist.current)
+			playlist.emit(TrackStarted, playlist.current)
+		}
+		playlist.lastPlayed = played
+		return
+	}
+
+	crossfade := playlist.Crossfade > 0 && playlist.previous < 0 && played > 0 && current.GetTimeLength()-played <= playlist.Crossfade
+	if crossfade && playlist.hasNext() {
+		playlist.advance(1, true)
+	} else if !current.IsPlaying() {
+		playlist.emit(TrackEnded, playlist.current)
+		playlist.current = -1
+		playlist.advance(1, false)
+	}
+}
+
+//Unload stops the playlist and unloads all of its music
+func (playlist *Playlist) Unload() {
+	playlist.Stop()
+	for _, track := range playlist.tracks {
+		track.Unload()
+	}
+	playlist.tracks, playlist.order = nil, nil
+	UnregisterUnloadable(playlist)
+}
+
+//advance moves the position by the step and starts that track.
+// Going past the last track stops the playlist or repeats it, and going before the first without repeating restarts it.
+func (playlist *Playlist) advance(step int, crossfade bool) {
+	position := playlist.position + step
+	count := len(playlist.order)
+	switch {
+	case position >= 0 && position < count:
+	case playlist.Repeat == RepeatNone && position < 0:
+		position = 0
+	case playlist.Repeat == RepeatNone:
+		playlist.Stop()
+		return
+	default:
+		if playlist.shuffle {
+			//A new shuffle should not play the track that just played again. It is the one at the position, as the current one is cleared when it ends.
+			last := playlist.order[playlist.position]
+			playlist.reshuffle()
+			if count > 1 && playlist.order[0] == last {
+				playlist.order[0], playlist.order[count-1] = playlist.order[count-1], playlist.order[0]
+			}
+		}
+		position = (position%count + count) % count
+	}
+	playlist.position = position
+	playlist.start(playlist.order[position], crossfade)
+}
+
+//hasNext returns true if another track plays after the current one, so it can be crossfaded into
+func (playlist *Playlist) hasNext() bool {
+	if playlist.position+1 < len(playlist.order) {
+		return true
+	}
+	return playlist.Repeat != RepeatNone && len(playlist.order) > 1
+}
+
+//start plays the track from its beginning. With a crossfade the current track becomes the previous one and fades out.
+func (playlist *Playlist) start(index int, crossfade bool) {
+	if playlist.previous >= 0 {
+		playlist.stopTrack(playlist.previous)
+	}
+	playlist.fades = playlist.fades[:0]
+
+	if playlist.current >= 0 && playlist.current != index {
+		if crossfade {
+			playlist.previous, playlist.previousGain = playlist.current, playlist.currentGain
+			playlist.fades = append(playlist.fades, TweenFloat32(&playlist.previousGain, 0, playlist.Crossfade, nil))
+		} else {
+			playlist.stopTrack(playlist.current)
+		}
+	} else if playlist.current == index {
+		playlist.stopTrack(index)
+	}
+
+	playlist.current, playlist.currentGain, playlist.lastPlayed = index, 1, 0
+	if crossfade {
+		playlist.currentGain = 0
+		playlist.fades = append(playlist.fades, TweenFloat32(&playlist.currentGain, 1, playlist.Crossfade, nil))
+	}
+
+	track := playlist.tracks[index]
+	track.setLooping(playlist.Repeat == RepeatOne)
+	track.SetVolume(playlist.Volume * playlist.currentGain)
+	track.PlayStream()
+	playlist.emit(TrackStarted, index)
+}
+
+func (playlist *Playlist) stopTrack(index int) {
+	playlist.tracks[index].StopStream()
+	playlist.emit(TrackEnded, index)
+}
+
+func (playlist *Playlist) stopAll() {
+	if playlist.previous >= 0 {
+		playlist.stopTrack(playlist.previous)
+	}
+	if playlist.current >= 0 {
+		playlist.stopTrack(playlist.current)
+	}
+	playlist.previous, playlist.current = -1, -1
+	playlist.fades = playlist.fades[:0]
+}
+
+func (playlist *Playlist) each(action func(track playlistTrack)) {
+	if playlist.previous >= 0 {
+		action(playlist.tracks[playlist.previous])
+	}
+	if playlist.current >= 0 {
+		action(playlist.tracks[playlist.current])
+	}
+}
+
+func (playlist *Playlist) emit(event PlaylistEventType, index int) {
+	music := playlist.Track(index)
+	callback := playlist.onStart
+	if event == TrackEnded {
+		callback = playlist.onEnd
+	}
+	if callback != nil {
+		callback(index, music)
+	}
+	if playlist.events != nil {
+		select {
+		case playlist.events <- PlaylistEvent{Type: event, Track: index, Music: music}:
+		default:
+		}
+	}
+}
+
+func (playlist *Playlist) reshuffle() {
+	shuffle := rand.Shuffle
+	if playlist.random != nil {
+		shuffle = playlist.random.Shuffle
+	}
+	shuffle(len(playlist.order), func(i, j int) { playlist.order[i], playlist.order[j] = playlist.order[j], playlist.order[i] })
+	playlist.position = playlist.orderOf(playlist.current)
+}
+
+//orderOf gets the position of the track in the order, or -1
+func (playlist *Playlist) orderOf(index int) int {
+	for position, track := range playlist.order {
+		if track == index {
+			return position
+		}
+	}
+	return -1
+}
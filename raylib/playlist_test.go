@@ -0,0 +1,248 @@
+package raylib
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+//testTrack is a track that plays half a second every UpdateStream
+type testTrack struct {
+	length  float32
+	played  float32
+	playing bool
+	looping bool
+	volume  float32
+	plays   int
+}
+
+func (track *testTrack) PlayStream() {
+	track.playing, track.played = true, 0
+	track.plays++
+}
+
+func (track *testTrack) UpdateStream() {
+	if !track.playing {
+		return
+	}
+	track.played += 0.5
+	if track.played >= track.length {
+		if track.looping {
+			track.played -= track.length
+		} else {
+			track.playing, track.played = false, 0
+		}
+	}
+}
+
+func (track *testTrack) StopStream()              { track.playing, track.played = false, 0 }
+func (track *testTrack) PauseStream()             {}
+func (track *testTrack) ResumeStream()            {}
+func (track *testTrack) IsPlaying() bool          { return track.playing }
+func (track *testTrack) SetVolume(volume float32) { track.volume = volume }
+func (track *testTrack) GetTimePlayed() float32   { return track.played }
+func (track *testTrack) GetTimeLength() float32   { return track.length }
+func (track *testTrack) Unload()                  {}
+func (track *testTrack) setLooping(looping bool)  { track.looping = looping }
+
+//testPlaylist creates a playlist of tracks that are length seconds long, and records its callbacks
+func testPlaylist(t *testing.T, count int, length float32) (*Playlist, []*testTrack, *[]string) {
+	playlist := NewPlaylist()
+	t.Cleanup(playlist.Unload)
+
+	tracks := make([]*testTrack, count)
+	for i := range tracks {
+		tracks[i] = &testTrack{length: length}
+		playlist.add(tracks[i])
+	}
+
+	callbacks := make([]string, 0)
+	playlist.OnTrackStart(func(track int, music *Music) { callbacks = append(callbacks, fmt.Sprint("+", track)) })
+	playlist.OnTrackEnd(func(track int, music *Music) { callbacks = append(callbacks, fmt.Sprint("-", track)) })
+	playlist.Events()
+	return playlist, tracks, &callbacks
+}
+
+//testPlaylistEvents checks the callbacks and the events sent since the last check are both want, with + for a start and - for an end
+func testPlaylistEvents(t *testing.T, playlist *Playlist, callbacks *[]string, want string) {
+	t.Helper()
+	events := make([]string, 0)
+	for len(playlist.events) > 0 {
+		event := <-playlist.events
+		sign := "+"
+		if event.Type == TrackEnded {
+			sign = "-"
+		}
+		events = append(events, fmt.Sprint(sign, event.Track))
+	}
+	if got := strings.Join(*callbacks, " "); got != want {
+		t.Errorf("the callbacks were %q, not %q", got, want)
+	}
+	if got := strings.Join(events, " "); got != want {
+		t.Errorf("the events were %q, not %q", got, want)
+	}
+	*callbacks = (*callbacks)[:0]
+}
+
+func TestPlaylistRepeatNone(t *testing.T) {
+	playlist, tracks, callbacks := testPlaylist(t, 3, 1)
+	playlist.Repeat = RepeatNone
+	playlist.Play()
+
+	//the next track starts in the same update the last one ends in
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "+0")
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "-0 +1")
+	if !tracks[1].playing || tracks[0].playing {
+		t.Fatal("the second track did not take over from the first")
+	}
+
+	for i := 0; i < 3; i++ {
+		playlist.Update(0.5)
+	}
+	if !playlist.IsPlaying() {
+		t.Fatal("the playlist stopped before the last track ended")
+	}
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "-1 +2 -2")
+	if playlist.IsPlaying() {
+		t.Fatal("the playlist kept playing after the last track")
+	}
+	if current, _ := playlist.Current(); current != -1 {
+		t.Fatalf("the current track is %d", current)
+	}
+}
+
+func TestPlaylistRepeatAll(t *testing.T) {
+	playlist, tracks, callbacks := testPlaylist(t, 2, 1)
+	playlist.Play()
+	for i := 0; i < 6; i++ {
+		playlist.Update(0.5)
+	}
+	testPlaylistEvents(t, playlist, callbacks, "+0 -0 +1 -1 +0 -0 +1")
+	if !playlist.IsPlaying() || tracks[0].plays != 2 || tracks[1].plays != 2 {
+		t.Fatalf("the tracks played %d and %d times", tracks[0].plays, tracks[1].plays)
+	}
+}
+
+func TestPlaylistRepeatOne(t *testing.T) {
+	playlist, tracks, callbacks := testPlaylist(t, 2, 1)
+	playlist.Repeat = RepeatOne
+	playlist.Play()
+
+	//the track loops by itself, and the loops are still sent as an end and a start
+	for i := 0; i < 4; i++ {
+		playlist.Update(0.5)
+	}
+	testPlaylistEvents(t, playlist, callbacks, "+0 -0 +0 -0 +0")
+	if !tracks[0].looping || tracks[0].plays != 1 || tracks[1].plays != 0 {
+		t.Fatalf("the first track was played %d times, and the second %d", tracks[0].plays, tracks[1].plays)
+	}
+
+	//turning it off lets the track end and moves on
+	playlist.Repeat = RepeatAll
+	playlist.Update(0.5)
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "-0 +1")
+}
+
+func TestPlaylistShuffle(t *testing.T) {
+	const count, rounds = 4, 20
+	for seed := int64(0); seed < 10; seed++ {
+		playlist, _, callbacks := testPlaylist(t, count, 0.5)
+		playlist.SetShuffle(true, rand.New(rand.NewSource(seed)))
+
+		started := make([]string, 0)
+		playlist.OnTrackStart(func(track int, music *Music) { started = append(started, fmt.Sprint(track)) })
+		playlist.Play()
+		for len(started) < count*rounds {
+			playlist.Update(0.5)
+		}
+		*callbacks = (*callbacks)[:0]
+
+		//every round plays each track once, and a new shuffle never starts with the track that just finished
+		for round := 0; round < rounds; round++ {
+			order := started[round*count : (round+1)*count]
+			seen := make(map[string]bool)
+			for _, track := range order {
+				seen[track] = true
+			}
+			if len(seen) != count {
+				t.Fatalf("seed %d: round %d played %v", seed, round, order)
+			}
+			if round > 0 && order[0] == started[round*count-1] {
+				t.Fatalf("seed %d: round %d started with the track that just finished, %v", seed, round, started[:(round+1)*count])
+			}
+		}
+	}
+}
+
+func TestPlaylistCrossfade(t *testing.T) {
+	playlist, tracks, callbacks := testPlaylist(t, 2, 4)
+	playlist.Repeat = RepeatNone
+	playlist.Crossfade = 1
+	playlist.Volume = 0.5
+	playlist.Play()
+
+	//the next track starts a crossfade before the end of the current one
+	for i := 0; i < 5; i++ {
+		playlist.Update(0.5)
+	}
+	testPlaylistEvents(t, playlist, callbacks, "+0")
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "+1")
+	if current, _ := playlist.Current(); current != 1 || tracks[1].volume != 0 || tracks[0].volume != 0.5 {
+		t.Fatalf("the crossfade started on %d with the volumes %v and %v", current, tracks[0].volume, tracks[1].volume)
+	}
+
+	//both play halfway through it, with the volume of the playlist on top of the fade
+	playlist.Update(0.5)
+	if !tracks[0].playing || !tracks[1].playing || !testNear(tracks[0].volume, 0.25) || !testNear(tracks[1].volume, 0.25) {
+		t.Fatalf("halfway through the crossfade the volumes are %v and %v", tracks[0].volume, tracks[1].volume)
+	}
+	testPlaylistEvents(t, playlist, callbacks, "")
+
+	//the faded out track ends once the fade does
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "-0")
+	if tracks[0].playing || tracks[0].volume != 0 || tracks[1].volume != 0.5 {
+		t.Fatalf("after the crossfade the volumes are %v and %v", tracks[0].volume, tracks[1].volume)
+	}
+
+	//the last track is not cut short by a crossfade when there is nothing to fade into
+	for i := 0; i < 5; i++ {
+		playlist.Update(0.5)
+	}
+	if !playlist.IsPlaying() || tracks[1].volume != 0.5 {
+		t.Fatalf("the last track was cut short at %v", tracks[1].played)
+	}
+	playlist.Update(0.5)
+	testPlaylistEvents(t, playlist, callbacks, "-1")
+	if playlist.IsPlaying() {
+		t.Fatal("the playlist kept playing after the last track")
+	}
+}
+
+func TestPlaylistSkip(t *testing.T) {
+	playlist, tracks, callbacks := testPlaylist(t, 3, 1)
+	playlist.Repeat = RepeatNone
+	playlist.Play()
+
+	//skipping stops the current track straight away when there is no crossfade
+	playlist.Previous()
+	playlist.Next()
+	playlist.Next()
+	testPlaylistEvents(t, playlist, callbacks, "+0 -0 +0 -0 +1 -1 +2")
+	if tracks[0].playing || tracks[1].playing || !tracks[2].playing {
+		t.Fatal("skipping left the other tracks playing")
+	}
+
+	playlist.PlayTrack(1)
+	playlist.Stop()
+	testPlaylistEvents(t, playlist, callbacks, "-2 +1 -1")
+	if playlist.IsPlaying() || tracks[1].playing {
+		t.Fatal("stopping left the playlist playing")
+	}
+}
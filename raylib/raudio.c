@@ -86,6 +86,12 @@
 #include <stdlib.h>             // Required for: malloc(), free()
 #include <string.h>             // Required for: strcmp(), strncmp()
 #include <stdio.h>              // Required for: FILE, fopen(), fclose(), fread()
+#include <math.h>               // Required for: sqrtf()
+
+#if defined(SUPPORT_FILEFORMAT_WAV)
+    #define DR_WAV_IMPLEMENTATION
+    #include "external/dr_wav.h"        // WAV music streaming functions
+#endif
 
 #if defined(SUPPORT_FILEFORMAT_OGG)
     #define STB_VORBIS_IMPLEMENTATION
@@ -201,6 +207,7 @@ struct rAudioBuffer {
 
     float volume;           // Audio buffer volume
     float pitch;            // Audio buffer pitch
+    float pan;              // Audio buffer pan (0.0f left, 0.5f center, 1.0f right)
 
     bool playing;           // Audio buffer state: AUDIO_PLAYING
     bool paused;            // Audio buffer state: AUDIO_PAUSED
@@ -240,7 +247,7 @@ static unsigned int audioBufferPoolChannels[MAX_AUDIO_BUFFER_POOL_CHANNELS] = {
 static void OnLog(ma_context *pContext, ma_device *pDevice, ma_uint32 logLevel, const char *message);
 static void OnSendAudioDataToDevice(ma_device *pDevice, void *pFramesOut, const void *pFramesInput, ma_uint32 frameCount);
 static ma_uint32 OnAudioBufferDSPRead(ma_pcm_converter *pDSP, void *pFramesOut, ma_uint32 frameCount, void *pUserData);
-static void MixAudioFrames(float *framesOut, const float *framesIn, ma_uint32 frameCount, float localVolume);
+static void MixAudioFrames(float *framesOut, const float *framesIn, ma_uint32 frameCount, float localVolume, float pan);
 
 // AudioBuffer management functions declaration
 // NOTE: Those functions are not exposed by raylib... for the moment
@@ -253,6 +260,7 @@ void PauseAudioBuffer(AudioBuffer *buffer);
 void ResumeAudioBuffer(AudioBuffer *buffer);
 void SetAudioBufferVolume(AudioBuffer *buffer, float volume);
 void SetAudioBufferPitch(AudioBuffer *buffer, float pitch);
+void SetAudioBufferPan(AudioBuffer *buffer, float pan);
 void TrackAudioBuffer(AudioBuffer *buffer);
 void UntrackAudioBuffer(AudioBuffer *buffer);
 
@@ -319,7 +327,7 @@ static void OnSendAudioDataToDevice(ma_device *pDevice, void *pFramesOut, const
                         float *framesOut = (float *)pFramesOut + (framesRead*device.playback.channels);
                         float *framesIn  = tempBuffer;
 
-                        MixAudioFrames(framesOut, framesIn, framesJustRead, audioBuffer->volume);
+                        MixAudioFrames(framesOut, framesIn, framesJustRead, audioBuffer->volume, audioBuffer->pan);
 
                         framesToRead -= framesJustRead;
                         framesRead += framesJustRead;
@@ -453,8 +461,23 @@ static ma_uint32 OnAudioBufferDSPRead(ma_pcm_converter *pDSP, void *pFramesOut,
 
 // This is the main mixing function. Mixing is pretty simple in this project - it's just an accumulation.
 // NOTE: framesOut is both an input and an output. It will be initially filled with zeros outside of this function.
-static void MixAudioFrames(float *framesOut, const float *framesIn, ma_uint32 frameCount, float localVolume)
+// NOTE: Pan is only applied to stereo output. It works as a balance: the center leaves both channels
+// at full volume, and panning to one side lowers the other channel down to silence.
+static void MixAudioFrames(float *framesOut, const float *framesIn, ma_uint32 frameCount, float localVolume, float pan)
 {
+    float levels[2] = { 1.0f, 1.0f };
+
+    if ((device.playback.channels == 2) && (pan != 0.5f))
+    {
+        if (pan < 0.0f) pan = 0.0f;
+        else if (pan > 1.0f) pan = 1.0f;
+
+        levels[0] = sqrtf(2.0f*(1.0f - pan));
+        levels[1] = sqrtf(2.0f*pan);
+        if (levels[0] > 1.0f) levels[0] = 1.0f;
+        if (levels[1] > 1.0f) levels[1] = 1.0f;
+    }
+
     for (ma_uint32 iFrame = 0; iFrame < frameCount; ++iFrame)
     {
         for (ma_uint32 iChannel = 0; iChannel < device.playback.channels; ++iChannel)
@@ -462,7 +485,7 @@ static void MixAudioFrames(float *framesOut, const float *framesIn, ma_uint32 fr
             float *frameOut = framesOut + (iFrame*device.playback.channels);
             const float *frameIn  = framesIn  + (iFrame*device.playback.channels);
 
-            frameOut[iChannel] += (frameIn[iChannel]*masterVolume*localVolume);
+            frameOut[iChannel] += (frameIn[iChannel]*masterVolume*localVolume*((iChannel < 2)? levels[iChannel] : 1.0f));
         }
     }
 }
@@ -478,12 +501,13 @@ static void InitAudioBufferPool()
 }
 
 // Close the audio buffers pool
+// NOTE: The buffers are untracked too, so the next audio device does not mix freed buffers
 static void CloseAudioBufferPool()
 {
-    for (int i = 0; i < MAX_AUDIO_BUFFER_POOL_CHANNELS; i++) 
+    for (int i = 0; i < MAX_AUDIO_BUFFER_POOL_CHANNELS; i++)
     {
-        RL_FREE(audioBufferPool[i]->buffer);
-        RL_FREE(audioBufferPool[i]);
+        CloseAudioBuffer(audioBufferPool[i]);
+        audioBufferPool[i] = NULL;
     }
 }
 
@@ -525,22 +549,24 @@ void InitAudioDevice(void)
         return;
     }
 
-    // Keep the device running the whole time. May want to consider doing something a bit smarter and only have the device running
-    // while there's at least one sound being played.
-    result = ma_device_start(&device);
-    if (result != MA_SUCCESS)
+    // Mixing happens on a seperate thread which means we need to synchronize. I'm using a mutex here to make things simple, but may
+    // want to look at something a bit smarter later on to keep everything real-time, if that's necessary.
+    // NOTE: The mutex is created before the device starts, as the mixing thread locks it as soon as it runs
+    if (ma_mutex_init(&context, &audioLock) != MA_SUCCESS)
     {
-        TraceLog(LOG_ERROR, "Failed to start audio playback device");
+        TraceLog(LOG_ERROR, "Failed to create mutex for audio mixing");
         ma_device_uninit(&device);
         ma_context_uninit(&context);
         return;
     }
 
-    // Mixing happens on a seperate thread which means we need to synchronize. I'm using a mutex here to make things simple, but may
-    // want to look at something a bit smarter later on to keep everything real-time, if that's necessary.
-    if (ma_mutex_init(&context, &audioLock) != MA_SUCCESS)
+    // Keep the device running the whole time. May want to consider doing something a bit smarter and only have the device running
+    // while there's at least one sound being played.
+    result = ma_device_start(&device);
+    if (result != MA_SUCCESS)
     {
-        TraceLog(LOG_ERROR, "Failed to create mutex for audio mixing");
+        TraceLog(LOG_ERROR, "Failed to start audio playback device");
+        ma_mutex_uninit(&audioLock);
         ma_device_uninit(&device);
         ma_context_uninit(&context);
         return;
@@ -564,11 +590,13 @@ void CloseAudioDevice(void)
 {
     if (isAudioInitialized)
     {
-        ma_mutex_uninit(&audioLock);
+        // NOTE: The device is stopped before the mutex is destroyed, as the mixing thread could be holding it
         ma_device_uninit(&device);
+        CloseAudioBufferPool();
+        ma_mutex_uninit(&audioLock);
         ma_context_uninit(&context);
 
-        CloseAudioBufferPool();
+        isAudioInitialized = false;
 
         TraceLog(LOG_INFO, "Audio device closed successfully");
     }
@@ -632,6 +660,7 @@ AudioBuffer *InitAudioBuffer(ma_format format, ma_uint32 channels, ma_uint32 sam
     // Init audio buffer values
     audioBuffer->volume = 1.0f;
     audioBuffer->pitch = 1.0f;
+    audioBuffer->pan = 0.5f;
     audioBuffer->playing = false;
     audioBuffer->paused = false;
     audioBuffer->looping = false;
@@ -650,6 +679,13 @@ AudioBuffer *InitAudioBuffer(ma_format format, ma_uint32 channels, ma_uint32 sam
     return audioBuffer;
 }
 
+// Set pan for an audio buffer
+void SetAudioBufferPan(AudioBuffer *buffer, float pan)
+{
+    if (buffer != NULL) buffer->pan = pan;
+    else TraceLog(LOG_WARNING, "SetAudioBufferPan() : No audio buffer");
+}
+
 // Delete an audio buffer
 void CloseAudioBuffer(AudioBuffer *buffer)
 {
@@ -837,7 +873,7 @@ Sound LoadSoundFromWave(Wave wave)
         // First option has been selected, format conversion is done on the loading stage.
         // The downside is that it uses more memory if the original sound is u8 or s16.
         ma_format formatIn  = ((wave.sampleSize == 8)? ma_format_u8 : ((wave.sampleSize == 16)? ma_format_s16 : ma_format_f32));
-        ma_uint32 frameCountIn = wave.sampleCount/wave.channels;
+        ma_uint32 frameCountIn = wave.sampleCount;     // NOTE: wave.sampleCount is the frame count, like WaveFormat() and WaveCrop() use it
 
         ma_uint32 frameCount = (ma_uint32)ma_convert_frames(NULL, DEVICE_FORMAT, DEVICE_CHANNELS, DEVICE_SAMPLE_RATE, NULL, formatIn, wave.channels, wave.sampleRate, frameCountIn);
         if (frameCount == 0) TraceLog(LOG_WARNING, "LoadSoundFromWave() : Failed to get frame count for format conversion");
@@ -1014,6 +1050,7 @@ void PlaySoundMulti(Sound sound)
 
     audioBufferPool[index]->volume = sound.stream.buffer->volume;
     audioBufferPool[index]->pitch = sound.stream.buffer->pitch;
+    audioBufferPool[index]->pan = sound.stream.buffer->pan;
     audioBufferPool[index]->looping = sound.stream.buffer->looping;
     audioBufferPool[index]->usage = sound.stream.buffer->usage;
     audioBufferPool[index]->isSubBufferProcessed[0] = false;
@@ -1079,13 +1116,19 @@ void SetSoundPitch(Sound sound, float pitch)
     SetAudioBufferPitch(sound.stream.buffer, pitch);
 }
 
+// Set pan for a sound (0.0f left, 0.5f center, 1.0f right)
+void SetSoundPan(Sound sound, float pan)
+{
+    SetAudioBufferPan(sound.stream.buffer, pan);
+}
+
 // Convert wave data to desired format
 void WaveFormat(Wave *wave, int sampleRate, int sampleSize, int channels)
 {
     ma_format formatIn  = ((wave->sampleSize == 8)? ma_format_u8 : ((wave->sampleSize == 16)? ma_format_s16 : ma_format_f32));
     ma_format formatOut = ((      sampleSize == 8)? ma_format_u8 : ((      sampleSize == 16)? ma_format_s16 : ma_format_f32));
 
-    ma_uint32 frameCountIn = wave->sampleCount;  // Is wave->sampleCount actually the frame count? That terminology needs to change, if so.
+    ma_uint32 frameCountIn = wave->sampleCount;  // NOTE: wave->sampleCount is the frame count (a sample for every channel)
 
     ma_uint32 frameCount = (ma_uint32)ma_convert_frames(NULL, formatOut, channels, sampleRate, NULL, formatIn, wave->channels, wave->sampleRate, frameCountIn);
     if (frameCount == 0)
@@ -1181,6 +1224,30 @@ Music LoadMusicStream(const char *fileName)
     bool musicLoaded = false;
 
     if (false) { }
+#if defined(SUPPORT_FILEFORMAT_WAV)
+    else if (IsFileExtension(fileName, ".wav"))
+    {
+        drwav *ctxWav = RL_CALLOC(1, sizeof(drwav));
+        music.ctxData = ctxWav;
+        music.ctxType = MUSIC_AUDIO_WAV;
+
+        if (drwav_init_file(ctxWav, fileName))
+        {
+            // NOTE: 32 bit float data is streamed as it is, anything else is converted to 16 bit on UpdateMusicStream()
+            int sampleSize = ((ctxWav->bitsPerSample == 32) && (ctxWav->translatedFormatTag == DR_WAVE_FORMAT_IEEE_FLOAT))? 32 : 16;
+
+            music.stream = InitAudioStream(ctxWav->sampleRate, sampleSize, ctxWav->channels);
+            music.sampleCount = (unsigned int)ctxWav->totalPCMFrameCount*ctxWav->channels;
+            music.loopCount = 0;   // Infinite loop by default
+            musicLoaded = true;
+        }
+        else
+        {
+            RL_FREE(ctxWav);
+            music.ctxData = NULL;
+        }
+    }
+#endif
 #if defined(SUPPORT_FILEFORMAT_OGG)
     else if (IsFileExtension(fileName, ".ogg"))
     {
@@ -1321,6 +1388,9 @@ void UnloadMusicStream(Music music)
     CloseAudioStream(music.stream);
 
     if (false) { }
+#if defined(SUPPORT_FILEFORMAT_WAV)
+    else if ((music.ctxType == MUSIC_AUDIO_WAV) && (music.ctxData != NULL)) { drwav_uninit((drwav *)music.ctxData); RL_FREE(music.ctxData); }
+#endif
 #if defined(SUPPORT_FILEFORMAT_OGG)
     else if (music.ctxType == MUSIC_AUDIO_OGG) stb_vorbis_close((stb_vorbis *)music.ctxData);
 #endif
@@ -1377,6 +1447,9 @@ void StopMusicStream(Music music)
     // Restart music context
     switch (music.ctxType)
     {
+#if defined(SUPPORT_FILEFORMAT_WAV)
+        case MUSIC_AUDIO_WAV: if (music.ctxData != NULL) drwav_seek_to_pcm_frame((drwav *)music.ctxData, 0); break;
+#endif
 #if defined(SUPPORT_FILEFORMAT_OGG)
         case MUSIC_AUDIO_OGG: stb_vorbis_seek_start((stb_vorbis *)music.ctxData); break;
 #endif
@@ -1419,6 +1492,14 @@ void UpdateMusicStream(Music music)
 
         switch (music.ctxType)
         {
+        #if defined(SUPPORT_FILEFORMAT_WAV)
+            case MUSIC_AUDIO_WAV:
+            {
+                if (music.stream.sampleSize == 32) drwav_read_pcm_frames_f32((drwav *)music.ctxData, samplesCount/music.stream.channels, (float *)pcm);
+                else drwav_read_pcm_frames_s16((drwav *)music.ctxData, samplesCount/music.stream.channels, (short *)pcm);
+
+            } break;
+        #endif
         #if defined(SUPPORT_FILEFORMAT_OGG)
             case MUSIC_AUDIO_OGG:
             {
@@ -1431,7 +1512,7 @@ void UpdateMusicStream(Music music)
             case MUSIC_AUDIO_FLAC:
             {
                 // NOTE: Returns the number of samples to process (not required)
-                drflac_read_pcm_frames_s16((drflac *)music.ctxData, samplesCount, (short *)pcm);
+                drflac_read_pcm_frames_s16((drflac *)music.ctxData, samplesCount/music.stream.channels, (short *)pcm);
 
             } break;
         #endif
@@ -1518,6 +1599,12 @@ void SetMusicPitch(Music music, float pitch)
     SetAudioStreamPitch(music.stream, pitch);
 }
 
+// Set pan for a music (0.0f left, 0.5f center, 1.0f right)
+void SetMusicPan(Music music, float pan)
+{
+    SetAudioStreamPan(music.stream, pan);
+}
+
 // Set music loop count (loop repeats)
 // NOTE: If set to 0, means infinite loop
 void SetMusicLoopCount(Music music, int count)
@@ -1547,6 +1634,101 @@ float GetMusicTimePlayed(Music music)
     return secondsPlayed;
 }
 
+// Seek music to a position (in seconds)
+// NOTE: Modules can not seek, so they are played from the start up to the position without being heard
+void SeekMusicStream(Music music, float position)
+{
+    AudioBuffer *audioBuffer = music.stream.buffer;
+
+    if (audioBuffer == NULL)
+    {
+        TraceLog(LOG_ERROR, "SeekMusicStream() : No audio buffer");
+        return;
+    }
+
+    unsigned int frameCount = music.sampleCount/music.stream.channels;
+    unsigned int frame = (position > 0.0f)? (unsigned int)(position*music.stream.sampleRate + 0.5f) : 0;
+    if (frame > frameCount) frame = frameCount;
+
+    switch (music.ctxType)
+    {
+#if defined(SUPPORT_FILEFORMAT_WAV)
+        case MUSIC_AUDIO_WAV: drwav_seek_to_pcm_frame((drwav *)music.ctxData, frame); break;
+#endif
+#if defined(SUPPORT_FILEFORMAT_OGG)
+        case MUSIC_AUDIO_OGG: stb_vorbis_seek((stb_vorbis *)music.ctxData, frame); break;
+#endif
+#if defined(SUPPORT_FILEFORMAT_FLAC)
+        case MUSIC_AUDIO_FLAC: drflac_seek_to_pcm_frame((drflac *)music.ctxData, frame); break;
+#endif
+#if defined(SUPPORT_FILEFORMAT_MP3)
+        case MUSIC_AUDIO_MP3: drmp3_seek_to_pcm_frame((drmp3 *)music.ctxData, frame); break;
+#endif
+#if defined(SUPPORT_FILEFORMAT_XM)
+        case MUSIC_MODULE_XM:
+        {
+            jar_xm_reset((jar_xm_context_t *)music.ctxData);
+
+            // NOTE: A NULL output generates the samples without keeping them
+            for (unsigned int skipped = 0; skipped < frame; skipped += AUDIO_BUFFER_SIZE)
+            {
+                unsigned int framesToSkip = ((frame - skipped) < AUDIO_BUFFER_SIZE)? (frame - skipped) : AUDIO_BUFFER_SIZE;
+                jar_xm_generate_samples_16bit((jar_xm_context_t *)music.ctxData, NULL, framesToSkip);
+            }
+        } break;
+#endif
+#if defined(SUPPORT_FILEFORMAT_MOD)
+        case MUSIC_MODULE_MOD:
+        {
+            jar_mod_seek_start((jar_mod_context_t *)music.ctxData);
+
+            short *skipBuffer = RL_MALLOC(AUDIO_BUFFER_SIZE*2*sizeof(short));
+            for (unsigned int skipped = 0; skipped < frame; skipped += AUDIO_BUFFER_SIZE)
+            {
+                unsigned int framesToSkip = ((frame - skipped) < AUDIO_BUFFER_SIZE)? (frame - skipped) : AUDIO_BUFFER_SIZE;
+                jar_mod_fillbuffer((jar_mod_context_t *)music.ctxData, skipBuffer, framesToSkip, 0);
+            }
+            RL_FREE(skipBuffer);
+        } break;
+#endif
+        default: break;
+    }
+
+    // Drop the queued audio from before the seek, so the next UpdateMusicStream() refills both halves from the new position
+    ma_mutex_lock(&audioLock);
+    audioBuffer->isSubBufferProcessed[0] = true;
+    audioBuffer->isSubBufferProcessed[1] = true;
+    audioBuffer->frameCursorPos = 0;
+    audioBuffer->totalFramesProcessed = frame;
+    ma_mutex_unlock(&audioLock);
+}
+
+// Get current music position (in seconds), of the audio being heard rather than the audio queued
+float GetMusicTimePosition(Music music)
+{
+    AudioBuffer *audioBuffer = music.stream.buffer;
+
+    if ((audioBuffer == NULL) || (music.stream.sampleRate == 0)) return 0.0f;
+
+    ma_mutex_lock(&audioLock);
+
+    unsigned int subBufferSizeInFrames = audioBuffer->bufferSizeInFrames/2;
+    unsigned int currentSubBufferIndex = audioBuffer->frameCursorPos/subBufferSizeInFrames;
+    unsigned int framesQueued = 0;
+
+    for (int i = 0; i < 2; i++) if (!audioBuffer->isSubBufferProcessed[i]) framesQueued += subBufferSizeInFrames;
+    if ((currentSubBufferIndex < 2) && !audioBuffer->isSubBufferProcessed[currentSubBufferIndex]) framesQueued -= audioBuffer->frameCursorPos%subBufferSizeInFrames;
+
+    unsigned int framesPlayed = (audioBuffer->totalFramesProcessed > framesQueued)? audioBuffer->totalFramesProcessed - framesQueued : 0;
+
+    ma_mutex_unlock(&audioLock);
+
+    unsigned int frameCount = music.sampleCount/music.stream.channels;
+    if (framesPlayed > frameCount) framesPlayed = frameCount;
+
+    return (float)framesPlayed/music.stream.sampleRate;
+}
+
 // Init audio stream (to stream audio pcm data)
 AudioStream InitAudioStream(unsigned int sampleRate, unsigned int sampleSize, unsigned int channels)
 {
@@ -1576,6 +1758,51 @@ AudioStream InitAudioStream(unsigned int sampleRate, unsigned int sampleSize, un
     return stream;
 }
 
+// Get the size of each of the two buffers of an audio stream (in frames)
+// NOTE: It is AUDIO_BUFFER_SIZE, unless the period of the device is bigger
+unsigned int GetAudioStreamBufferSize(AudioStream stream)
+{
+    if (stream.buffer == NULL) return 0;
+
+    return stream.buffer->bufferSizeInFrames/2;
+}
+
+// Copy the frames queued in the buffer of an audio stream, starting from the next frame to be played
+// NOTE: The frames are in the format of the stream, returns how many were copied
+unsigned int ReadAudioStreamBuffer(AudioStream stream, void *data, unsigned int frameCount)
+{
+    AudioBuffer *audioBuffer = stream.buffer;
+
+    if ((audioBuffer == NULL) || (audioBuffer->bufferSizeInFrames == 0)) return 0;
+
+    unsigned int frameSize = stream.channels*(stream.sampleSize/8);
+    unsigned int subBufferSizeInFrames = audioBuffer->bufferSizeInFrames/2;
+    unsigned int framesRead = 0;
+
+    ma_mutex_lock(&audioLock);
+
+    unsigned int cursor = audioBuffer->frameCursorPos%audioBuffer->bufferSizeInFrames;
+
+    while (framesRead < frameCount)
+    {
+        unsigned int subBuffer = cursor/subBufferSizeInFrames;
+        if (audioBuffer->isSubBufferProcessed[subBuffer]) break;
+
+        unsigned int framesToRead = (subBuffer + 1)*subBufferSizeInFrames - cursor;
+        if (framesToRead > (frameCount - framesRead)) framesToRead = frameCount - framesRead;
+
+        memcpy((unsigned char *)data + framesRead*frameSize, audioBuffer->buffer + cursor*frameSize, framesToRead*frameSize);
+        framesRead += framesToRead;
+        cursor = (cursor + framesToRead)%audioBuffer->bufferSizeInFrames;
+
+        if (cursor == audioBuffer->frameCursorPos%audioBuffer->bufferSizeInFrames) break;
+    }
+
+    ma_mutex_unlock(&audioLock);
+
+    return framesRead;
+}
+
 // Close audio stream and free memory
 void CloseAudioStream(AudioStream stream)
 {
@@ -1693,6 +1920,12 @@ void SetAudioStreamPitch(AudioStream stream, float pitch)
     SetAudioBufferPitch(stream.buffer, pitch);
 }
 
+// Set pan for audio stream (0.0f left, 0.5f center, 1.0f right)
+void SetAudioStreamPan(AudioStream stream, float pan)
+{
+    SetAudioBufferPan(stream.buffer, pan);
+}
+
 //----------------------------------------------------------------------------------
 // Module specific Functions Definition
 //----------------------------------------------------------------------------------
@@ -1858,7 +2091,7 @@ static int SaveWAV(Wave wave, const char *fileName)
         riffHeader.chunkID[1] = 'I';
         riffHeader.chunkID[2] = 'F';
         riffHeader.chunkID[3] = 'F';
-        riffHeader.chunkSize = 44 - 4 + wave.sampleCount*wave.sampleSize/8;
+        riffHeader.chunkSize = 44 - 8 + dataSize;
         riffHeader.format[0] = 'W';
         riffHeader.format[1] = 'A';
         riffHeader.format[2] = 'V';
@@ -1872,8 +2105,8 @@ static int SaveWAV(Wave wave, const char *fileName)
         waveFormat.audioFormat = 1;
         waveFormat.numChannels = wave.channels;
         waveFormat.sampleRate = wave.sampleRate;
-        waveFormat.byteRate = wave.sampleRate*wave.sampleSize/8;
-        waveFormat.blockAlign = wave.sampleSize/8;
+        waveFormat.byteRate = wave.sampleRate*wave.channels*wave.sampleSize/8;
+        waveFormat.blockAlign = wave.channels*wave.sampleSize/8;
         waveFormat.bitsPerSample = wave.sampleSize;
 
         waveData.subChunkID[0] = 'd';
@@ -1913,7 +2146,7 @@ static Wave LoadOGG(const char *fileName)
         wave.sampleRate = info.sample_rate;
         wave.sampleSize = 16;                   // 16 bit per sample (short)
         wave.channels = info.channels;
-        wave.sampleCount = (unsigned int)stb_vorbis_stream_length_in_samples(oggFile)*info.channels;  // Independent by channel
+        wave.sampleCount = (unsigned int)stb_vorbis_stream_length_in_samples(oggFile);  // Frame count, a sample for every channel
 
         float totalSeconds = stb_vorbis_stream_length_in_seconds(oggFile);
         if (totalSeconds > 10) TraceLog(LOG_WARNING, "[%s] Ogg audio length is larger than 10 seconds (%f), that's a big file in memory, consider music streaming", fileName, totalSeconds);
@@ -1972,7 +2205,7 @@ static Wave LoadMP3(const char *fileName)
 
     wave.channels = config.outputChannels;
     wave.sampleRate = config.outputSampleRate;
-    wave.sampleCount = (int)totalFrameCount*wave.channels;
+    wave.sampleCount = (unsigned int)totalFrameCount;
     wave.sampleSize = 32;
 
     // NOTE: Only support up to 2 channels (mono, stereo)
@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -18,74 +18,201 @@ func newGuiTextBoxStateFromPointer(ptr unsafe.Pointer) GuiTextBoxState {
 }
 
 func (w *GuiTextBoxState) cptr() *C.GuiTextBoxState {
+	checkMainThread("GuiEnable")
 	return (*C.GuiTextBoxState)(unsafe.Pointer(w))
 }
 
 //GuiEnable : Enable gui controls (global state)
 func GuiEnable() {
+	checkMainThread("GuiEnable")
 	C.GuiEnable()
 	guiEnabled = true
 }
 
 //GuiDisable : Disable gui controls (global state)
 func GuiDisable() {
+	checkMainThread("GuiDisable")
 	C.GuiDisable()
 	guiEnabled = false
 }
 
 //GuiLock : Lock gui controls (global state)
 func GuiLock() {
+	checkMainThread("GuiLock")
 	C.GuiLock()
 	guiLocked = true
 }
 
 //GuiUnlock : Unlock gui controls (global state)
 func GuiUnlock() {
+	checkMainThread("GuiUnlock")
 	C.GuiUnlock()
 	guiLocked = false
 }
 
 //GuiFade : Set gui controls alpha (global state), alpha goes from 0.0f to 1.0f
 func GuiFade(alpha float32) {
+	checkMainThread("GuiFade")
 	C.GuiFade(C.float(alpha))
 }
 
 //GuiSetState : Set gui state (global state)
 func GuiSetState(state int) {
+	checkMainThread("GuiSetState")
 	C.GuiSetState(C.int(int32(state)))
 }
 
 //GuiGetState : Get gui state (global state)
 func GuiGetState() int {
+	checkMainThread("GuiGetState")
 	res := C.GuiGetState()
 	return int(int32(res))
 }
 
 //GuiSetFont : Set gui custom font (global state)
 func GuiSetFont(font Font) {
+	checkMainThread("GuiSetFont")
 	cfont := *font.cptr()
 	C.GuiSetFont(cfont)
 }
 
 //GuiGetFont : Get gui custom font (global state)
 func GuiGetFont() *Font {
+	checkMainThread("GuiGetFont")
 	res := C.GuiGetFont()
 	return newFontFromPointer(unsafe.Pointer(&res))
 }
 
 //GuiSetStyle : Set one style property
 func GuiSetStyle(control GuiControl, property GuiProperty, value int) {
+	checkMainThread("GuiSetStyle")
 	C.GuiSetStyle(C.int(control), C.int(property), C.int(value))
 }
 
 //GuiGetStyle : Get one style property
 func GuiGetStyle(control GuiControl, property GuiProperty) int {
+	checkMainThread("GuiGetStyle")
 	res := C.GuiGetStyle(C.int(control), C.int(property))
 	return int(res)
 }
 
+//GuiTextBoxSetActive : Sets the active textbox
+func GuiTextBoxSetActive(bounds Rectangle) {
+	checkMainThread("GuiTextBoxSetActive")
+	cbounds := *bounds.cptr()
+	C.GuiTextBoxSetActive(cbounds)
+}
+
+//GuiTextBoxGetActive : Get bounds of active textbox
+func GuiTextBoxGetActive() Rectangle {
+	checkMainThread("GuiTextBoxGetActive")
+	res := C.GuiTextBoxGetActive()
+	return newRectangleFromPointer(unsafe.Pointer(&res))
+}
+
+//GuiTextBoxSetCursor : Set cursor position of active textbox
+func GuiTextBoxSetCursor(cursor int) {
+	checkMainThread("GuiTextBoxSetCursor")
+	C.GuiTextBoxSetCursor(C.int(int32(cursor)))
+}
+
+//GuiTextBoxGetCursor : Get cursor position of active textbox
+func GuiTextBoxGetCursor() int {
+	checkMainThread("GuiTextBoxGetCursor")
+	res := C.GuiTextBoxGetCursor()
+	return int(int32(res))
+}
+
+//GuiTextBoxSetSelection : Set selection of active textbox
+func GuiTextBoxSetSelection(start int, length int) {
+	checkMainThread("GuiTextBoxSetSelection")
+	C.GuiTextBoxSetSelection(C.int(int32(start)), C.int(int32(length)))
+}
+
+//GuiTextBoxGetSelection : Get selection of active textbox (x - selection start  y - selection length)
+func GuiTextBoxGetSelection() Vector2 {
+	checkMainThread("GuiTextBoxGetSelection")
+	res := C.GuiTextBoxGetSelection()
+	return newVector2FromPointer(unsafe.Pointer(&res))
+}
+
+//GuiTextBoxIsActive : Returns true if a textbox control with specified `bounds` is the active textbox
+func GuiTextBoxIsActive(bounds Rectangle) bool {
+	checkMainThread("GuiTextBoxIsActive")
+	cbounds := *bounds.cptr()
+	res := C.GuiTextBoxIsActive(cbounds)
+	return bool(res)
+}
+
+//GuiTextBoxGetState : Get state for the active textbox
+func GuiTextBoxGetState() GuiTextBoxState {
+	checkMainThread("GuiTextBoxGetState")
+	res := C.GuiTextBoxGetState()
+	return newGuiTextBoxStateFromPointer(unsafe.Pointer(&res))
+}
+
+//GuiTextBoxSetState : Set state for the active textbox (state must be valid else things will break)
+func GuiTextBoxSetState(state GuiTextBoxState) {
+	checkMainThread("GuiTextBoxSetState")
+	cstate := *state.cptr()
+	C.GuiTextBoxSetState(cstate)
+}
+
+//GuiTextBoxSelectAll : Select all characters in the active textbox (same as pressing `CTRL` + `A`)
+func GuiTextBoxSelectAll(text string) {
+	checkMainThread("GuiTextBoxSelectAll")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	C.GuiTextBoxSelectAll(ctext)
+}
+
+//GuiTextBoxCopy : Copy selected text to clipboard from the active textbox (same as pressing `CTRL` + `C`)
+func GuiTextBoxCopy(text string) {
+	checkMainThread("GuiTextBoxCopy")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	C.GuiTextBoxCopy(ctext)
+}
+
+//GuiTextBoxPaste : Paste text from clipboard into the textbox (same as pressing `CTRL` + `V`)
+func GuiTextBoxPaste(text string, textSize int) string {
+	checkMainThread("GuiTextBoxPaste")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	C.GuiTextBoxPaste(ctext, C.int(int32(textSize)))
+	return C.GoString(ctext)
+}
+
+//GuiTextBoxCut : Cut selected text in the active textbox and copy it to clipboard (same as pressing `CTRL` + `X`)
+func GuiTextBoxCut(text string) string {
+	checkMainThread("GuiTextBoxCut")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	C.GuiTextBoxCut(ctext)
+	return C.GoString(ctext)
+}
+
+//GuiTextBoxDelete : Deletes a character or selection before from the active textbox (depending on `before`). Returns bytes deleted.
+func GuiTextBoxDelete(text string, length int, before bool) (int, string) {
+	checkMainThread("GuiTextBoxDelete")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.GuiTextBoxDelete(ctext, C.int(int32(length)), C.bool(before))
+	return int(int32(res)), C.GoString(ctext)
+}
+
+//GuiTextBoxGetByteIndex : Get the byte index for a character starting at position `from` with index `start` until position `to`.
+func GuiTextBoxGetByteIndex(text string, start int, from int, to int) int {
+	checkMainThread("GuiTextBoxGetByteIndex")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.GuiTextBoxGetByteIndex(ctext, C.int(int32(start)), C.int(int32(from)), C.int(int32(to)))
+	return int(int32(res))
+}
+
 //GuiWindowBox : Window Box control, shows a window that can be closed
 func GuiWindowBox(bounds Rectangle, title string) bool {
+	checkMainThread("GuiWindowBox")
 	ctitle := C.CString(title)
 	defer C.free(unsafe.Pointer(ctitle))
 	cbounds := *bounds.cptr()
@@ -95,6 +222,7 @@ func GuiWindowBox(bounds Rectangle, title string) bool {
 
 //GuiGroupBox : Group Box control with text name
 func GuiGroupBox(bounds Rectangle, text string) {
+	checkMainThread("GuiGroupBox")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -103,6 +231,7 @@ func GuiGroupBox(bounds Rectangle, text string) {
 
 //GuiLine : Line separator control, could contain text
 func GuiLine(bounds Rectangle, text string) {
+	checkMainThread("GuiLine")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -111,12 +240,14 @@ func GuiLine(bounds Rectangle, text string) {
 
 //GuiPanel : Panel control, useful to group controls
 func GuiPanel(bounds Rectangle) {
+	checkMainThread("GuiPanel")
 	cbounds := *bounds.cptr()
 	C.GuiPanel(cbounds)
 }
 
 //GuiScrollPanel : Scroll Panel control
 func GuiScrollPanel(bounds Rectangle, content Rectangle, scroll Vector2) (Rectangle, Vector2) {
+	checkMainThread("GuiScrollPanel")
 	cscroll := scroll.cptr()
 	ccontent := *content.cptr()
 	cbounds := *bounds.cptr()
@@ -126,6 +257,7 @@ func GuiScrollPanel(bounds Rectangle, content Rectangle, scroll Vector2) (Rectan
 
 //GuiLabel : Label control, shows text
 func GuiLabel(bounds Rectangle, text string) {
+	checkMainThread("GuiLabel")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -134,6 +266,7 @@ func GuiLabel(bounds Rectangle, text string) {
 
 //GuiButton : Button control, returns true when clicked
 func GuiButton(bounds Rectangle, text string) bool {
+	checkMainThread("GuiButton")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -143,6 +276,7 @@ func GuiButton(bounds Rectangle, text string) bool {
 
 //GuiLabelButton : Label button control, show true when clicked
 func GuiLabelButton(bounds Rectangle, text string) bool {
+	checkMainThread("GuiLabelButton")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -152,6 +286,7 @@ func GuiLabelButton(bounds Rectangle, text string) bool {
 
 //GuiImageButton : Image button control, returns true when clicked
 func GuiImageButton(bounds Rectangle, text string, texture Texture2D) bool {
+	checkMainThread("GuiImageButton")
 	ctexture := *texture.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -162,6 +297,7 @@ func GuiImageButton(bounds Rectangle, text string, texture Texture2D) bool {
 
 //GuiImageButtonEx : Image button extended control, returns true when clicked
 func GuiImageButtonEx(bounds Rectangle, text string, texture Texture2D, texSource Rectangle) bool {
+	checkMainThread("GuiImageButtonEx")
 	ctexSource := *texSource.cptr()
 	ctexture := *texture.cptr()
 	ctext := C.CString(text)
@@ -173,6 +309,7 @@ func GuiImageButtonEx(bounds Rectangle, text string, texture Texture2D, texSourc
 
 //GuiToggle : Toggle Button control, returns true when active
 func GuiToggle(bounds Rectangle, text string, active bool) bool {
+	checkMainThread("GuiToggle")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -182,6 +319,7 @@ func GuiToggle(bounds Rectangle, text string, active bool) bool {
 
 //GuiToggleGroup : Toggle Group control, returns active toggle index
 func GuiToggleGroup(bounds Rectangle, text string, active int) int {
+	checkMainThread("GuiToggleGroup")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -191,6 +329,7 @@ func GuiToggleGroup(bounds Rectangle, text string, active int) int {
 
 //GuiCheckBox : Check Box control, returns true when active
 func GuiCheckBox(bounds Rectangle, text string, checked bool) bool {
+	checkMainThread("GuiCheckBox")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -200,6 +339,7 @@ func GuiCheckBox(bounds Rectangle, text string, checked bool) bool {
 
 //GuiComboBox : Combo Box control, returns selected item index
 func GuiComboBox(bounds Rectangle, text string, active int) int {
+	checkMainThread("GuiComboBox")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -209,6 +349,7 @@ func GuiComboBox(bounds Rectangle, text string, active int) int {
 
 //GuiDropdownBox : Dropdown Box control, returns selected item
 func GuiDropdownBox(bounds Rectangle, text string, active int, editMode bool) (bool, int) {
+	checkMainThread("GuiDropdownBox")
 	cactive := C.int(int32(active))
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -219,6 +360,7 @@ func GuiDropdownBox(bounds Rectangle, text string, active int, editMode bool) (b
 
 //GuiSpinner : Spinner control, returns selected value
 func GuiSpinner(bounds Rectangle, text string, value int, minValue int, maxValue int, editMode bool) (bool, int) {
+	checkMainThread("GuiSpinner")
 	cvalue := C.int(int32(value))
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -229,6 +371,7 @@ func GuiSpinner(bounds Rectangle, text string, value int, minValue int, maxValue
 
 //GuiValueBox : Value Box control, updates input text with numbers
 func GuiValueBox(bounds Rectangle, text string, value int, minValue int, maxValue int, editMode bool) (bool, int) {
+	checkMainThread("GuiValueBox")
 	cvalue := C.int(int32(value))
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -239,6 +382,7 @@ func GuiValueBox(bounds Rectangle, text string, value int, minValue int, maxValu
 
 //GuiTextBox : Text Box control, updates input text
 func GuiTextBox(bounds Rectangle, text string, maxCharacters int, editMode bool) (bool, string) {
+	checkMainThread("GuiTextBox")
 
 	//Allocate a new chunk of memory to put the characters in.
 	// Then copy all the characters across. If there is not enough characters, fill with 0.
@@ -264,6 +408,7 @@ func GuiTextBox(bounds Rectangle, text string, maxCharacters int, editMode bool)
 
 //GuiTextBox : Text Box control, updates input text
 func GuiTextBoxMulti(bounds Rectangle, text string, maxCharacters int, editMode bool) (bool, string) {
+	checkMainThread("GuiTextBoxMulti")
 
 	//Allocate a new chunk of memory to put the characters in.
 	// Then copy all the characters across. If there is not enough characters, fill with 0.
@@ -289,6 +434,7 @@ func GuiTextBoxMulti(bounds Rectangle, text string, maxCharacters int, editMode
 
 //GuiSlider : Slider control, returns selected value
 func GuiSlider(bounds Rectangle, textLeft string, textRight string, value float32, minValue float32, maxValue float32) float32 {
+	checkMainThread("GuiSlider")
 	ctextRight := C.CString(textRight)
 	defer C.free(unsafe.Pointer(ctextRight))
 	ctextLeft := C.CString(textLeft)
@@ -300,6 +446,7 @@ func GuiSlider(bounds Rectangle, textLeft string, textRight string, value float3
 
 //GuiSliderBar : Slider Bar control, returns selected value
 func GuiSliderBar(bounds Rectangle, textLeft string, textRight string, value float32, minValue float32, maxValue float32) float32 {
+	checkMainThread("GuiSliderBar")
 	ctextRight := C.CString(textRight)
 	defer C.free(unsafe.Pointer(ctextRight))
 	ctextLeft := C.CString(textLeft)
@@ -311,6 +458,7 @@ func GuiSliderBar(bounds Rectangle, textLeft string, textRight string, value flo
 
 //GuiProgressBar : Progress Bar control, shows current progress value
 func GuiProgressBar(bounds Rectangle, textLeft string, textRight string, value float32, minValue float32, maxValue float32) float32 {
+	checkMainThread("GuiProgressBar")
 	ctextRight := C.CString(textRight)
 	defer C.free(unsafe.Pointer(ctextRight))
 	ctextLeft := C.CString(textLeft)
@@ -322,6 +470,7 @@ func GuiProgressBar(bounds Rectangle, textLeft string, textRight string, value f
 
 //GuiStatusBar : Status Bar control, shows info text
 func GuiStatusBar(bounds Rectangle, text string) {
+	checkMainThread("GuiStatusBar")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -330,6 +479,7 @@ func GuiStatusBar(bounds Rectangle, text string) {
 
 //GuiDummyRec : Dummy control for placeholders
 func GuiDummyRec(bounds Rectangle, text string) {
+	checkMainThread("GuiDummyRec")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbounds := *bounds.cptr()
@@ -338,6 +488,7 @@ func GuiDummyRec(bounds Rectangle, text string) {
 
 //GuiScrollBar : Scroll Bar control
 func GuiScrollBar(bounds Rectangle, value int, minValue int, maxValue int) int {
+	checkMainThread("GuiScrollBar")
 	cbounds := *bounds.cptr()
 	res := C.GuiScrollBar(cbounds, C.int(int32(value)), C.int(int32(minValue)), C.int(int32(maxValue)))
 	return int(int32(res))
@@ -345,6 +496,7 @@ func GuiScrollBar(bounds Rectangle, value int, minValue int, maxValue int) int {
 
 //GuiGrid : Grid control
 func GuiGrid(bounds Rectangle, spacing float32, subdivs int) Vector2 {
+	checkMainThread("GuiGrid")
 	cbounds := *bounds.cptr()
 	res := C.GuiGrid(cbounds, C.float(spacing), C.int(int32(subdivs)))
 	return newVector2FromPointer(unsafe.Pointer(&res))
@@ -352,6 +504,7 @@ func GuiGrid(bounds Rectangle, spacing float32, subdivs int) Vector2 {
 
 //GuiListView : List View control, returns selected list item index
 func GuiListView(bounds Rectangle, text string, scrollIndex int, active int) (int, int) {
+	checkMainThread("GuiListView")
 	cscrollIndex := C.int(int32(scrollIndex))
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -362,6 +515,7 @@ func GuiListView(bounds Rectangle, text string, scrollIndex int, active int) (in
 
 //GuiListViewEx : List View with extended parameters
 func GuiListViewEx(bounds Rectangle, text []string, count int, focus int, scrollIndex int, active int) (int, int, int) {
+	checkMainThread("GuiListViewEx")
 	cscrollIndex := C.int(scrollIndex)
 	cfocus := C.int(focus)
 	cbounds := *bounds.cptr()
@@ -380,6 +534,7 @@ func GuiListViewEx(bounds Rectangle, text []string, count int, focus int, scroll
 
 //GuiMessageBox : Message Box control, displays a message
 func GuiMessageBox(bounds Rectangle, title string, message string, buttons string) int {
+	checkMainThread("GuiMessageBox")
 	cbuttons := C.CString(buttons)
 	defer C.free(unsafe.Pointer(cbuttons))
 	cmessage := C.CString(message)
@@ -393,6 +548,7 @@ func GuiMessageBox(bounds Rectangle, title string, message string, buttons strin
 
 //GuiTextInputBox : Text Input Box control, ask for text
 func GuiTextInputBox(bounds Rectangle, title string, message string, buttons string, text string) (int, string) {
+	checkMainThread("GuiTextInputBox")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	cbuttons := C.CString(buttons)
@@ -408,6 +564,7 @@ func GuiTextInputBox(bounds Rectangle, title string, message string, buttons str
 
 //GuiColorPicker : Color Picker control
 func GuiColorPicker(bounds Rectangle, color Color) Color {
+	checkMainThread("GuiColorPicker")
 	ccolor := *color.cptr()
 	cbounds := *bounds.cptr()
 	res := C.GuiColorPicker(cbounds, ccolor)
@@ -416,6 +573,7 @@ func GuiColorPicker(bounds Rectangle, color Color) Color {
 
 //GuiLoadStyle : Load style file (.rgs)
 func GuiLoadStyle(fileName string) {
+	checkMainThread("GuiLoadStyle")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 	C.GuiLoadStyle(cfileName)
@@ -423,112 +581,15 @@ func GuiLoadStyle(fileName string) {
 
 //GuiLoadStyleDefault : Load style default over global style
 func GuiLoadStyleDefault() {
+	checkMainThread("GuiLoadStyleDefault")
 	C.GuiLoadStyleDefault()
 }
 
 //GuiIconText : Get text with icon id prepended
 func GuiIconText(iconId int, text string) string {
+	checkMainThread("GuiIconText")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	res := C.GuiIconText(C.int(int32(iconId)), ctext)
 	return C.GoString(res)
 }
-
-//GuiTextBoxSetActive : Sets the active textbox
-func GuiTextBoxSetActive(bounds Rectangle) {
-	cbounds := *bounds.cptr()
-	C.GuiTextBoxSetActive(cbounds)
-}
-
-//GuiTextBoxGetActive : Get bounds of active textbox
-func GuiTextBoxGetActive() Rectangle {
-	res := C.GuiTextBoxGetActive()
-	return newRectangleFromPointer(unsafe.Pointer(&res))
-}
-
-//GuiTextBoxSetCursor : Set cursor position of active textbox
-func GuiTextBoxSetCursor(cursor int) {
-	C.GuiTextBoxSetCursor(C.int(int32(cursor)))
-}
-
-//GuiTextBoxGetCursor : Get cursor position of active textbox
-func GuiTextBoxGetCursor() int {
-	res := C.GuiTextBoxGetCursor()
-	return int(int32(res))
-}
-
-//GuiTextBoxSetSelection : Set selection of active textbox
-func GuiTextBoxSetSelection(start int, length int) {
-	C.GuiTextBoxSetSelection(C.int(int32(start)), C.int(int32(length)))
-}
-
-//GuiTextBoxGetSelection : Get selection of active textbox (x - selection start  y - selection length)
-func GuiTextBoxGetSelection() Vector2 {
-	res := C.GuiTextBoxGetSelection()
-	return newVector2FromPointer(unsafe.Pointer(&res))
-}
-
-//GuiTextBoxIsActive : Returns true if a textbox control with specified `bounds` is the active textbox
-func GuiTextBoxIsActive(bounds Rectangle) bool {
-	cbounds := *bounds.cptr()
-	res := C.GuiTextBoxIsActive(cbounds)
-	return bool(res)
-}
-
-//GuiTextBoxGetState : Get state for the active textbox
-func GuiTextBoxGetState() GuiTextBoxState {
-	res := C.GuiTextBoxGetState()
-	return newGuiTextBoxStateFromPointer(unsafe.Pointer(&res))
-}
-
-//GuiTextBoxSetState : Set state for the active textbox (state must be valid else things will break)
-func GuiTextBoxSetState(state GuiTextBoxState) {
-	cstate := *state.cptr()
-	C.GuiTextBoxSetState(cstate)
-}
-
-//GuiTextBoxSelectAll : Select all characters in the active textbox (same as pressing `CTRL` + `A`)
-func GuiTextBoxSelectAll(text string) {
-	ctext := C.CString(text)
-	defer C.free(unsafe.Pointer(ctext))
-	C.GuiTextBoxSelectAll(ctext)
-}
-
-//GuiTextBoxCopy : Copy selected text to clipboard from the active textbox (same as pressing `CTRL` + `C`)
-func GuiTextBoxCopy(text string) {
-	ctext := C.CString(text)
-	defer C.free(unsafe.Pointer(ctext))
-	C.GuiTextBoxCopy(ctext)
-}
-
-//GuiTextBoxPaste : Paste text from clipboard into the textbox (same as pressing `CTRL` + `V`)
-func GuiTextBoxPaste(text string, textSize int) string {
-	ctext := C.CString(text)
-	defer C.free(unsafe.Pointer(ctext))
-	C.GuiTextBoxPaste(ctext, C.int(int32(textSize)))
-	return C.GoString(ctext)
-}
-
-//GuiTextBoxCut : Cut selected text in the active textbox and copy it to clipboard (same as pressing `CTRL` + `X`)
-func GuiTextBoxCut(text string) string {
-	ctext := C.CString(text)
-	defer C.free(unsafe.Pointer(ctext))
-	C.GuiTextBoxCut(ctext)
-	return C.GoString(ctext)
-}
-
-//GuiTextBoxDelete : Deletes a character or selection before from the active textbox (depending on `before`). Returns bytes deleted.
-func GuiTextBoxDelete(text string, length int, before bool) (int, string) {
-	ctext := C.CString(text)
-	defer C.free(unsafe.Pointer(ctext))
-	res := C.GuiTextBoxDelete(ctext, C.int(int32(length)), C.bool(before))
-	return int(int32(res)), C.GoString(ctext)
-}
-
-//GuiTextBoxGetByteIndex : Get the byte index for a character starting at position `from` with index `start` until position `to`.
-func GuiTextBoxGetByteIndex(text string, start int, from int, to int) int {
-	ctext := C.CString(text)
-	defer C.free(unsafe.Pointer(ctext))
-	res := C.GuiTextBoxGetByteIndex(ctext, C.int(int32(start)), C.int(int32(from)), C.int(int32(to)))
-	return int(int32(res))
-}
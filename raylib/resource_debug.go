@@ -0,0 +1,8 @@
+// +build debug
+
+package raylib
+
+func init() {
+	//Debug builds record where every Unloadable is loaded, so leaks can be tracked down
+	resourceDebug = true
+}
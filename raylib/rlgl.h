@@ -1921,7 +1921,7 @@ unsigned int rlLoadTexture(void *data, int width, int height, int format, int mi
         #endif
 
         #if defined(GRAPHICS_API_OPENGL_33)
-            if (format == UNCOMPRESSED_GRAYSCALE)
+            if ((format == UNCOMPRESSED_GRAYSCALE) || (format == UNCOMPRESSED_R32))     // NOTE: R32 is drawn as grayscale, like GetImageData() reads it
             {
                 GLint swizzleMask[] = { GL_RED, GL_RED, GL_RED, GL_ONE };
                 glTexParameteriv(GL_TEXTURE_2D, GL_TEXTURE_SWIZZLE_RGBA, swizzleMask);
@@ -2075,7 +2075,7 @@ unsigned int rlLoadTextureCubemap(void *data, int size, int format)
             else glCompressedTexImage2D(GL_TEXTURE_CUBE_MAP_POSITIVE_X + i, 0, glInternalFormat, size, size, 0, dataSize, (unsigned char *)data + i*dataSize);
 
 #if defined(GRAPHICS_API_OPENGL_33)
-            if (format == UNCOMPRESSED_GRAYSCALE)
+            if ((format == UNCOMPRESSED_GRAYSCALE) || (format == UNCOMPRESSED_R32))     // NOTE: R32 is drawn as grayscale, like GetImageData() reads it
             {
                 GLint swizzleMask[] = { GL_RED, GL_RED, GL_RED, GL_ONE };
                 glTexParameteriv(GL_TEXTURE_CUBE_MAP, GL_TEXTURE_SWIZZLE_RGBA, swizzleMask);
@@ -3067,6 +3067,9 @@ Shader LoadShaderCode(const char *vsCode, const char *fsCode)
 // Unload shader from GPU memory (VRAM)
 void UnloadShader(Shader shader)
 {
+    // NOTE: Make sure shader is not default shader (fallback), it is unloaded by rlglClose()
+    if ((shader.id > 0) && (shader.id == GetShaderDefault().id)) return;
+
     if (shader.id > 0)
     {
         rlDeleteShader(shader.id);
@@ -0,0 +1,256 @@
+//Package rlgl exposes the rlgl matrix stack and immediate-mode vertex API that raylib draws with.
+// The implementation is compiled into the raylib package, so everything submitted here ends up in the same
+// internal batch as the raylib Draw functions. That means it works inside BeginMode3D / BeginTextureMode,
+// and the usual raylib rules apply: only call it from the main thread between BeginDrawing and EndDrawing.
+package rlgl
+
+/*
+Function Bindings for rlgl.
+source: https://github.com/raysan5/raylib/blob/master/src/rlgl.h
+*/
+
+/*
+#cgo CFLAGS: -w -I${SRCDIR}/..
+#define RAYMATH_HEADER_ONLY
+#include "raylib.h"
+#include "rlgl.h"
+
+static void Go_rlMultMatrix(Matrix mat) { rlMultMatrixf(MatrixToFloat(mat)); }
+*/
+import "C"
+import (
+	"unsafe"
+
+	r "github.com/lachee/raylib-goplus/raylib"
+)
+
+//MatrixMode is the matrix that the matrix stack operations are applied to
+type MatrixMode int32
+
+const (
+	//Modelview is the matrix that transforms vertices into view space (default)
+	Modelview MatrixMode = C.RL_MODELVIEW
+	//Projection is the matrix that projects vertices into clip space
+	Projection MatrixMode = C.RL_PROJECTION
+	//Texture is the texture coordinate matrix
+	Texture MatrixMode = C.RL_TEXTURE
+)
+
+//DrawMode is how the vertices between Begin and End are organised into primitives
+type DrawMode int32
+
+const (
+	//Lines draws every pair of vertices as a line
+	Lines DrawMode = C.RL_LINES
+	//Triangles draws every three vertices as a triangle
+	Triangles DrawMode = C.RL_TRIANGLES
+	//Quads draws every four vertices as a quad
+	Quads DrawMode = C.RL_QUADS
+)
+
+func cmatrix(m r.Matrix) C.Matrix    { return *(*C.Matrix)(unsafe.Pointer(&m)) }
+func cvector3(v r.Vector3) C.Vector3 { return *(*C.Vector3)(unsafe.Pointer(&v)) }
+
+//SetMatrixMode chooses the current matrix to be transformed
+func SetMatrixMode(mode MatrixMode) {
+	C.rlMatrixMode(C.int(mode))
+}
+
+//PushMatrix pushes the current matrix to the stack
+func PushMatrix() {
+	C.rlPushMatrix()
+}
+
+//PopMatrix pops the latest inserted matrix from the stack
+func PopMatrix() {
+	C.rlPopMatrix()
+}
+
+//LoadIdentity resets the current matrix to the identity matrix
+func LoadIdentity() {
+	C.rlLoadIdentity()
+}
+
+//Translatef multiplies the current matrix by a translation matrix
+func Translatef(x float32, y float32, z float32) {
+	C.rlTranslatef(C.float(x), C.float(y), C.float(z))
+}
+
+//Translate multiplies the current matrix by a translation matrix
+func Translate(v r.Vector3) {
+	C.rlTranslatef(C.float(v.X), C.float(v.Y), C.float(v.Z))
+}
+
+//Rotatef multiplies the current matrix by a rotation matrix of angleDeg degrees around the axis x, y, z
+func Rotatef(angleDeg float32, x float32, y float32, z float32) {
+	C.rlRotatef(C.float(angleDeg), C.float(x), C.float(y), C.float(z))
+}
+
+//Rotate multiplies the current matrix by a rotation matrix of angleDeg degrees around the axis
+func Rotate(angleDeg float32, axis r.Vector3) {
+	C.rlRotatef(C.float(angleDeg), C.float(axis.X), C.float(axis.Y), C.float(axis.Z))
+}
+
+//Scalef multiplies the current matrix by a scaling matrix
+func Scalef(x float32, y float32, z float32) {
+	C.rlScalef(C.float(x), C.float(y), C.float(z))
+}
+
+//Scale multiplies the current matrix by a scaling matrix
+func Scale(v r.Vector3) {
+	C.rlScalef(C.float(v.X), C.float(v.Y), C.float(v.Z))
+}
+
+//MultMatrix multiplies the current matrix by another matrix
+func MultMatrix(m r.Matrix) {
+	C.Go_rlMultMatrix(cmatrix(m))
+}
+
+//Frustum multiplies the current matrix by a perspective matrix
+func Frustum(left float64, right float64, bottom float64, top float64, znear float64, zfar float64) {
+	C.rlFrustum(C.double(left), C.double(right), C.double(bottom), C.double(top), C.double(znear), C.double(zfar))
+}
+
+//Ortho multiplies the current matrix by an orthographic matrix
+func Ortho(left float64, right float64, bottom float64, top float64, znear float64, zfar float64) {
+	C.rlOrtho(C.double(left), C.double(right), C.double(bottom), C.double(top), C.double(znear), C.double(zfar))
+}
+
+//Viewport sets the viewport area
+func Viewport(x int, y int, width int, height int) {
+	C.rlViewport(C.int(x), C.int(y), C.int(width), C.int(height))
+}
+
+//Begin initializes the drawing mode. Every vertex until End is organised by the mode.
+// Call CheckBufferLimit first when submitting a lot of vertices.
+func Begin(mode DrawMode) {
+	C.rlBegin(C.int(mode))
+}
+
+//End finishes providing vertices
+func End() {
+	C.rlEnd()
+}
+
+//Vertex2i defines one vertex (position)
+func Vertex2i(x int, y int) {
+	C.rlVertex2i(C.int(x), C.int(y))
+}
+
+//Vertex2f defines one vertex (position)
+func Vertex2f(x float32, y float32) {
+	C.rlVertex2f(C.float(x), C.float(y))
+}
+
+//Vertex3f defines one vertex (position)
+func Vertex3f(x float32, y float32, z float32) {
+	C.rlVertex3f(C.float(x), C.float(y), C.float(z))
+}
+
+//Vertex2 defines one vertex (position)
+func Vertex2(v r.Vector2) {
+	C.rlVertex2f(C.float(v.X), C.float(v.Y))
+}
+
+//Vertex3 defines one vertex (position)
+func Vertex3(v r.Vector3) {
+	C.rlVertex3f(C.float(v.X), C.float(v.Y), C.float(v.Z))
+}
+
+//TexCoord2f defines the texture coordinate of the next vertex
+func TexCoord2f(x float32, y float32) {
+	C.rlTexCoord2f(C.float(x), C.float(y))
+}
+
+//TexCoord defines the texture coordinate of the next vertex
+func TexCoord(v r.Vector2) {
+	C.rlTexCoord2f(C.float(v.X), C.float(v.Y))
+}
+
+//Normal3f defines the normal of the next vertex
+func Normal3f(x float32, y float32, z float32) {
+	C.rlNormal3f(C.float(x), C.float(y), C.float(z))
+}
+
+//Normal defines the normal of the next vertex
+func Normal(v r.Vector3) {
+	C.rlNormal3f(C.float(v.X), C.float(v.Y), C.float(v.Z))
+}
+
+//Color4ub defines the color of the next vertex
+func Color4ub(red uint8, green uint8, blue uint8, alpha uint8) {
+	C.rlColor4ub(C.byte(red), C.byte(green), C.byte(blue), C.byte(alpha))
+}
+
+//Color3f defines the color of the next vertex, with each component in the range 0 to 1
+func Color3f(red float32, green float32, blue float32) {
+	C.rlColor3f(C.float(red), C.float(green), C.float(blue))
+}
+
+//Color4f defines the color of the next vertex, with each component in the range 0 to 1
+func Color4f(red float32, green float32, blue float32, alpha float32) {
+	C.rlColor4f(C.float(red), C.float(green), C.float(blue), C.float(alpha))
+}
+
+//SetColor defines the color of the next vertex
+func SetColor(color r.Color) {
+	C.rlColor4ub(C.byte(color.R), C.byte(color.G), C.byte(color.B), C.byte(color.A))
+}
+
+//EnableTexture binds the texture used by the next vertices. Vertices without a texture use GetTextureDefault().
+// Call this before Begin, and DisableTexture after End.
+func EnableTexture(texture r.Texture2D) {
+	C.rlEnableTexture(C.uint(texture.Id))
+}
+
+//DisableTexture stops using the texture set by EnableTexture
+func DisableTexture() {
+	C.rlDisableTexture()
+}
+
+//EnableDepthTest enables the depth test
+func EnableDepthTest() {
+	C.rlEnableDepthTest()
+}
+
+//DisableDepthTest disables the depth test
+func DisableDepthTest() {
+	C.rlDisableDepthTest()
+}
+
+//EnableBackfaceCulling enables backface culling
+func EnableBackfaceCulling() {
+	C.rlEnableBackfaceCulling()
+}
+
+//DisableBackfaceCulling disables backface culling
+func DisableBackfaceCulling() {
+	C.rlDisableBackfaceCulling()
+}
+
+//EnableWireMode draws the following primitives as wireframes
+func EnableWireMode() {
+	C.rlEnableWireMode()
+}
+
+//DisableWireMode stops drawing primitives as wireframes
+func DisableWireMode() {
+	C.rlDisableWireMode()
+}
+
+//Draw flushes the internal batch to the GPU. Required before changing GL state that the batch does not track.
+func Draw() {
+	C.rlglDraw()
+}
+
+//CheckBufferLimit returns true if vertexCount more vertices would overflow the internal batch.
+// When it does, call Draw before Begin.
+func CheckBufferLimit(vertexCount int) bool {
+	return bool(C.rlCheckBufferLimit(C.int(vertexCount)))
+}
+
+//Unproject gets the world coordinates from screen coordinates
+func Unproject(source r.Vector3, proj r.Matrix, view r.Matrix) r.Vector3 {
+	res := C.rlUnproject(cvector3(source), cmatrix(proj), cmatrix(view))
+	return *(*r.Vector3)(unsafe.Pointer(&res))
+}
@@ -0,0 +1,128 @@
+package rnet
+
+import "sync"
+
+//Host is a server that accepts clients into its SocketSet.
+// For TCP every client is its own socket, for UDP the server socket receives from every client.
+type Host struct {
+	//Socket is the listening socket
+	Socket *Socket
+	//Set holds the clients (or the UDP socket) and queues their packets
+	Set *SocketSet
+
+	closeOnce sync.Once
+}
+
+//NewHost starts listening for clients. maxClients is how many TCP clients can be connected at once, 0 uses MaxSetSize.
+func NewHost(config SocketConfig, maxClients int) (*Host, error) {
+	if maxClients <= 0 {
+		maxClients = MaxSetSize
+	}
+
+	config.Server = true
+	sock, err := NewSocket(config)
+	if err != nil {
+		return nil, err
+	}
+
+	host := &Host{Socket: sock, Set: NewSocketSet(maxClients)}
+	if config.Type == SocketUDP {
+		host.Set.maxSockets = 1
+		host.Set.Add(sock)
+	} else {
+		go host.accept()
+	}
+	return host, nil
+}
+
+//accept adds clients to the set until the host is closed
+func (host *Host) accept() {
+	for {
+		client, err := host.Socket.Accept()
+		if err != nil {
+			return
+		}
+
+		//The set is full, so turn them away
+		if host.Set.Add(client) != nil {
+			client.Close()
+		}
+	}
+}
+
+//Poll returns every packet received since the last Poll. See SocketSet.Poll
+func (host *Host) Poll() []Message {
+	return host.Set.Poll()
+}
+
+//Send sends the packet to the client that sent msg
+func (host *Host) Send(msg Message, packet *Packet) error {
+	if msg.Socket.packetConn != nil {
+		return msg.Socket.SendPacketTo(packet, msg.Addr)
+	}
+	return msg.Socket.SendPacket(packet)
+}
+
+//Broadcast sends the packet to every client. Returns the first error, but still sends to the rest.
+func (host *Host) Broadcast(packet *Packet) error {
+	var first error
+	if host.Socket.packetConn != nil {
+		for _, addr := range host.Socket.Peers() {
+			if err := host.Socket.SendPacketTo(packet, addr); err != nil && first == nil {
+				first = err
+			}
+		}
+		return first
+	}
+
+	for _, client := range host.Set.Sockets() {
+		if err := client.SendPacket(packet); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+//Close stops listening and disconnects every client
+func (host *Host) Close() {
+	host.closeOnce.Do(func() {
+		host.Socket.Close()
+		host.Set.Close()
+	})
+}
+
+//Client is a connection to a Host
+type Client struct {
+	//Socket is the connected socket
+	Socket *Socket
+	//Set queues the packets received from the host
+	Set *SocketSet
+}
+
+//NewClient connects to the host
+func NewClient(config SocketConfig) (*Client, error) {
+	config.Server = false
+	sock, err := NewSocket(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{Socket: sock, Set: NewSocketSet(1)}
+	client.Set.Add(sock)
+	return client, nil
+}
+
+//Poll returns every packet received since the last Poll. See SocketSet.Poll
+func (client *Client) Poll() []Message {
+	return client.Set.Poll()
+}
+
+//Send sends the packet to the host
+func (client *Client) Send(packet *Packet) error {
+	return client.Socket.SendPacket(packet)
+}
+
+//Close disconnects from the host
+func (client *Client) Close() {
+	client.Set.Close()
+}
@@ -0,0 +1,166 @@
+package rnet
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+//Packet is a buffer of data in network byte order (big endian).
+// Writes append to the end, reads continue from where the last read finished.
+// A read past the end returns 0 and sets Err, so a whole message can be read before checking it.
+type Packet struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+//NewPacket creates an empty packet with room for size bytes
+func NewPacket(size int) *Packet {
+	return &Packet{data: make([]byte, 0, size)}
+}
+
+//NewPacketFromBytes creates a packet to read the data. The data is not copied.
+func NewPacketFromBytes(data []byte) *Packet {
+	return &Packet{data: data}
+}
+
+//Bytes gets the data of the packet
+func (p *Packet) Bytes() []byte {
+	return p.data
+}
+
+//Len gets the size of the packet in bytes
+func (p *Packet) Len() int {
+	return len(p.data)
+}
+
+//Remaining gets the number of bytes that have not been read yet
+func (p *Packet) Remaining() int {
+	return len(p.data) - p.offset
+}
+
+//Err gets the first read error, which is io.ErrUnexpectedEOF if a read went past the end of the packet
+func (p *Packet) Err() error {
+	return p.err
+}
+
+//Reset empties the packet so it can be written again
+func (p *Packet) Reset() {
+	p.data = p.data[:0]
+	p.offset = 0
+	p.err = nil
+}
+
+//Write8 appends a byte
+func (p *Packet) Write8(value uint8) {
+	p.data = append(p.data, value)
+}
+
+//Write16 appends a 16 bit value
+func (p *Packet) Write16(value uint16) {
+	p.data = append(p.data, 0, 0)
+	binary.BigEndian.PutUint16(p.data[len(p.data)-2:], value)
+}
+
+//Write32 appends a 32 bit value
+func (p *Packet) Write32(value uint32) {
+	p.data = append(p.data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(p.data[len(p.data)-4:], value)
+}
+
+//Write64 appends a 64 bit value
+func (p *Packet) Write64(value uint64) {
+	p.data = append(p.data, 0, 0, 0, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint64(p.data[len(p.data)-8:], value)
+}
+
+//WriteFloat32 appends a float32
+func (p *Packet) WriteFloat32(value float32) {
+	p.Write32(math.Float32bits(value))
+}
+
+//WriteBytes appends the data, prefixed with its length as 16 bits.
+// Returns ErrBytesTooLarge and writes nothing if the data is larger than MaxBytesSize.
+func (p *Packet) WriteBytes(data []byte) error {
+	if len(data) > MaxBytesSize {
+		return ErrBytesTooLarge
+	}
+	p.Write16(uint16(len(data)))
+	p.data = append(p.data, data...)
+	return nil
+}
+
+//WriteString appends the string, prefixed with its length as 16 bits.
+// Returns ErrBytesTooLarge and writes nothing if the string is larger than MaxBytesSize.
+func (p *Packet) WriteString(value string) error {
+	return p.WriteBytes([]byte(value))
+}
+
+//next reads the next n bytes, or returns nil and sets the error if there is not enough left
+func (p *Packet) next(n int) []byte {
+	if p.err != nil {
+		return nil
+	}
+
+	if p.Remaining() < n {
+		p.err = io.ErrUnexpectedEOF
+		p.offset = len(p.data)
+		return nil
+	}
+
+	b := p.data[p.offset : p.offset+n]
+	p.offset += n
+	return b
+}
+
+//Read8 reads a byte
+func (p *Packet) Read8() uint8 {
+	if b := p.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+//Read16 reads a 16 bit value
+func (p *Packet) Read16() uint16 {
+	if b := p.next(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+//Read32 reads a 32 bit value
+func (p *Packet) Read32() uint32 {
+	if b := p.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+//Read64 reads a 64 bit value
+func (p *Packet) Read64() uint64 {
+	if b := p.next(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
+
+//ReadFloat32 reads a float32
+func (p *Packet) ReadFloat32() float32 {
+	return math.Float32frombits(p.Read32())
+}
+
+//ReadBytes reads data written with WriteBytes. The data is not copied.
+func (p *Packet) ReadBytes() []byte {
+	n := int(p.Read16())
+	if b := p.next(n); b != nil {
+		return b
+	}
+	return nil
+}
+
+//ReadString reads a string written with WriteString
+func (p *Packet) ReadString() string {
+	return string(p.ReadBytes())
+}
@@ -0,0 +1,266 @@
+//Package rnet is a Go version of the rnet networking module that is vendored with raylib (rnet.h).
+// It has the same concepts (sockets, socket sets, packets, hosts and clients) but is built on the net package,
+// so sockets can be read from goroutines. Received packets are handed to the render thread through a SocketSet.
+package rnet
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"sync"
+)
+
+const (
+	//MaxSetSize is the default maximum sockets in a set (SOCKET_MAX_SET_SIZE)
+	MaxSetSize = 32
+	//MaxPacketSize is the largest packet that can be sent or received
+	MaxPacketSize = 1 << 16
+	//MaxQueueSize is the amount of messages a set will hold before the readers wait for the render thread
+	MaxQueueSize = 256
+	//MaxBytesSize is the largest data WriteBytes and WriteString can write, as its length is 16 bits
+	MaxBytesSize = math.MaxUint16
+)
+
+var (
+	//ErrPacketTooLarge is returned when sending or receiving a packet larger than MaxPacketSize
+	ErrPacketTooLarge = errors.New("rnet: packet too large")
+	//ErrBytesTooLarge is returned when writing bytes or a string larger than MaxBytesSize to a packet
+	ErrBytesTooLarge = errors.New("rnet: bytes too large for a packet")
+	//ErrNotConnected is returned when sending on a socket that has no remote address
+	ErrNotConnected = errors.New("rnet: socket is not connected")
+	//ErrSetFull is returned when adding a socket to a full set
+	ErrSetFull = errors.New("rnet: socket set is full")
+	//ErrServerSocket is returned when adding a TCP server socket to a set. Use a Host instead.
+	ErrServerSocket = errors.New("rnet: cannot read from a TCP server socket")
+)
+
+//SocketType is the type of socket
+type SocketType int32
+
+const (
+	//SocketTCP is a stream socket. Packets are sent with their length in front of them.
+	SocketTCP SocketType = iota
+	//SocketUDP is a datagram socket. Each packet is a single datagram.
+	SocketUDP
+)
+
+func (t SocketType) network() string {
+	if t == SocketUDP {
+		return "udp"
+	}
+	return "tcp"
+}
+
+//SocketConfig describes the socket to create
+type SocketConfig struct {
+	//Host is the address to connect to, or to listen on if it is a server. Empty listens on every address.
+	Host string
+	//Port is the port or service, ie: "25565" or "http"
+	Port string
+	//Server listens for incoming clients instead of connecting
+	Server bool
+	//Type is the type of socket, TCP or UDP
+	Type SocketType
+}
+
+//Socket is a TCP or UDP socket. Sending is safe from multiple goroutines, receiving should be done from one at a time.
+type Socket struct {
+	//Type is the type of the socket
+	Type SocketType
+	//IsServer is true if the socket is listening for clients
+	IsServer bool
+
+	conn       net.Conn
+	listener   net.Listener
+	packetConn net.PacketConn
+
+	writeMutex sync.Mutex
+	peerMutex  sync.Mutex
+	peers      map[string]net.Addr
+}
+
+//NewSocket creates a socket from the config. Servers start listening, clients connect.
+func NewSocket(config SocketConfig) (*Socket, error) {
+	address := net.JoinHostPort(config.Host, config.Port)
+	sock := &Socket{Type: config.Type, IsServer: config.Server}
+
+	var err error
+	switch {
+	case config.Server && config.Type == SocketUDP:
+		sock.packetConn, err = net.ListenPacket("udp", address)
+		sock.peers = make(map[string]net.Addr)
+	case config.Server:
+		sock.listener, err = net.Listen("tcp", address)
+	default:
+		sock.conn, err = net.Dial(config.Type.network(), address)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return sock, nil
+}
+
+//Accept waits for the next client of a TCP server socket
+func (sock *Socket) Accept() (*Socket, error) {
+	if sock.listener == nil {
+		return nil, ErrNotConnected
+	}
+
+	conn, err := sock.listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &Socket{Type: SocketTCP, conn: conn}, nil
+}
+
+//LocalAddr gets the address the socket is bound to
+func (sock *Socket) LocalAddr() net.Addr {
+	switch {
+	case sock.listener != nil:
+		return sock.listener.Addr()
+	case sock.packetConn != nil:
+		return sock.packetConn.LocalAddr()
+	default:
+		return sock.conn.LocalAddr()
+	}
+}
+
+//RemoteAddr gets the address the socket is connected to. Servers are not connected and return nil.
+func (sock *Socket) RemoteAddr() net.Addr {
+	if sock.conn == nil {
+		return nil
+	}
+	return sock.conn.RemoteAddr()
+}
+
+//Peers gets the addresses that have sent packets to a UDP server socket
+func (sock *Socket) Peers() []net.Addr {
+	sock.peerMutex.Lock()
+	defer sock.peerMutex.Unlock()
+	peers := make([]net.Addr, 0, len(sock.peers))
+	for _, addr := range sock.peers {
+		peers = append(peers, addr)
+	}
+	return peers
+}
+
+//Send writes the raw data to a connected socket
+func (sock *Socket) Send(data []byte) (int, error) {
+	if sock.conn == nil {
+		return 0, ErrNotConnected
+	}
+
+	sock.writeMutex.Lock()
+	defer sock.writeMutex.Unlock()
+	return sock.conn.Write(data)
+}
+
+//Receive reads raw data from a connected socket
+func (sock *Socket) Receive(data []byte) (int, error) {
+	if sock.conn == nil {
+		return 0, ErrNotConnected
+	}
+	return sock.conn.Read(data)
+}
+
+//SendPacket sends the packet to a connected socket. TCP packets are prefixed with their length so they can be received whole.
+func (sock *Socket) SendPacket(packet *Packet) error {
+	if sock.conn == nil {
+		return ErrNotConnected
+	}
+
+	data := packet.Bytes()
+	if len(data) > MaxPacketSize {
+		return ErrPacketTooLarge
+	}
+
+	sock.writeMutex.Lock()
+	defer sock.writeMutex.Unlock()
+
+	if sock.Type == SocketTCP {
+		frame := make([]byte, 4+len(data))
+		binary.BigEndian.PutUint32(frame, uint32(len(data)))
+		copy(frame[4:], data)
+		data = frame
+	}
+
+	_, err := sock.conn.Write(data)
+	return err
+}
+
+//SendPacketTo sends the packet from a UDP server socket to the address
+func (sock *Socket) SendPacketTo(packet *Packet, addr net.Addr) error {
+	if sock.packetConn == nil {
+		return sock.SendPacket(packet)
+	}
+
+	data := packet.Bytes()
+	if len(data) > MaxPacketSize {
+		return ErrPacketTooLarge
+	}
+
+	sock.writeMutex.Lock()
+	defer sock.writeMutex.Unlock()
+	_, err := sock.packetConn.WriteTo(data, addr)
+	return err
+}
+
+//ReceivePacket waits for the next packet and the address it came from
+func (sock *Socket) ReceivePacket() (*Packet, net.Addr, error) {
+	switch {
+	case sock.packetConn != nil:
+		buffer := make([]byte, MaxPacketSize)
+		n, addr, err := sock.packetConn.ReadFrom(buffer)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sock.peerMutex.Lock()
+		sock.peers[addr.String()] = addr
+		sock.peerMutex.Unlock()
+		return NewPacketFromBytes(buffer[:n]), addr, nil
+
+	case sock.conn == nil:
+		return nil, nil, ErrServerSocket
+
+	case sock.Type == SocketUDP:
+		buffer := make([]byte, MaxPacketSize)
+		n, err := sock.conn.Read(buffer)
+		if err != nil {
+			return nil, nil, err
+		}
+		return NewPacketFromBytes(buffer[:n]), sock.conn.RemoteAddr(), nil
+
+	default:
+		var header [4]byte
+		if _, err := io.ReadFull(sock.conn, header[:]); err != nil {
+			return nil, nil, err
+		}
+
+		size := binary.BigEndian.Uint32(header[:])
+		if size > MaxPacketSize {
+			return nil, nil, ErrPacketTooLarge
+		}
+
+		data := make([]byte, size)
+		if _, err := io.ReadFull(sock.conn, data); err != nil {
+			return nil, nil, err
+		}
+		return NewPacketFromBytes(data), sock.conn.RemoteAddr(), nil
+	}
+}
+
+//Close closes the socket
+func (sock *Socket) Close() error {
+	switch {
+	case sock.listener != nil:
+		return sock.listener.Close()
+	case sock.packetConn != nil:
+		return sock.packetConn.Close()
+	default:
+		return sock.conn.Close()
+	}
+}
@@ -0,0 +1,242 @@
+package rnet
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+//testConfig is a loopback config on a free port
+func testConfig(socketType SocketType) SocketConfig {
+	return SocketConfig{Host: "127.0.0.1", Port: "0", Type: socketType}
+}
+
+//testPort gets the port a server socket is listening on
+func testPort(t *testing.T, sock *Socket) string {
+	_, port, err := net.SplitHostPort(sock.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return port
+}
+
+//testPoll waits for the next message of a set
+func testPoll(t *testing.T, set *SocketSet) Message {
+	if set.Check(5*time.Second) == 0 {
+		t.Fatal("no message was received")
+	}
+	messages := set.Poll()
+	if len(messages) == 0 {
+		t.Fatal("Check was ready, but Poll had no messages")
+	}
+	return messages[0]
+}
+
+func TestPacketRoundTrip(t *testing.T) {
+	packet := NewPacket(64)
+	packet.Write8(0xab)
+	packet.Write16(0xcdef)
+	packet.Write32(0x01234567)
+	packet.Write64(0x89abcdef01234567)
+	packet.WriteFloat32(-1.5)
+	if err := packet.WriteBytes([]byte{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := packet.WriteString("héllo"); err != nil {
+		t.Fatal(err)
+	}
+
+	read := NewPacketFromBytes(packet.Bytes())
+	if v := read.Read8(); v != 0xab {
+		t.Errorf("Read8 is %x", v)
+	}
+	if v := read.Read16(); v != 0xcdef {
+		t.Errorf("Read16 is %x", v)
+	}
+	if v := read.Read32(); v != 0x01234567 {
+		t.Errorf("Read32 is %x", v)
+	}
+	if v := read.Read64(); v != 0x89abcdef01234567 {
+		t.Errorf("Read64 is %x", v)
+	}
+	if v := read.ReadFloat32(); v != -1.5 {
+		t.Errorf("ReadFloat32 is %v", v)
+	}
+	if v := read.ReadBytes(); !bytes.Equal(v, []byte{1, 2, 3}) {
+		t.Errorf("ReadBytes is %v", v)
+	}
+	if v := read.ReadString(); v != "héllo" {
+		t.Errorf("ReadString is %q", v)
+	}
+	if read.Remaining() != 0 || read.Err() != nil {
+		t.Fatalf("%d bytes remaining, error %v", read.Remaining(), read.Err())
+	}
+
+	//reading past the end gives zeros and keeps the error
+	if v := read.Read32(); v != 0 || read.Err() != io.ErrUnexpectedEOF {
+		t.Fatalf("read past the end gives %d and %v", v, read.Err())
+	}
+	if v := read.Read8(); v != 0 || read.Err() != io.ErrUnexpectedEOF {
+		t.Fatalf("read after the error gives %d and %v", v, read.Err())
+	}
+
+	packet.Reset()
+	if packet.Len() != 0 || packet.Err() != nil {
+		t.Fatal("reset packet is not empty")
+	}
+}
+
+func TestPacketWriteBytesTooLarge(t *testing.T) {
+	packet := NewPacket(0)
+	if err := packet.WriteBytes(make([]byte, MaxBytesSize)); err != nil {
+		t.Fatalf("writing %d bytes: %v", MaxBytesSize, err)
+	}
+	if read := NewPacketFromBytes(packet.Bytes()); len(read.ReadBytes()) != MaxBytesSize || read.Err() != nil {
+		t.Fatalf("reading %d bytes: %v", MaxBytesSize, read.Err())
+	}
+
+	packet.Reset()
+	if err := packet.WriteBytes(make([]byte, MaxBytesSize+1)); err != ErrBytesTooLarge {
+		t.Fatalf("writing %d bytes gives %v", MaxBytesSize+1, err)
+	}
+	if err := packet.WriteString(strings.Repeat("x", 70000)); err != ErrBytesTooLarge {
+		t.Fatalf("writing a 70000 byte string gives %v", err)
+	}
+	if packet.Len() != 0 {
+		t.Fatalf("too large data wrote %d bytes", packet.Len())
+	}
+}
+
+func TestSocketLoopback(t *testing.T) {
+	config := testConfig(SocketTCP)
+	config.Server = true
+	server, err := NewSocket(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	client, err := NewSocket(SocketConfig{Host: "127.0.0.1", Port: testPort(t, server), Type: SocketTCP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	accepted, err := server.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer accepted.Close()
+
+	//TCP packets arrive whole, one at a time, even when sent back to back
+	for i := uint32(0); i < 3; i++ {
+		packet := NewPacket(8)
+		packet.Write32(i)
+		packet.WriteString(strings.Repeat("a", int(i)*1000))
+		if err := client.SendPacket(packet); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := uint32(0); i < 3; i++ {
+		packet, addr, err := accepted.ReceivePacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addr.String() != client.LocalAddr().String() {
+			t.Errorf("packet came from %v, expected %v", addr, client.LocalAddr())
+		}
+		if v, s := packet.Read32(), packet.ReadString(); v != i || len(s) != int(i)*1000 {
+			t.Errorf("packet %d has %d and a %d byte string", i, v, len(s))
+		}
+	}
+
+	if err := client.SendPacket(NewPacketFromBytes(make([]byte, MaxPacketSize+1))); err != ErrPacketTooLarge {
+		t.Errorf("sending a packet over MaxPacketSize gives %v", err)
+	}
+	if _, _, err := server.ReceivePacket(); err != ErrServerSocket {
+		t.Errorf("receiving on a server socket gives %v", err)
+	}
+}
+
+func TestHostLoopback(t *testing.T) {
+	for _, socketType := range []SocketType{SocketTCP, SocketUDP} {
+		host, err := NewHost(testConfig(socketType), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		client, err := NewClient(SocketConfig{Host: "127.0.0.1", Port: testPort(t, host.Socket), Type: socketType})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		packet := NewPacket(16)
+		packet.WriteString("ping")
+		if err := client.Send(packet); err != nil {
+			t.Fatal(err)
+		}
+		msg := testPoll(t, host.Set)
+		if msg.Err != nil || msg.Packet.ReadString() != "ping" {
+			t.Fatalf("%s host received %v", socketType.network(), msg.Err)
+		}
+
+		//replies go back to the client that sent the message
+		reply := NewPacket(16)
+		reply.WriteString("pong")
+		if err := host.Send(msg, reply); err != nil {
+			t.Fatal(err)
+		}
+		if msg := testPoll(t, client.Set); msg.Err != nil || msg.Packet.ReadString() != "pong" {
+			t.Fatalf("%s client received %v", socketType.network(), msg.Err)
+		}
+
+		broadcast := NewPacket(16)
+		broadcast.WriteString("everyone")
+		if err := host.Broadcast(broadcast); err != nil {
+			t.Fatal(err)
+		}
+		if msg := testPoll(t, client.Set); msg.Err != nil || msg.Packet.ReadString() != "everyone" {
+			t.Fatalf("%s client received %v from a broadcast", socketType.network(), msg.Err)
+		}
+
+		client.Close()
+		host.Close()
+	}
+}
+
+func TestHostDisconnect(t *testing.T) {
+	host, err := NewHost(testConfig(SocketTCP), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer host.Close()
+	config := SocketConfig{Host: "127.0.0.1", Port: testPort(t, host.Socket), Type: SocketTCP}
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Send(NewPacket(0))
+	testPoll(t, host.Set)
+
+	//the set is full, so the second client is turned away
+	full, err := NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer full.Close()
+	if msg := testPoll(t, full.Set); msg.Err == nil {
+		t.Fatal("a client of a full host was not disconnected")
+	}
+
+	//a client leaving is a message with an error, and is removed from the set
+	client.Close()
+	if msg := testPoll(t, host.Set); msg.Err == nil {
+		t.Fatal("the host did not see the client leave")
+	}
+	if host.Set.Len() != 0 {
+		t.Fatalf("the host still has %d clients", host.Set.Len())
+	}
+}
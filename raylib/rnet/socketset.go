@@ -0,0 +1,191 @@
+package rnet
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+//Message is a packet received by a SocketSet, or the error that stopped a socket
+type Message struct {
+	//Socket is the socket the packet came from
+	Socket *Socket
+	//Addr is the address that sent the packet
+	Addr net.Addr
+	//Packet is the packet that was received. Nil if there was an error.
+	Packet *Packet
+	//Err is set when the socket stopped receiving (ie: disconnected). The socket has been removed from the set.
+	Err error
+}
+
+//SocketSet reads packets from its sockets on goroutines and queues them for the render thread.
+// Every method is safe to call from any goroutine.
+type SocketSet struct {
+	maxSockets int
+	messages   chan Message
+	done       chan struct{}
+
+	mutex   sync.Mutex
+	sockets map[*Socket]chan struct{}
+	pending []Message
+	closed  bool
+}
+
+//NewSocketSet creates a set that can hold up to maxSockets
+func NewSocketSet(maxSockets int) *SocketSet {
+	return &SocketSet{
+		maxSockets: maxSockets,
+		messages:   make(chan Message, MaxQueueSize),
+		done:       make(chan struct{}),
+		sockets:    make(map[*Socket]chan struct{}),
+	}
+}
+
+//Add starts reading packets from the socket
+func (set *SocketSet) Add(sock *Socket) error {
+	if sock.listener != nil {
+		return ErrServerSocket
+	}
+
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	if set.closed || len(set.sockets) >= set.maxSockets {
+		return ErrSetFull
+	}
+
+	if _, ok := set.sockets[sock]; !ok {
+		stop := make(chan struct{})
+		set.sockets[sock] = stop
+		go set.read(sock, stop)
+	}
+	return nil
+}
+
+//Remove stops reading from the socket and closes it
+func (set *SocketSet) Remove(sock *Socket) {
+	if set.remove(sock) {
+		sock.Close()
+	}
+}
+
+func (set *SocketSet) remove(sock *Socket) bool {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	stop, ok := set.sockets[sock]
+	if ok {
+		close(stop)
+		delete(set.sockets, sock)
+	}
+	return ok
+}
+
+//Sockets gets the sockets in the set
+func (set *SocketSet) Sockets() []*Socket {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	sockets := make([]*Socket, 0, len(set.sockets))
+	for sock := range set.sockets {
+		sockets = append(sockets, sock)
+	}
+	return sockets
+}
+
+//Len gets the number of sockets in the set
+func (set *SocketSet) Len() int {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	return len(set.sockets)
+}
+
+//read is run on a goroutine for each socket until it is removed or fails
+func (set *SocketSet) read(sock *Socket, stop chan struct{}) {
+	for {
+		packet, addr, err := sock.ReceivePacket()
+		msg := Message{Socket: sock, Addr: addr, Packet: packet, Err: err}
+
+		if err != nil {
+			//A removed socket fails to read because it was closed, nobody needs to know about that.
+			if set.remove(sock) {
+				sock.Close()
+				select {
+				case set.messages <- msg:
+				case <-set.done:
+				}
+			}
+			return
+		}
+
+		select {
+		case set.messages <- msg:
+		case <-stop:
+			return
+		case <-set.done:
+			return
+		}
+	}
+}
+
+//Messages gets the channel the packets are queued on. Use this if you would rather receive on a goroutine than Poll.
+func (set *SocketSet) Messages() <-chan Message {
+	return set.messages
+}
+
+//Check waits up to timeout for a message to be queued and returns the number of messages ready for Poll.
+// A timeout of 0 does not wait.
+func (set *SocketSet) Check(timeout time.Duration) int {
+	set.mutex.Lock()
+	ready := len(set.pending) + len(set.messages)
+	set.mutex.Unlock()
+	if ready > 0 || timeout <= 0 {
+		return ready
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case msg := <-set.messages:
+		set.mutex.Lock()
+		set.pending = append(set.pending, msg)
+		ready = len(set.pending) + len(set.messages)
+		set.mutex.Unlock()
+		return ready
+	case <-timer.C:
+		return 0
+	}
+}
+
+//Poll returns every queued message without waiting. Call this once a frame from the render thread.
+func (set *SocketSet) Poll() []Message {
+	set.mutex.Lock()
+	messages := set.pending
+	set.pending = nil
+	set.mutex.Unlock()
+
+	for {
+		select {
+		case msg := <-set.messages:
+			messages = append(messages, msg)
+		default:
+			return messages
+		}
+	}
+}
+
+//Close removes and closes every socket in the set
+func (set *SocketSet) Close() {
+	set.mutex.Lock()
+	if set.closed {
+		set.mutex.Unlock()
+		return
+	}
+	set.closed = true
+	close(set.done)
+	sockets := set.sockets
+	set.sockets = make(map[*Socket]chan struct{})
+	set.mutex.Unlock()
+
+	for sock, stop := range sockets {
+		close(stop)
+		sock.Close()
+	}
+}
@@ -22,6 +22,16 @@ func (s *Shader) cptr() *C.Shader {
 	return (*C.Shader)(unsafe.Pointer(s))
 }
 
+//IsValid returns true if the shader has been compiled. The default shader that raylib falls back to is not considered valid.
+func (s Shader) IsValid() bool {
+	return s.Id > 0 && s.Id != GetShaderDefault().Id
+}
+
+//isSharedDefault returns true if this is the default shader, which LoadShader falls back to
+func (s Shader) isSharedDefault() bool {
+	return s.Id > 0 && s.Id == uint32(C.GetShaderDefault().id)
+}
+
 type ShaderUniformDataType int32
 
 const (
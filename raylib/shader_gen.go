@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -21,6 +21,7 @@ func LoadText(fileName string) string { return "" }
 */
 //LoadShader : Load shader from files and bind default locations
 func LoadShader(vsFileName string, fsFileName string) Shader {
+	checkMainThread("LoadShader")
 	cfsFileName := C.CString(fsFileName)
 	defer C.free(unsafe.Pointer(cfsFileName))
 	cvsFileName := C.CString(vsFileName)
@@ -31,8 +32,23 @@ func LoadShader(vsFileName string, fsFileName string) Shader {
 	return retval
 }
 
+//LoadShaderE : Load shader from files and bind default locations
+//Returns an error instead of an invalid Shader if it cannot be loaded
+func LoadShaderE(vsFileName string, fsFileName string) (Shader, error) {
+	var retval Shader
+	err := loadChecked("LoadShader", "", "Custom shader could not be loaded", func() Unloadable {
+		retval = LoadShader(vsFileName, fsFileName)
+		return retval
+	}, vsFileName, fsFileName)
+	if err != nil {
+		return Shader{}, err
+	}
+	return retval, nil
+}
+
 //LoadShaderCode : Load shader from code strings and bind default locations
 func LoadShaderCode(vsCode string, fsCode string) Shader {
+	checkMainThread("LoadShaderCode")
 	cfsCode := C.CString(fsCode)
 	defer C.free(unsafe.Pointer(cfsCode))
 	cvsCode := C.CString(vsCode)
@@ -45,6 +61,7 @@ func LoadShaderCode(vsCode string, fsCode string) Shader {
 
 //Unload : Unload shader from GPU memory (VRAM)
 func (shader Shader) Unload() {
+	checkMainThread("UnloadShader")
 	cshader := *shader.cptr()
 	C.UnloadShader(cshader)
 	UnregisterUnloadable(shader)
@@ -58,18 +75,21 @@ func UnloadShader(shader Shader) {
 
 //GetShaderDefault : Get default shader
 func GetShaderDefault() Shader {
+	checkMainThread("GetShaderDefault")
 	res := C.GetShaderDefault()
 	return newShaderFromPointer(unsafe.Pointer(&res))
 }
 
 //GetTextureDefault : Get default texture
 func GetTextureDefault() Texture2D {
+	checkMainThread("GetTextureDefault")
 	res := C.GetTextureDefault()
 	return newTexture2DFromPointer(unsafe.Pointer(&res))
 }
 
 //GetLocation : Get shader uniform location
 func (shader Shader) GetLocation(uniformName string) int {
+	checkMainThread("GetShaderLocation")
 	cuniformName := C.CString(uniformName)
 	defer C.free(unsafe.Pointer(cuniformName))
 	cshader := *shader.cptr()
@@ -85,6 +105,7 @@ func GetShaderLocation(shader Shader, uniformName string) int {
 
 //SetValueFloat32 : Set shader uniform value
 func (shader *Shader) SetValueFloat32(uniformLoc int, value []float32, uniformType ShaderUniformDataType) {
+	checkMainThread("SetShaderValue")
 	cshader := *shader.cptr()
 	cvalue := (*C.float)(unsafe.Pointer((*reflect.SliceHeader)(unsafe.Pointer(&value)).Data))
 	clen := C.int(1)
@@ -99,6 +120,7 @@ func SetShaderValueFloat32(shader *Shader, uniformLoc int, value []float32, unif
 
 //SetValueInt32 : Set shader uniform value
 func (shader *Shader) SetValueInt32(uniformLoc int, value []int32, uniformType ShaderUniformDataType) {
+	checkMainThread("SetShaderValue")
 	cshader := *shader.cptr()
 	cvalue := (*C.int)(unsafe.Pointer((*reflect.SliceHeader)(unsafe.Pointer(&value)).Data))
 	clen := C.int(1)
@@ -113,6 +135,7 @@ func SetShaderValueInt32(shader *Shader, uniformLoc int, value []int32, uniformT
 
 //SetValueFloat32V : Sets a vector (array) of uniform values
 func (shader *Shader) SetValueFloat32V(uniformLoc int, values []float32, uniformType ShaderUniformDataType) {
+	checkMainThread("SetShaderValueV")
 	cshader := *shader.cptr()
 	cvalue := (*C.float)(unsafe.Pointer((*reflect.SliceHeader)(unsafe.Pointer(&values)).Data))
 	clen := C.int(int32(len(values)))
@@ -127,6 +150,7 @@ func SetShaderValueFloat32V(shader *Shader, uniformLoc int, values []float32, un
 
 //SetValueInt32V : Sets a integer vector (array) of uniform values
 func (shader *Shader) SetValueInt32V(uniformLoc int, values []int32, uniformType ShaderUniformDataType) {
+	checkMainThread("SetShaderValueV")
 	cshader := *shader.cptr()
 	cvalue := (*C.int)(unsafe.Pointer((*reflect.SliceHeader)(unsafe.Pointer(&values)).Data))
 	clen := C.int(int32(len(values)))
@@ -141,6 +165,7 @@ func SetShaderValueInt32V(shader *Shader, uniformLoc int, values []int32, unifor
 
 //SetValueMatrix : Set shader uniform value (matrix 4x4)
 func (shader Shader) SetValueMatrix(uniformLoc int, mat Matrix) {
+	checkMainThread("SetShaderValueMatrix")
 	cmat := *mat.cptr()
 	cshader := *shader.cptr()
 	C.SetShaderValueMatrix(cshader, C.int(int32(uniformLoc)), cmat)
@@ -154,6 +179,7 @@ func SetShaderValueMatrix(shader Shader, uniformLoc int, mat Matrix) {
 
 //SetValueTexture : Set shader uniform value for texture
 func (shader Shader) SetValueTexture(uniformLoc int, texture Texture2D) {
+	checkMainThread("SetShaderValueTexture")
 	ctexture := *texture.cptr()
 	cshader := *shader.cptr()
 	C.SetShaderValueTexture(cshader, C.int(int32(uniformLoc)), ctexture)
@@ -167,38 +193,44 @@ func SetShaderValueTexture(shader Shader, uniformLoc int, texture Texture2D) {
 
 //SetMatrixProjection : Set a custom projection matrix (replaces internal projection matrix)
 func SetMatrixProjection(proj Matrix) {
+	checkMainThread("SetMatrixProjection")
 	cproj := *proj.cptr()
 	C.SetMatrixProjection(cproj)
 }
 
 //SetMatrixModelview : Set a custom modelview matrix (replaces internal modelview matrix)
 func SetMatrixModelview(view Matrix) {
+	checkMainThread("SetMatrixModelview")
 	cview := *view.cptr()
 	C.SetMatrixModelview(cview)
 }
 
 //GetMatrixModelview : Get internal modelview matrix
 func GetMatrixModelview() Matrix {
+	checkMainThread("GetMatrixModelview")
 	res := C.GetMatrixModelview()
 	return newMatrixFromPointer(unsafe.Pointer(&res))
 }
 
 //GetMatrixProjection : Get internal projection matrix
 func GetMatrixProjection() Matrix {
+	checkMainThread("GetMatrixProjection")
 	res := C.GetMatrixProjection()
 	return newMatrixFromPointer(unsafe.Pointer(&res))
 }
 
-//GenTextureCubemap : Generate cubemap texture from HDR texture
-func GenTextureCubemap(shader Shader, skyHDR Texture2D, size int) Texture2D {
-	cskyHDR := *skyHDR.cptr()
+//GenTextureCubemap : Generate cubemap texture from 2D texture
+func GenTextureCubemap(shader Shader, gmap Texture2D, size int) Texture2D {
+	checkMainThread("GenTextureCubemap")
+	cgmap := *gmap.cptr()
 	cshader := *shader.cptr()
-	res := C.GenTextureCubemap(cshader, cskyHDR, C.int(int32(size)))
+	res := C.GenTextureCubemap(cshader, cgmap, C.int(int32(size)))
 	return newTexture2DFromPointer(unsafe.Pointer(&res))
 }
 
 //GenTextureIrradiance : Generate irradiance texture using cubemap data
 func GenTextureIrradiance(shader Shader, cubemap Texture2D, size int) Texture2D {
+	checkMainThread("GenTextureIrradiance")
 	ccubemap := *cubemap.cptr()
 	cshader := *shader.cptr()
 	res := C.GenTextureIrradiance(cshader, ccubemap, C.int(int32(size)))
@@ -207,6 +239,7 @@ func GenTextureIrradiance(shader Shader, cubemap Texture2D, size int) Texture2D
 
 //GenTexturePrefilter : Generate prefilter texture using cubemap data
 func GenTexturePrefilter(shader Shader, cubemap Texture2D, size int) Texture2D {
+	checkMainThread("GenTexturePrefilter")
 	ccubemap := *cubemap.cptr()
 	cshader := *shader.cptr()
 	res := C.GenTexturePrefilter(cshader, ccubemap, C.int(int32(size)))
@@ -215,6 +248,7 @@ func GenTexturePrefilter(shader Shader, cubemap Texture2D, size int) Texture2D {
 
 //GenTextureBRDF : Generate BRDF texture
 func GenTextureBRDF(shader Shader, size int) Texture2D {
+	checkMainThread("GenTextureBRDF")
 	cshader := *shader.cptr()
 	res := C.GenTextureBRDF(cshader, C.int(int32(size)))
 	return newTexture2DFromPointer(unsafe.Pointer(&res))
@@ -222,21 +256,25 @@ func GenTextureBRDF(shader Shader, size int) Texture2D {
 
 //BeginShaderMode : Begin custom shader drawing
 func BeginShaderMode(shader Shader) {
+	checkMainThread("BeginShaderMode")
 	cshader := *shader.cptr()
 	C.BeginShaderMode(cshader)
 }
 
 //EndShaderMode : End custom shader drawing (use default shader)
 func EndShaderMode() {
+	checkMainThread("EndShaderMode")
 	C.EndShaderMode()
 }
 
 //BeginBlendMode : Begin blending mode (alpha, additive, multiplied)
 func BeginBlendMode(mode BlendMode) {
+	checkMainThread("BeginBlendMode")
 	C.BeginBlendMode(C.int(int32(mode)))
 }
 
 //EndBlendMode : End blending mode (reset to default: alpha blending)
 func EndBlendMode() {
+	checkMainThread("EndBlendMode")
 	C.EndBlendMode()
 }
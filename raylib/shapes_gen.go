@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -14,12 +14,14 @@ import (
 
 //DrawPixel : Draw a pixel
 func DrawPixel(posX int, posY int, color Color) {
+	checkMainThread("DrawPixel")
 	ccolor := *color.cptr()
 	C.DrawPixel(C.int(int32(posX)), C.int(int32(posY)), ccolor)
 }
 
 //DrawPixelV : Draw a pixel (Vector version)
 func DrawPixelV(position Vector2, color Color) {
+	checkMainThread("DrawPixelV")
 	ccolor := *color.cptr()
 	cposition := *position.cptr()
 	C.DrawPixelV(cposition, ccolor)
@@ -27,12 +29,14 @@ func DrawPixelV(position Vector2, color Color) {
 
 //DrawLine : Draw a line
 func DrawLine(startPosX int, startPosY int, endPosX int, endPosY int, color Color) {
+	checkMainThread("DrawLine")
 	ccolor := *color.cptr()
 	C.DrawLine(C.int(int32(startPosX)), C.int(int32(startPosY)), C.int(int32(endPosX)), C.int(int32(endPosY)), ccolor)
 }
 
 //DrawLineV : Draw a line (Vector version)
 func DrawLineV(startPos Vector2, endPos Vector2, color Color) {
+	checkMainThread("DrawLineV")
 	ccolor := *color.cptr()
 	cendPos := *endPos.cptr()
 	cstartPos := *startPos.cptr()
@@ -41,6 +45,7 @@ func DrawLineV(startPos Vector2, endPos Vector2, color Color) {
 
 //DrawLineEx : Draw a line defining thickness
 func DrawLineEx(startPos Vector2, endPos Vector2, thick float32, color Color) {
+	checkMainThread("DrawLineEx")
 	ccolor := *color.cptr()
 	cendPos := *endPos.cptr()
 	cstartPos := *startPos.cptr()
@@ -49,6 +54,7 @@ func DrawLineEx(startPos Vector2, endPos Vector2, thick float32, color Color) {
 
 //DrawLineBezier : Draw a line using cubic-bezier curves in-out
 func DrawLineBezier(startPos Vector2, endPos Vector2, thick float32, color Color) {
+	checkMainThread("DrawLineBezier")
 	ccolor := *color.cptr()
 	cendPos := *endPos.cptr()
 	cstartPos := *startPos.cptr()
@@ -57,6 +63,7 @@ func DrawLineBezier(startPos Vector2, endPos Vector2, thick float32, color Color
 
 //DrawLineStrip : Draw lines sequence
 func DrawLineStrip(points Vector2, numPoints int, color Color) Vector2 {
+	checkMainThread("DrawLineStrip")
 	ccolor := *color.cptr()
 	cpoints := points.cptr()
 	C.DrawLineStrip(cpoints, C.int(int32(numPoints)), ccolor)
@@ -65,12 +72,14 @@ func DrawLineStrip(points Vector2, numPoints int, color Color) Vector2 {
 
 //DrawCircle : Draw a color-filled circle
 func DrawCircle(centerX int, centerY int, radius float32, color Color) {
+	checkMainThread("DrawCircle")
 	ccolor := *color.cptr()
 	C.DrawCircle(C.int(int32(centerX)), C.int(int32(centerY)), C.float(radius), ccolor)
 }
 
 //DrawCircleSector : Draw a piece of a circle
 func DrawCircleSector(center Vector2, radius float32, startAngle int, endAngle int, segments int, color Color) {
+	checkMainThread("DrawCircleSector")
 	ccolor := *color.cptr()
 	ccenter := *center.cptr()
 	C.DrawCircleSector(ccenter, C.float(radius), C.int(int32(startAngle)), C.int(int32(endAngle)), C.int(int32(segments)), ccolor)
@@ -78,6 +87,7 @@ func DrawCircleSector(center Vector2, radius float32, startAngle int, endAngle i
 
 //DrawCircleSectorLines : Draw circle sector outline
 func DrawCircleSectorLines(center Vector2, radius float32, startAngle int, endAngle int, segments int, color Color) {
+	checkMainThread("DrawCircleSectorLines")
 	ccolor := *color.cptr()
 	ccenter := *center.cptr()
 	C.DrawCircleSectorLines(ccenter, C.float(radius), C.int(int32(startAngle)), C.int(int32(endAngle)), C.int(int32(segments)), ccolor)
@@ -85,6 +95,7 @@ func DrawCircleSectorLines(center Vector2, radius float32, startAngle int, endAn
 
 //DrawCircleGradient : Draw a gradient-filled circle
 func DrawCircleGradient(centerX int, centerY int, radius float32, color1 Color, color2 Color) {
+	checkMainThread("DrawCircleGradient")
 	ccolor2 := *color2.cptr()
 	ccolor1 := *color1.cptr()
 	C.DrawCircleGradient(C.int(int32(centerX)), C.int(int32(centerY)), C.float(radius), ccolor1, ccolor2)
@@ -92,6 +103,7 @@ func DrawCircleGradient(centerX int, centerY int, radius float32, color1 Color,
 
 //DrawCircleV : Draw a color-filled circle (Vector version)
 func DrawCircleV(center Vector2, radius float32, color Color) {
+	checkMainThread("DrawCircleV")
 	ccolor := *color.cptr()
 	ccenter := *center.cptr()
 	C.DrawCircleV(ccenter, C.float(radius), ccolor)
@@ -99,12 +111,28 @@ func DrawCircleV(center Vector2, radius float32, color Color) {
 
 //DrawCircleLines : Draw circle outline
 func DrawCircleLines(centerX int, centerY int, radius float32, color Color) {
+	checkMainThread("DrawCircleLines")
 	ccolor := *color.cptr()
 	C.DrawCircleLines(C.int(int32(centerX)), C.int(int32(centerY)), C.float(radius), ccolor)
 }
 
+//DrawEllipse : Draw ellipse
+func DrawEllipse(centerX int, centerY int, radiusH float32, radiusV float32, color Color) {
+	checkMainThread("DrawEllipse")
+	ccolor := *color.cptr()
+	C.DrawEllipse(C.int(int32(centerX)), C.int(int32(centerY)), C.float(radiusH), C.float(radiusV), ccolor)
+}
+
+//DrawEllipseLines : Draw ellipse outline
+func DrawEllipseLines(centerX int, centerY int, radiusH float32, radiusV float32, color Color) {
+	checkMainThread("DrawEllipseLines")
+	ccolor := *color.cptr()
+	C.DrawEllipseLines(C.int(int32(centerX)), C.int(int32(centerY)), C.float(radiusH), C.float(radiusV), ccolor)
+}
+
 //DrawRing : Draw ring
 func DrawRing(center Vector2, innerRadius float32, outerRadius float32, startAngle int, endAngle int, segments int, color Color) {
+	checkMainThread("DrawRing")
 	ccolor := *color.cptr()
 	ccenter := *center.cptr()
 	C.DrawRing(ccenter, C.float(innerRadius), C.float(outerRadius), C.int(int32(startAngle)), C.int(int32(endAngle)), C.int(int32(segments)), ccolor)
@@ -112,6 +140,7 @@ func DrawRing(center Vector2, innerRadius float32, outerRadius float32, startAng
 
 //DrawRingLines : Draw ring outline
 func DrawRingLines(center Vector2, innerRadius float32, outerRadius float32, startAngle int, endAngle int, segments int, color Color) {
+	checkMainThread("DrawRingLines")
 	ccolor := *color.cptr()
 	ccenter := *center.cptr()
 	C.DrawRingLines(ccenter, C.float(innerRadius), C.float(outerRadius), C.int(int32(startAngle)), C.int(int32(endAngle)), C.int(int32(segments)), ccolor)
@@ -119,12 +148,14 @@ func DrawRingLines(center Vector2, innerRadius float32, outerRadius float32, sta
 
 //DrawRectangle : Draw a color-filled rectangle
 func DrawRectangle(posX int, posY int, width int, height int, color Color) {
+	checkMainThread("DrawRectangle")
 	ccolor := *color.cptr()
 	C.DrawRectangle(C.int(int32(posX)), C.int(int32(posY)), C.int(int32(width)), C.int(int32(height)), ccolor)
 }
 
 //DrawRectangleV : Draw a color-filled rectangle (Vector version)
 func DrawRectangleV(position Vector2, size Vector2, color Color) {
+	checkMainThread("DrawRectangleV")
 	ccolor := *color.cptr()
 	csize := *size.cptr()
 	cposition := *position.cptr()
@@ -133,6 +164,7 @@ func DrawRectangleV(position Vector2, size Vector2, color Color) {
 
 //DrawRectangleRec : Draw a color-filled rectangle
 func DrawRectangleRec(rec Rectangle, color Color) {
+	checkMainThread("DrawRectangleRec")
 	ccolor := *color.cptr()
 	crec := *rec.cptr()
 	C.DrawRectangleRec(crec, ccolor)
@@ -140,6 +172,7 @@ func DrawRectangleRec(rec Rectangle, color Color) {
 
 //DrawRectanglePro : Draw a color-filled rectangle with pro parameters
 func DrawRectanglePro(rec Rectangle, origin Vector2, rotation float32, color Color) {
+	checkMainThread("DrawRectanglePro")
 	ccolor := *color.cptr()
 	corigin := *origin.cptr()
 	crec := *rec.cptr()
@@ -148,6 +181,7 @@ func DrawRectanglePro(rec Rectangle, origin Vector2, rotation float32, color Col
 
 //DrawRectangleGradientV : Draw a vertical-gradient-filled rectangle
 func DrawRectangleGradientV(posX int, posY int, width int, height int, color1 Color, color2 Color) {
+	checkMainThread("DrawRectangleGradientV")
 	ccolor2 := *color2.cptr()
 	ccolor1 := *color1.cptr()
 	C.DrawRectangleGradientV(C.int(int32(posX)), C.int(int32(posY)), C.int(int32(width)), C.int(int32(height)), ccolor1, ccolor2)
@@ -155,6 +189,7 @@ func DrawRectangleGradientV(posX int, posY int, width int, height int, color1 Co
 
 //DrawRectangleGradientH : Draw a horizontal-gradient-filled rectangle
 func DrawRectangleGradientH(posX int, posY int, width int, height int, color1 Color, color2 Color) {
+	checkMainThread("DrawRectangleGradientH")
 	ccolor2 := *color2.cptr()
 	ccolor1 := *color1.cptr()
 	C.DrawRectangleGradientH(C.int(int32(posX)), C.int(int32(posY)), C.int(int32(width)), C.int(int32(height)), ccolor1, ccolor2)
@@ -162,6 +197,7 @@ func DrawRectangleGradientH(posX int, posY int, width int, height int, color1 Co
 
 //DrawRectangleGradientEx : Draw a gradient-filled rectangle with custom vertex colors
 func DrawRectangleGradientEx(rec Rectangle, col1 Color, col2 Color, col3 Color, col4 Color) {
+	checkMainThread("DrawRectangleGradientEx")
 	ccol4 := *col4.cptr()
 	ccol3 := *col3.cptr()
 	ccol2 := *col2.cptr()
@@ -172,12 +208,14 @@ func DrawRectangleGradientEx(rec Rectangle, col1 Color, col2 Color, col3 Color,
 
 //DrawRectangleLines : Draw rectangle outline
 func DrawRectangleLines(posX int, posY int, width int, height int, color Color) {
+	checkMainThread("DrawRectangleLines")
 	ccolor := *color.cptr()
 	C.DrawRectangleLines(C.int(int32(posX)), C.int(int32(posY)), C.int(int32(width)), C.int(int32(height)), ccolor)
 }
 
 //DrawRectangleLinesEx : Draw rectangle outline with extended parameters
 func DrawRectangleLinesEx(rec Rectangle, lineThick int, color Color) {
+	checkMainThread("DrawRectangleLinesEx")
 	ccolor := *color.cptr()
 	crec := *rec.cptr()
 	C.DrawRectangleLinesEx(crec, C.int(int32(lineThick)), ccolor)
@@ -185,6 +223,7 @@ func DrawRectangleLinesEx(rec Rectangle, lineThick int, color Color) {
 
 //DrawRectangleRounded : Draw rectangle with rounded edges
 func DrawRectangleRounded(rec Rectangle, roundness float32, segments int, color Color) {
+	checkMainThread("DrawRectangleRounded")
 	ccolor := *color.cptr()
 	crec := *rec.cptr()
 	C.DrawRectangleRounded(crec, C.float(roundness), C.int(int32(segments)), ccolor)
@@ -192,6 +231,7 @@ func DrawRectangleRounded(rec Rectangle, roundness float32, segments int, color
 
 //DrawRectangleRoundedLines : Draw rectangle with rounded edges outline
 func DrawRectangleRoundedLines(rec Rectangle, roundness float32, segments int, lineThick int, color Color) {
+	checkMainThread("DrawRectangleRoundedLines")
 	ccolor := *color.cptr()
 	crec := *rec.cptr()
 	C.DrawRectangleRoundedLines(crec, C.float(roundness), C.int(int32(segments)), C.int(int32(lineThick)), ccolor)
@@ -199,6 +239,7 @@ func DrawRectangleRoundedLines(rec Rectangle, roundness float32, segments int, l
 
 //DrawTriangle : Draw a color-filled triangle (vertex in counter-clockwise order!)
 func DrawTriangle(v1 Vector2, v2 Vector2, v3 Vector2, color Color) {
+	checkMainThread("DrawTriangle")
 	ccolor := *color.cptr()
 	cv3 := *v3.cptr()
 	cv2 := *v2.cptr()
@@ -208,6 +249,7 @@ func DrawTriangle(v1 Vector2, v2 Vector2, v3 Vector2, color Color) {
 
 //DrawTriangleLines : Draw triangle outline (vertex in counter-clockwise order!)
 func DrawTriangleLines(v1 Vector2, v2 Vector2, v3 Vector2, color Color) {
+	checkMainThread("DrawTriangleLines")
 	ccolor := *color.cptr()
 	cv3 := *v3.cptr()
 	cv2 := *v2.cptr()
@@ -217,6 +259,7 @@ func DrawTriangleLines(v1 Vector2, v2 Vector2, v3 Vector2, color Color) {
 
 //DrawTriangleFan : Draw a triangle fan defined by points (first vertex is the center)
 func DrawTriangleFan(points Vector2, numPoints int, color Color) Vector2 {
+	checkMainThread("DrawTriangleFan")
 	ccolor := *color.cptr()
 	cpoints := points.cptr()
 	C.DrawTriangleFan(cpoints, C.int(int32(numPoints)), ccolor)
@@ -225,6 +268,7 @@ func DrawTriangleFan(points Vector2, numPoints int, color Color) Vector2 {
 
 //DrawTriangleStrip : Draw a triangle strip defined by points
 func DrawTriangleStrip(points Vector2, pointsCount int, color Color) Vector2 {
+	checkMainThread("DrawTriangleStrip")
 	ccolor := *color.cptr()
 	cpoints := points.cptr()
 	C.DrawTriangleStrip(cpoints, C.int(int32(pointsCount)), ccolor)
@@ -233,13 +277,23 @@ func DrawTriangleStrip(points Vector2, pointsCount int, color Color) Vector2 {
 
 //DrawPoly : Draw a regular polygon (Vector version)
 func DrawPoly(center Vector2, sides int, radius float32, rotation float32, color Color) {
+	checkMainThread("DrawPoly")
 	ccolor := *color.cptr()
 	ccenter := *center.cptr()
 	C.DrawPoly(ccenter, C.int(int32(sides)), C.float(radius), C.float(rotation), ccolor)
 }
 
+//DrawPolyLines : Draw a polygon outline of n sides
+func DrawPolyLines(center Vector2, sides int, radius float32, rotation float32, color Color) {
+	checkMainThread("DrawPolyLines")
+	ccolor := *color.cptr()
+	ccenter := *center.cptr()
+	C.DrawPolyLines(ccenter, C.int(int32(sides)), C.float(radius), C.float(rotation), ccolor)
+}
+
 //SetShapesTexture : Define default texture used to draw shapes
 func SetShapesTexture(texture Texture2D, source Rectangle) {
+	checkMainThread("SetShapesTexture")
 	csource := *source.cptr()
 	ctexture := *texture.cptr()
 	C.SetShapesTexture(ctexture, csource)
@@ -0,0 +1,183 @@
+package raylib
+
+import "math"
+
+//Spatializable is audio an Emitter can position. Sound, Music, AudioStream and StreamPlayer are Spatializable.
+type Spatializable interface {
+	SetVolume(volume float32)
+	SetPitch(pitch float32)
+	SetPan(pan float32)
+}
+
+//Attenuation is how the volume of an Emitter falls off with its distance from the Listener.
+// Closer than the reference distance the volume is not changed, and further than the max distance it does not fall any more.
+type Attenuation int
+
+//Attenuation models, they are the clamped distance models of OpenAL
+const (
+	//AttenuationNone does not change the volume with distance
+	AttenuationNone Attenuation = iota
+	//AttenuationLinear falls in a straight line, reaching silence at the max distance with a rolloff of 1
+	AttenuationLinear
+	//AttenuationInverse halves the volume at double the reference distance with a rolloff of 1, like sound in the real world
+	AttenuationInverse
+	//AttenuationExponential falls by the distance over the reference distance to the power of the rolloff
+	AttenuationExponential
+)
+
+//SpeedOfSound is the default speed of sound of a Listener, in world units a second (meters in air)
+const SpeedOfSound = 343.3
+
+//Listener is the ears in the world, usually following the Camera. Emitters are heard relative to it.
+type Listener struct {
+	Position Vector3
+	//Forward is the direction the listener is facing
+	Forward Vector3
+	Up      Vector3
+	//Velocity is used for the doppler effect, in world units a second
+	Velocity Vector3
+
+	//DopplerFactor scales the doppler effect. 0 turns it off.
+	DopplerFactor float32
+	//SpeedOfSound is the speed of sound in world units a second
+	SpeedOfSound float32
+
+	moved bool
+}
+
+//NewListener creates a listener at the origin facing -Z, with no doppler effect
+func NewListener() *Listener {
+	return &Listener{
+		Forward:      NewVector3(0, 0, -1),
+		Up:           NewVector3(0, 1, 0),
+		SpeedOfSound: SpeedOfSound,
+	}
+}
+
+//SetCamera moves the listener to the camera and faces it the same way
+func (listener *Listener) SetCamera(camera Camera) {
+	listener.Position = camera.Position
+	listener.Forward = camera.Target.Subtract(camera.Position).Normalize()
+	listener.Up = camera.Up.Normalize()
+}
+
+//FollowCamera moves the listener to the camera and works out its velocity from how far it moved in the dt seconds since the last follow
+func (listener *Listener) FollowCamera(camera Camera, dt float32) {
+	if listener.moved && dt > 0 {
+		listener.Velocity = camera.Position.Subtract(listener.Position).Divide(dt)
+	}
+	listener.SetCamera(camera)
+	listener.moved = true
+}
+
+//Right is the direction to the right of the listener, which is where a pan of 1 is
+func (listener *Listener) Right() Vector3 {
+	return listener.Forward.CrossProduct(listener.Up).Normalize()
+}
+
+//Emitter plays audio from a position in the world. Update sets the volume, pitch and pan of the audio from where it is to the Listener.
+// The audio should not be routed through a Mixer as well, as both set its volume.
+type Emitter struct {
+	Audio    Spatializable
+	Position Vector3
+	//Velocity is used for the doppler effect, in world units a second
+	Velocity Vector3
+
+	//Volume and Pitch are of the audio before it is positioned
+	Volume float32
+	Pitch  float32
+
+	Attenuation Attenuation
+	//ReferenceDistance is the distance the attenuation starts at
+	ReferenceDistance float32
+	//MaxDistance is the distance the attenuation stops at
+	MaxDistance float32
+	//Rolloff is how quickly the volume falls
+	Rolloff float32
+	//Doppler changes the pitch when the emitter and listener move towards or away from each other
+	Doppler bool
+}
+
+//NewEmitter creates an emitter at the position, with inverse attenuation from 1 to 100 units and no doppler effect
+func NewEmitter(audio Spatializable, position Vector3) *Emitter {
+	return &Emitter{
+		Audio:             audio,
+		Position:          position,
+		Volume:            1,
+		Pitch:             1,
+		Attenuation:       AttenuationInverse,
+		ReferenceDistance: 1,
+		MaxDistance:       100,
+		Rolloff:           1,
+	}
+}
+
+//MoveTo moves the emitter and works out its velocity from how far it moved in dt seconds
+func (emitter *Emitter) MoveTo(position Vector3, dt float32) {
+	if dt > 0 {
+		emitter.Velocity = position.Subtract(emitter.Position).Divide(dt)
+	}
+	emitter.Position = position
+}
+
+//Update sets the volume, pitch and pan of the audio for the listener
+func (emitter *Emitter) Update(listener *Listener) {
+	volume, pitch, pan := emitter.Spatialize(listener)
+	emitter.Audio.SetVolume(volume)
+	emitter.Audio.SetPitch(pitch)
+	emitter.Audio.SetPan(pan)
+}
+
+//Spatialize works out the volume, pitch and pan of the audio for the listener, without setting them
+func (emitter *Emitter) Spatialize(listener *Listener) (volume float32, pitch float32, pan float32) {
+	offset := emitter.Position.Subtract(listener.Position)
+	distance := offset.Length()
+
+	volume = emitter.Volume * emitter.Gain(distance)
+	pitch = emitter.Pitch
+
+	pan = 0.5
+	if distance > 0 {
+		pan = 0.5 + 0.5*offset.Divide(distance).DotProduct(listener.Right())
+	}
+
+	if emitter.Doppler && listener.DopplerFactor > 0 && distance > 0 {
+		//OpenAL's doppler, along the line from the emitter to the listener
+		speed := listener.SpeedOfSound / listener.DopplerFactor
+		direction := offset.Divide(-distance)
+		listenerSpeed := Clamp32(direction.DotProduct(listener.Velocity), -math.MaxFloat32, speed)
+		emitterSpeed := Clamp32(direction.DotProduct(emitter.Velocity), -math.MaxFloat32, speed)
+		if denominator := listener.SpeedOfSound - listener.DopplerFactor*emitterSpeed; denominator > 0 {
+			pitch *= (listener.SpeedOfSound - listener.DopplerFactor*listenerSpeed) / denominator
+		}
+	}
+	return volume, pitch, pan
+}
+
+//Gain gets how loud the emitter is at the distance, from 0 to 1
+func (emitter *Emitter) Gain(distance float32) float32 {
+	reference := emitter.ReferenceDistance
+	distance = Clamp32(distance, reference, float32(math.Max(float64(reference), float64(emitter.MaxDistance))))
+
+	var gain float32
+	switch emitter.Attenuation {
+	case AttenuationLinear:
+		if emitter.MaxDistance <= reference {
+			return 1
+		}
+		gain = 1 - emitter.Rolloff*(distance-reference)/(emitter.MaxDistance-reference)
+	case AttenuationInverse:
+		if reference <= 0 {
+			return 1
+		}
+		gain = reference / (reference + emitter.Rolloff*(distance-reference))
+	case AttenuationExponential:
+		if reference <= 0 {
+			return 1
+		}
+		gain = float32(math.Pow(float64(distance/reference), float64(-emitter.Rolloff)))
+	default:
+		return 1
+	}
+	return Clamp32(gain, 0, 1)
+}
@@ -0,0 +1,158 @@
+package raylib
+
+import "testing"
+
+//testSpatializable records what an Emitter sets
+type testSpatializable struct {
+	volume, pitch, pan float32
+}
+
+func (audio *testSpatializable) SetVolume(volume float32) { audio.volume = volume }
+func (audio *testSpatializable) SetPitch(pitch float32)   { audio.pitch = pitch }
+func (audio *testSpatializable) SetPan(pan float32)       { audio.pan = pan }
+
+func TestEmitterGain(t *testing.T) {
+	tests := []struct {
+		attenuation Attenuation
+		rolloff     float32
+		distance    float32
+		gain        float32
+	}{
+		{AttenuationNone, 1, 0, 1},
+		{AttenuationNone, 1, 50, 1},
+
+		//closer than the reference distance is not louder, and further than the max is not quieter
+		{AttenuationLinear, 1, 1, 1},
+		{AttenuationLinear, 1, 2, 1},
+		{AttenuationLinear, 1, 6, 0.5},
+		{AttenuationLinear, 1, 10, 0},
+		{AttenuationLinear, 1, 20, 0},
+		{AttenuationLinear, 0.5, 10, 0.5},
+		{AttenuationLinear, 0.5, 20, 0.5},
+
+		{AttenuationInverse, 1, 1, 1},
+		{AttenuationInverse, 1, 6, 1.0 / 3},
+		{AttenuationInverse, 1, 10, 0.2},
+		{AttenuationInverse, 1, 20, 0.2},
+		{AttenuationInverse, 2, 10, 1.0 / 9},
+
+		{AttenuationExponential, 1, 1, 1},
+		{AttenuationExponential, 1, 4, 0.5},
+		{AttenuationExponential, 1, 10, 0.2},
+		{AttenuationExponential, 1, 20, 0.2},
+		{AttenuationExponential, 2, 10, 0.04},
+	}
+
+	emitter := NewEmitter(&testSpatializable{}, Vector3{})
+	emitter.ReferenceDistance, emitter.MaxDistance = 2, 10
+	for _, test := range tests {
+		emitter.Attenuation, emitter.Rolloff = test.attenuation, test.rolloff
+		if gain := emitter.Gain(test.distance); !testNear(gain, test.gain) {
+			t.Errorf("attenuation %d with a rolloff of %v at %v is %v, not %v", test.attenuation, test.rolloff, test.distance, gain, test.gain)
+		}
+	}
+
+	//distances that cannot attenuate are not attenuated
+	emitter.Attenuation, emitter.MaxDistance = AttenuationLinear, 2
+	if gain := emitter.Gain(5); gain != 1 {
+		t.Errorf("linear with the max distance at the reference distance is %v", gain)
+	}
+	emitter.Attenuation, emitter.ReferenceDistance = AttenuationInverse, 0
+	if gain := emitter.Gain(5); gain != 1 {
+		t.Errorf("inverse with no reference distance is %v", gain)
+	}
+}
+
+func TestEmitterPan(t *testing.T) {
+	listener := NewListener()
+	audio := &testSpatializable{}
+	emitter := NewEmitter(audio, Vector3{})
+	emitter.Attenuation = AttenuationNone
+
+	tests := []struct {
+		position Vector3
+		pan      float32
+	}{
+		{NewVector3(5, 0, 0), 1},
+		{NewVector3(-5, 0, 0), 0},
+		{NewVector3(0, 0, -5), 0.5},
+		{NewVector3(0, 0, 5), 0.5},
+		{NewVector3(0, 5, 0), 0.5},
+		{NewVector3(3, 0, -3), 0.5 + 0.5*0.70710678},
+		{NewVector3(0, 0, 0), 0.5},
+	}
+	for _, test := range tests {
+		emitter.Position = test.position
+		emitter.Update(listener)
+		if !testNear(audio.pan, test.pan) || audio.volume != 1 || audio.pitch != 1 {
+			t.Errorf("at %v the pan is %v, not %v", test.position, audio.pan, test.pan)
+		}
+	}
+
+	//turning the listener to face +X puts +Z on its right
+	listener.SetCamera(Camera{Position: Vector3{}, Target: NewVector3(10, 0, 0), Up: NewVector3(0, 2, 0)})
+	emitter.Position = NewVector3(0, 0, 5)
+	emitter.Update(listener)
+	if !testNear(audio.pan, 1) {
+		t.Errorf("the pan of the turned listener is %v", audio.pan)
+	}
+}
+
+func TestEmitterDoppler(t *testing.T) {
+	const speed = 34.33
+	tests := []struct {
+		name     string
+		emitter  Vector3
+		listener Vector3
+		factor   float32
+		pitch    float32
+		doppler  bool
+		expected float32
+	}{
+		{"an approaching emitter", NewVector3(0, 0, speed), Vector3{}, 1, 1, true, 343.3 / (343.3 - speed)},
+		{"a receding emitter", NewVector3(0, 0, -speed), Vector3{}, 1, 1, true, 343.3 / (343.3 + speed)},
+		{"an approaching listener", Vector3{}, NewVector3(0, 0, -speed), 1, 1, true, 1.1},
+		{"a receding listener", Vector3{}, NewVector3(0, 0, speed), 1, 1, true, 0.9},
+		{"both approaching", NewVector3(0, 0, speed), NewVector3(0, 0, -speed), 1, 1, true, 1.1 * 343.3 / (343.3 - speed)},
+		{"moving across", NewVector3(speed, 0, 0), NewVector3(0, speed, 0), 1, 1, true, 1},
+		{"a doubled factor", NewVector3(0, 0, speed), Vector3{}, 2, 1, true, 343.3 / (343.3 - 2*speed)},
+		{"a higher pitch", NewVector3(0, 0, speed), Vector3{}, 1, 2, true, 2 * 343.3 / (343.3 - speed)},
+		{"no factor", NewVector3(0, 0, speed), Vector3{}, 0, 1, true, 1},
+		{"doppler turned off", NewVector3(0, 0, speed), Vector3{}, 1, 1, false, 1},
+		//faster than sound towards the listener is left alone instead of dividing by zero
+		{"a supersonic emitter", NewVector3(0, 0, 400), Vector3{}, 1, 1, true, 1},
+	}
+
+	audio := &testSpatializable{}
+	for _, test := range tests {
+		listener := NewListener()
+		listener.Velocity, listener.DopplerFactor = test.listener, test.factor
+		emitter := NewEmitter(audio, NewVector3(0, 0, -10))
+		emitter.Velocity, emitter.Pitch, emitter.Doppler = test.emitter, test.pitch, test.doppler
+		emitter.Update(listener)
+		if !testNear(audio.pitch, test.expected) {
+			t.Errorf("%s has a pitch of %v, not %v", test.name, audio.pitch, test.expected)
+		}
+	}
+}
+
+func TestEmitterVelocity(t *testing.T) {
+	emitter := NewEmitter(&testSpatializable{}, NewVector3(0, 0, -10))
+	emitter.MoveTo(NewVector3(0, 0, -9), 0.5)
+	if emitter.Velocity != NewVector3(0, 0, 2) || emitter.Position != NewVector3(0, 0, -9) {
+		t.Fatalf("moving the emitter set the velocity %v", emitter.Velocity)
+	}
+
+	//the first follow only places the listener, as it has not moved from anywhere yet
+	listener := NewListener()
+	camera := Camera{Position: NewVector3(4, 0, 0), Target: NewVector3(4, 0, -1), Up: NewVector3(0, 1, 0)}
+	listener.FollowCamera(camera, 0.5)
+	if listener.Velocity != (Vector3{}) || listener.Position != camera.Position {
+		t.Fatalf("the first follow set the velocity %v", listener.Velocity)
+	}
+	camera.Position = NewVector3(4, 0, -1)
+	listener.FollowCamera(camera, 0.25)
+	if listener.Velocity != NewVector3(0, 0, -4) {
+		t.Fatalf("following the camera set the velocity %v", listener.Velocity)
+	}
+}
@@ -0,0 +1,319 @@
+package raylib
+
+/*
+#include "raylib.h"
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+//ErrInvalidStream is returned when a StreamPlayer cannot be made with the format, or without the audio device
+var ErrInvalidStream = errors.New("invalid audio stream")
+
+//Sample is a PCM sample type an AudioStream can play
+type Sample interface {
+	~uint8 | ~int16 | ~float32
+}
+
+//SampleFormat is the format of the PCM data a StreamPlayer reads. The value is the sample size in bits.
+type SampleFormat uint32
+
+//Sample formats
+const (
+	SampleUint8   SampleFormat = 8
+	SampleInt16   SampleFormat = 16
+	SampleFloat32 SampleFormat = 32
+)
+
+//StreamStats are the statistics of a StreamPlayer
+type StreamStats struct {
+	//Buffers is the number of buffers written to the stream
+	Buffers int
+	//Underruns is the number of times the stream played both of its buffers before the player refilled them
+	Underruns int
+	//SilentFrames is the number of frames of silence written because the source ended part way through a buffer
+	SilentFrames int
+}
+
+//StreamPlayer plays an AudioStream that pulls its PCM data from an io.Reader or a generator function.
+// A goroutine keeps the buffers of the stream filled, so the audio does not depend on the frame rate.
+type StreamPlayer struct {
+	Stream *AudioStream
+
+	fill     func(buffer []byte) (int, error)
+	buffer   []byte
+	frames   int
+	interval time.Duration
+
+	mutex    sync.Mutex
+	stats    StreamStats
+	err      error
+	ended    bool
+	drained  bool
+	finished bool
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+//NewStreamPlayer creates a player that reads interleaved PCM in the format from the source, ie: the data of a .wav file after its header.
+// The player finishes when the source returns io.EOF, any other error is kept in Err.
+// Returns ErrInvalidStream if the sample rate, channels or format is 0 or unsupported, or the audio device is not ready.
+func NewStreamPlayer(sampleRate uint32, channels uint32, format SampleFormat, source io.Reader) (*StreamPlayer, error) {
+	return newStreamPlayer(sampleRate, uint32(format), channels, func(buffer []byte) (int, error) {
+		n, err := io.ReadFull(source, buffer)
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+		return n, err
+	})
+}
+
+//NewStreamPlayerFunc creates a player that calls generate to fill each buffer. The samples are interleaved, so a stereo buffer is left, right, left, right...
+// The stream has the sample size of T. generate is called from the goroutine of the player, not the one that called Play.
+// Returns ErrInvalidStream like NewStreamPlayer.
+func NewStreamPlayerFunc[T Sample](sampleRate uint32, channels uint32, generate func(samples []T)) (*StreamPlayer, error) {
+	var sample T
+	size := int(unsafe.Sizeof(sample))
+	return newStreamPlayer(sampleRate, uint32(size*8), channels, func(buffer []byte) (int, error) {
+		generate(unsafe.Slice((*T)(unsafe.Pointer(&buffer[0])), len(buffer)/size))
+		return len(buffer), nil
+	})
+}
+
+func newStreamPlayer(sampleRate uint32, sampleSize uint32, channels uint32, fill func(buffer []byte) (int, error)) (*StreamPlayer, error) {
+	if sampleRate == 0 || channels == 0 || (sampleSize != 8 && sampleSize != 16 && sampleSize != 32) {
+		return nil, fmt.Errorf("%w: %d channels of %d bit samples at %d Hz", ErrInvalidStream, channels, sampleSize, sampleRate)
+	}
+	if !IsAudioDeviceReady() {
+		return nil, fmt.Errorf("%w: the audio device is not ready", ErrInvalidStream)
+	}
+
+	//The player unloads the stream itself
+	stream := InitAudioStream(sampleRate, sampleSize, channels)
+	UnregisterUnloadable(stream)
+
+	//raudio makes the buffers of the stream as big as the period of the device when it is bigger than AUDIO_BUFFER_SIZE
+	frames := int(stream.GetBufferSize())
+	if frames == 0 {
+		stream.Unload()
+		return nil, fmt.Errorf("%w: the stream has no buffer", ErrInvalidStream)
+	}
+
+	player := &StreamPlayer{
+		Stream:   stream,
+		fill:     fill,
+		buffer:   make([]byte, frames*int(channels)*int(sampleSize/8)),
+		frames:   frames,
+		interval: time.Second * time.Duration(frames) / time.Duration(sampleRate) / 4,
+	}
+	RegisterUnloadable(player)
+	return player, nil
+}
+
+//Play fills the stream and starts playing it. The goroutine keeps filling it until Stop, or until the source ends.
+func (player *StreamPlayer) Play() {
+	player.mutex.Lock()
+	running := player.stop != nil && !player.finished
+	player.mutex.Unlock()
+	if running {
+		return
+	}
+
+	//Clears up after a player that finished by itself
+	player.Stop()
+	player.mutex.Lock()
+	player.ended, player.drained, player.finished = false, false, false
+	player.mutex.Unlock()
+
+	if !player.refill(true) {
+		return
+	}
+	player.Stream.Play()
+
+	player.mutex.Lock()
+	player.stop, player.done = make(chan struct{}), make(chan struct{})
+	go player.run(player.stop, player.done)
+	player.mutex.Unlock()
+}
+
+//Stop stops the goroutine and the stream. Playing again starts from where the source is now.
+func (player *StreamPlayer) Stop() {
+	player.mutex.Lock()
+	stop, done := player.stop, player.done
+	player.stop, player.done = nil, nil
+	player.mutex.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+	player.Stream.Stop()
+}
+
+//Pause pauses the stream. The goroutine keeps running but has nothing to refill.
+func (player *StreamPlayer) Pause() {
+	player.Stream.Pause()
+}
+
+//Resume resumes the paused stream
+func (player *StreamPlayer) Resume() {
+	player.Stream.Resume()
+}
+
+//SetVolume sets the volume of the stream
+func (player *StreamPlayer) SetVolume(volume float32) {
+	player.Stream.SetVolume(volume)
+}
+
+//SetPitch sets the pitch of the stream
+func (player *StreamPlayer) SetPitch(pitch float32) {
+	player.Stream.SetPitch(pitch)
+}
+
+//SetPan sets the pan of the stream, from 0 (left) to 1 (right)
+func (player *StreamPlayer) SetPan(pan float32) {
+	player.Stream.SetPan(pan)
+}
+
+//IsPlaying returns true if the stream is playing
+func (player *StreamPlayer) IsPlaying() bool {
+	return player.Stream.IsPlaying()
+}
+
+//IsFinished returns true once the source has ended and the stream has played all of it
+func (player *StreamPlayer) IsFinished() bool {
+	player.mutex.Lock()
+	defer player.mutex.Unlock()
+	return player.finished
+}
+
+//Stats returns the statistics since the player was created
+func (player *StreamPlayer) Stats() StreamStats {
+	player.mutex.Lock()
+	defer player.mutex.Unlock()
+	return player.stats
+}
+
+//Err returns the error the source failed with, or nil. io.EOF is not an error.
+func (player *StreamPlayer) Err() error {
+	player.mutex.Lock()
+	defer player.mutex.Unlock()
+	return player.err
+}
+
+//Unload stops the player and closes the stream
+func (player *StreamPlayer) Unload() {
+	player.Stop()
+	player.Stream.Unload()
+	UnregisterUnloadable(player)
+}
+
+func (player *StreamPlayer) run(stop chan struct{}, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(player.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if !player.refill(false) {
+				return
+			}
+		}
+	}
+}
+
+//refill writes every sub-buffer the stream has played. Having to write both of them means the stream ran out, unless it is being primed.
+// Once the source has ended, one buffer of silence is written so the last of the data plays, and then the stream is stopped.
+// Returns false when the stream has been stopped.
+func (player *StreamPlayer) refill(priming bool) bool {
+	written := 0
+	for player.Stream.IsProcessed() {
+		player.mutex.Lock()
+		ended, drained := player.ended, player.drained
+		player.mutex.Unlock()
+
+		if ended && drained {
+			player.Stream.Stop()
+			player.mutex.Lock()
+			player.finished = true
+			player.mutex.Unlock()
+			return false
+		}
+
+		n := 0
+		if !ended {
+			var err error
+			n, err = player.fill(player.buffer)
+			if err != nil {
+				player.mutex.Lock()
+				player.ended = true
+				if !errors.Is(err, io.EOF) {
+					player.err = err
+				}
+				if n == 0 {
+					player.drained = true
+				}
+				player.mutex.Unlock()
+			}
+		} else {
+			player.mutex.Lock()
+			player.drained = true
+			player.mutex.Unlock()
+		}
+
+		//Whole frames only, the rest is silence
+		frameSize := len(player.buffer) / player.frames
+		n -= n % frameSize
+		for i := n; i < len(player.buffer); i++ {
+			player.buffer[i] = 0
+		}
+
+		samples := len(player.buffer) / int(player.Stream.SampleSize/8)
+		C.UpdateAudioStream(*player.Stream.cptr(), unsafe.Pointer(&player.buffer[0]), C.int(samples))
+		written++
+
+		player.mutex.Lock()
+		player.stats.Buffers++
+		if !ended && n < len(player.buffer) && n > 0 {
+			player.stats.SilentFrames += (len(player.buffer) - n) / frameSize
+		}
+		player.mutex.Unlock()
+	}
+
+	if written > 1 && !priming {
+		player.mutex.Lock()
+		player.stats.Underruns++
+		player.mutex.Unlock()
+	}
+	return true
+}
+
+//Interleave writes the samples of each channel into dst one frame after another, ie: left, right, left, right... for two channels.
+// Returns the number of frames written, which is the length of the shortest channel or as many as fit in dst.
+func Interleave[T Sample](dst []T, channels ...[]T) int {
+	if len(channels) == 0 {
+		return 0
+	}
+	frames := len(dst) / len(channels)
+	for _, channel := range channels {
+		if len(channel) < frames {
+			frames = len(channel)
+		}
+	}
+	for i := 0; i < frames; i++ {
+		for c, channel := range channels {
+			dst[i*len(channels)+c] = channel[i]
+		}
+	}
+	return frames
+}
@@ -0,0 +1,112 @@
+package raylib
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStreamPlayerInvalid(t *testing.T) {
+	testAudioDevice(t)
+
+	silence := bytes.NewReader(make([]byte, 64))
+	for _, test := range []struct {
+		sampleRate, channels uint32
+		format               SampleFormat
+	}{
+		{0, 2, SampleInt16},
+		{44100, 0, SampleInt16},
+		{44100, 2, 24},
+	} {
+		if player, err := NewStreamPlayer(test.sampleRate, test.channels, test.format, silence); player != nil || !errors.Is(err, ErrInvalidStream) {
+			t.Errorf("%d Hz, %d channels of %d bits gives %v", test.sampleRate, test.channels, test.format, err)
+		}
+	}
+	if player, err := NewStreamPlayerFunc(0, 1, func(samples []float32) {}); player != nil || !errors.Is(err, ErrInvalidStream) {
+		t.Errorf("a generator at 0 Hz gives %v", err)
+	}
+}
+
+func TestStreamPlayerFillsTheWholeBuffer(t *testing.T) {
+	testAudioDevice(t)
+
+	//the buffers are at least AUDIO_BUFFER_SIZE, and bigger when the period of the device is
+	lengths := make(chan int, 16)
+	player, err := NewStreamPlayerFunc(22050, 2, func(samples []int16) {
+		select {
+		case lengths <- len(samples):
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer player.Unload()
+
+	frames := int(player.Stream.GetBufferSize())
+	if frames < 4096 {
+		t.Fatalf("the stream has %d frame buffers", frames)
+	}
+	player.Play()
+	if n := <-lengths; n != frames*2 {
+		t.Fatalf("generated %d samples for %d frame buffers", n, frames)
+	}
+}
+
+func TestStreamPlayerFinishes(t *testing.T) {
+	testAudioDevice(t)
+
+	//half a second of stereo
+	data := make([]byte, 44100*2*2/2)
+	player, err := NewStreamPlayer(44100, 2, SampleInt16, bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer player.Unload()
+
+	player.Play()
+	deadline := time.Now().Add(5 * time.Second)
+	for !player.IsFinished() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !player.IsFinished() || player.Err() != nil {
+		t.Fatalf("finished %v with %v, %+v", player.IsFinished(), player.Err(), player.Stats())
+	}
+}
+
+func TestStreamPlayerAfterReopeningTheDevice(t *testing.T) {
+	//closing the device used to leave freed buffers in the mixer, so the streams of every other device were never played
+	for round := 0; round < 4; round++ {
+		InitAudioDevice()
+		if !IsAudioDeviceReady() {
+			t.Skip("no audio device")
+		}
+		player, err := NewStreamPlayerFunc(44100, 1, func(samples []int16) {})
+		if err != nil {
+			t.Fatal(err)
+		}
+		player.Play()
+		deadline := time.Now().Add(2 * time.Second)
+		for player.Stats().Buffers <= 2 && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		buffers := player.Stats().Buffers
+		player.Unload()
+		CloseAudioDevice()
+
+		if buffers <= 2 {
+			t.Fatalf("round %d: the stream was never refilled", round)
+		}
+		if IsAudioDeviceReady() {
+			t.Fatalf("round %d: the device is still ready once closed", round)
+		}
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	dst := make([]int16, 6)
+	if n := Interleave(dst, []int16{1, 2, 3}, []int16{4, 5, 6, 7}); n != 3 || dst[0] != 1 || dst[1] != 4 || dst[2] != 2 || dst[5] != 6 {
+		t.Fatalf("interleaved %d frames, %v", n, dst)
+	}
+}
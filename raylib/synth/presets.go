@@ -0,0 +1,101 @@
+package synth
+
+import "math/rand"
+
+//Presets are the presets by name, for tools that list them
+var Presets = map[string]func(random *rand.Rand) Params{
+	"pickup":    Pickup,
+	"laser":     Laser,
+	"explosion": Explosion,
+	"hit":       Hit,
+	"jump":      Jump,
+}
+
+//Pickup is a short rising chime, like collecting a coin.
+// The presets vary their parameters with the random source, a nil source gives the middle of each range.
+func Pickup(random *rand.Rand) Params {
+	p := DefaultParams()
+	p.Waveform = choose(random, Square, Sine)
+	p.Frequency = between(random, 800, 1400)
+	p.ArpeggioMultiplier = between(random, 1.3, 2)
+	p.ArpeggioTime = between(random, 0.04, 0.1)
+	p.Envelope = Envelope{Decay: 0.02, Sustain: 0.6, Hold: between(random, 0.05, 0.12), Release: between(random, 0.1, 0.3)}
+	p.Seed = seed(random)
+	return p
+}
+
+//Laser is a fast falling zap
+func Laser(random *rand.Rand) Params {
+	p := DefaultParams()
+	p.Waveform = choose(random, Square, Saw, Sine)
+	p.Frequency = between(random, 500, 1500)
+	p.MinFrequency = between(random, 80, 200)
+	p.Slide = -between(random, 3, 7)
+	p.DeltaSlide = between(random, 0, 4)
+	p.Duty = between(random, 0.3, 0.7)
+	p.DutySweep = between(random, -1, 1)
+	p.Envelope = Envelope{Sustain: 0.8, Hold: between(random, 0.05, 0.15), Release: between(random, 0.1, 0.25)}
+	p.Seed = seed(random)
+	return p
+}
+
+//Explosion is a long rumble of falling noise
+func Explosion(random *rand.Rand) Params {
+	p := DefaultParams()
+	p.Waveform = Noise
+	p.Frequency = between(random, 40, 160)
+	p.Slide = -between(random, 0.3, 1.2)
+	p.VibratoDepth = between(random, 0, 0.3)
+	p.VibratoSpeed = between(random, 5, 15)
+	p.Envelope = Envelope{Sustain: 1, Hold: between(random, 0.1, 0.3), Release: between(random, 0.4, 0.9)}
+	p.Volume = 0.6
+	p.Seed = seed(random)
+	return p
+}
+
+//Hit is a short falling thud, like taking damage
+func Hit(random *rand.Rand) Params {
+	p := DefaultParams()
+	p.Waveform = choose(random, Square, Saw, Noise)
+	p.Frequency = between(random, 200, 600)
+	p.Slide = -between(random, 3, 6)
+	p.Duty = between(random, 0.3, 0.6)
+	p.Envelope = Envelope{Sustain: 0.9, Hold: between(random, 0.01, 0.04), Release: between(random, 0.08, 0.2)}
+	p.Seed = seed(random)
+	return p
+}
+
+//Jump is a rising square wave
+func Jump(random *rand.Rand) Params {
+	p := DefaultParams()
+	p.Waveform = Square
+	p.Frequency = between(random, 250, 450)
+	p.Slide = between(random, 1.5, 3)
+	p.Duty = between(random, 0.3, 0.6)
+	p.Envelope = Envelope{Sustain: 0.7, Hold: between(random, 0.08, 0.15), Release: between(random, 0.1, 0.25)}
+	p.Seed = seed(random)
+	return p
+}
+
+//between picks a value in the range, or the middle of it if there is no random source
+func between(random *rand.Rand, min float64, max float64) float64 {
+	if random == nil {
+		return (min + max) / 2
+	}
+	return min + random.Float64()*(max-min)
+}
+
+//choose picks one of the waveforms, or the first if there is no random source
+func choose(random *rand.Rand, waveforms ...Waveform) Waveform {
+	if random == nil {
+		return waveforms[0]
+	}
+	return waveforms[random.Intn(len(waveforms))]
+}
+
+func seed(random *rand.Rand) int64 {
+	if random == nil {
+		return 0
+	}
+	return random.Int63()
+}
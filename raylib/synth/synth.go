@@ -0,0 +1,290 @@
+//Package synth generates sound effects in the style of sfxr. A Params describes an oscillator with an ADSR envelope,
+// frequency slides, vibrato and an arpeggio, and is rendered into a raylib Wave that can be given to LoadSoundFromWave.
+// Params serialise to JSON, so they can be tweaked outside the game and loaded back in.
+package synth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	r "github.com/lachee/raylib-goplus/raylib"
+)
+
+//DefaultSampleRate is the sample rate of the waves made by Wave
+const DefaultSampleRate = 44100
+
+//noisePeriod is the number of random values in each period of the noise oscillator, like sfxr
+const noisePeriod = 32
+
+//ErrUnknownWaveform is returned when unmarshalling a waveform that does not exist
+var ErrUnknownWaveform = errors.New("synth: unknown waveform")
+
+//ErrInvalidParams is returned by Validate when a parameter is out of its range
+var ErrInvalidParams = errors.New("synth: invalid params")
+
+//Waveform is the shape of the oscillator
+type Waveform int
+
+//Waveforms
+const (
+	Square Waveform = iota
+	Saw
+	Sine
+	Triangle
+	Noise
+)
+
+var waveformNames = []string{"square", "saw", "sine", "triangle", "noise"}
+
+func (w Waveform) String() string {
+	if w < 0 || int(w) >= len(waveformNames) {
+		return "unknown"
+	}
+	return waveformNames[w]
+}
+
+//MarshalText writes the waveform as its name, ie: "square"
+func (w Waveform) MarshalText() ([]byte, error) {
+	if w < 0 || int(w) >= len(waveformNames) {
+		return nil, ErrUnknownWaveform
+	}
+	return []byte(w.String()), nil
+}
+
+//UnmarshalText reads the waveform from its name
+func (w *Waveform) UnmarshalText(text []byte) error {
+	for i, name := range waveformNames {
+		if strings.EqualFold(name, string(text)) {
+			*w = Waveform(i)
+			return nil
+		}
+	}
+	return ErrUnknownWaveform
+}
+
+//Envelope is the ADSR envelope of the volume. The times are in seconds.
+type Envelope struct {
+	//Attack is the time to go from silence to full volume
+	Attack float64 `json:"attack"`
+	//Decay is the time to go from full volume to the sustain level
+	Decay float64 `json:"decay"`
+	//Sustain is the level the volume is held at, from 0 to 1
+	Sustain float64 `json:"sustain"`
+	//Hold is the time the volume is held at the sustain level
+	Hold float64 `json:"hold"`
+	//Release is the time to go from the sustain level to silence
+	Release float64 `json:"release"`
+}
+
+//Duration is the length of the envelope in seconds
+func (e Envelope) Duration() float64 {
+	return e.Attack + e.Decay + e.Hold + e.Release
+}
+
+//Level gets the volume of the envelope at the time
+func (e Envelope) Level(t float64) float64 {
+	switch {
+	case t < e.Attack:
+		return t / e.Attack
+	case t < e.Attack+e.Decay:
+		return 1 - (1-e.Sustain)*(t-e.Attack)/e.Decay
+	case t < e.Attack+e.Decay+e.Hold:
+		return e.Sustain
+	case t < e.Duration():
+		return e.Sustain * (1 - (t-e.Attack-e.Decay-e.Hold)/e.Release)
+	default:
+		return 0
+	}
+}
+
+//Params describe a sound effect
+type Params struct {
+	Waveform Waveform `json:"waveform"`
+	Envelope Envelope `json:"envelope"`
+	//Volume is the volume of the whole sound, from 0 to 1
+	Volume float64 `json:"volume"`
+
+	//Frequency is the starting frequency in Hz
+	Frequency float64 `json:"frequency"`
+	//MinFrequency cuts the sound off when a slide takes the frequency below it. 0 never cuts it off.
+	MinFrequency float64 `json:"minFrequency"`
+	//Slide changes the frequency by this many octaves a second
+	Slide float64 `json:"slide"`
+	//DeltaSlide changes the slide by this many octaves a second, every second
+	DeltaSlide float64 `json:"deltaSlide"`
+
+	//VibratoDepth is how far the vibrato moves the frequency, as a fraction of it
+	VibratoDepth float64 `json:"vibratoDepth"`
+	//VibratoSpeed is the speed of the vibrato in Hz
+	VibratoSpeed float64 `json:"vibratoSpeed"`
+
+	//ArpeggioMultiplier multiplies the frequency once ArpeggioTime has passed
+	ArpeggioMultiplier float64 `json:"arpeggioMultiplier"`
+	//ArpeggioTime is the time in seconds the arpeggio starts. 0 has no arpeggio.
+	ArpeggioTime float64 `json:"arpeggioTime"`
+
+	//Duty is the fraction of the period a square wave is high
+	Duty float64 `json:"duty"`
+	//DutySweep changes the duty by this much a second
+	DutySweep float64 `json:"dutySweep"`
+
+	//Seed is the seed of the noise
+	Seed int64 `json:"seed"`
+}
+
+//DefaultParams is a short square wave beep. Unmarshal into it so the fields missing from the JSON keep a sensible value.
+func DefaultParams() Params {
+	return Params{
+		Waveform:           Square,
+		Envelope:           Envelope{Decay: 0.05, Sustain: 0.5, Hold: 0.1, Release: 0.2},
+		Volume:             0.5,
+		Frequency:          440,
+		ArpeggioMultiplier: 1,
+		Duty:               0.5,
+	}
+}
+
+//UnmarshalJSON reads the params, keeping the fields missing from the JSON, and checks them with Validate
+func (p *Params) UnmarshalJSON(data []byte) error {
+	type params Params
+	if err := json.Unmarshal(data, (*params)(p)); err != nil {
+		return err
+	}
+	return p.Validate()
+}
+
+//Validate returns ErrInvalidParams if a parameter is out of its range, or ErrUnknownWaveform
+func (p Params) Validate() error {
+	if p.Waveform < 0 || int(p.Waveform) >= len(waveformNames) {
+		return ErrUnknownWaveform
+	}
+
+	inf := math.Inf(1)
+	ranges := []struct {
+		name     string
+		value    float64
+		min, max float64
+	}{
+		{"Envelope.Attack", p.Envelope.Attack, 0, inf},
+		{"Envelope.Decay", p.Envelope.Decay, 0, inf},
+		{"Envelope.Sustain", p.Envelope.Sustain, 0, 1},
+		{"Envelope.Hold", p.Envelope.Hold, 0, inf},
+		{"Envelope.Release", p.Envelope.Release, 0, inf},
+		{"Volume", p.Volume, 0, 1},
+		{"Frequency", p.Frequency, 0, inf},
+		{"MinFrequency", p.MinFrequency, 0, inf},
+		{"Slide", p.Slide, -inf, inf},
+		{"DeltaSlide", p.DeltaSlide, -inf, inf},
+		{"VibratoDepth", p.VibratoDepth, 0, 1},
+		{"VibratoSpeed", p.VibratoSpeed, 0, inf},
+		{"ArpeggioMultiplier", p.ArpeggioMultiplier, 0, inf},
+		{"ArpeggioTime", p.ArpeggioTime, 0, inf},
+		{"Duty", p.Duty, 0, 1},
+		{"DutySweep", p.DutySweep, -inf, inf},
+	}
+	for _, field := range ranges {
+		if math.IsNaN(field.value) || math.IsInf(field.value, 0) || field.value < field.min || field.value > field.max {
+			return fmt.Errorf("%w: %s is %v, it must be from %v to %v", ErrInvalidParams, field.name, field.value, field.min, field.max)
+		}
+	}
+	return nil
+}
+
+//Samples renders the sound into mono samples at the sample rate.
+// Params that fail Validate still render, but may not sound like anything.
+func (p Params) Samples(sampleRate int) []float32 {
+	count := int(p.Envelope.Duration() * float64(sampleRate))
+	if count < 1 {
+		count = 1
+	}
+	samples := make([]float32, count)
+
+	random := rand.New(rand.NewSource(p.Seed))
+	noise := make([]float64, noisePeriod)
+	fillNoise(random, noise)
+
+	dt := 1 / float64(sampleRate)
+	nyquist := float64(sampleRate) / 2
+	phase := 0.0
+	octaves := 0.0
+	slide := p.Slide
+
+	for i := range samples {
+		t := float64(i) * dt
+
+		frequency := p.Frequency * math.Pow(2, octaves)
+		if p.MinFrequency > 0 && frequency < p.MinFrequency {
+			return samples[:i]
+		}
+		if p.ArpeggioTime > 0 && t >= p.ArpeggioTime {
+			frequency *= p.ArpeggioMultiplier
+		}
+		if p.VibratoDepth != 0 {
+			frequency *= 1 + p.VibratoDepth*math.Sin(2*math.Pi*p.VibratoSpeed*t)
+		}
+		//Frequencies past the Nyquist frequency cannot be heard, and a long slide would take them to infinity
+		frequency = math.Max(math.Min(frequency, nyquist), -nyquist)
+
+		samples[i] = float32(p.oscillate(phase, t, noise) * p.Envelope.Level(t) * p.Volume)
+
+		//Negative frequencies, like vibrato deeper than 1 gives, move the phase backwards, so it wraps both ways
+		phase += frequency * dt
+		if phase >= 1 || phase < 0 {
+			phase -= math.Floor(phase)
+			if p.Waveform == Noise {
+				fillNoise(random, noise)
+			}
+		}
+		slide += p.DeltaSlide * dt
+		octaves += slide * dt
+	}
+	return samples
+}
+
+//Wave renders the sound into a 32 bit mono wave at DefaultSampleRate
+func (p Params) Wave() *r.Wave {
+	return p.WaveAt(DefaultSampleRate)
+}
+
+//WaveAt renders the sound into a 32 bit mono wave at the sample rate
+func (p Params) WaveAt(sampleRate int) *r.Wave {
+	return r.NewWaveFromSamples(p.Samples(sampleRate), uint32(sampleRate), 1)
+}
+
+//Sound renders the sound and loads it. The wave is unloaded once the sound has been made from it.
+func (p Params) Sound() *r.Sound {
+	wave := p.Wave()
+	defer wave.Unload()
+	return r.LoadSoundFromWave(wave)
+}
+
+//oscillate gets the value of the oscillator at the phase, from -1 to 1
+func (p Params) oscillate(phase float64, t float64, noise []float64) float64 {
+	switch p.Waveform {
+	case Square:
+		duty := math.Min(math.Max(p.Duty+p.DutySweep*t, 0), 1)
+		if phase < duty {
+			return 1
+		}
+		return -1
+	case Saw:
+		return 1 - 2*phase
+	case Sine:
+		return math.Sin(2 * math.Pi * phase)
+	case Triangle:
+		return 1 - 4*math.Abs(phase-0.5)
+	case Noise:
+		return noise[int(phase*noisePeriod)%noisePeriod]
+	}
+	return 0
+}
+
+func fillNoise(random *rand.Rand, noise []float64) {
+	for i := range noise {
+		noise[i] = random.Float64()*2 - 1
+	}
+}
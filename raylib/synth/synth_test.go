@@ -0,0 +1,82 @@
+package synth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestSamplesWrapThePhase(t *testing.T) {
+	for w := Square; w <= Noise; w++ {
+		tests := []Params{
+			DefaultParams(),
+			DefaultParams(),
+			DefaultParams(),
+		}
+		tests[0].VibratoDepth, tests[0].VibratoSpeed = 3, 20
+		tests[1].Frequency = -440
+		tests[2].Slide, tests[2].DeltaSlide = 2000, 5000
+
+		for i, p := range tests {
+			p.Waveform = w
+			for j, s := range p.Samples(DefaultSampleRate) {
+				if s < -1 || s > 1 {
+					t.Fatalf("%s params %d: sample %d is %v", w, i, j, s)
+				}
+			}
+		}
+	}
+}
+
+func TestPresetsAreValid(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for name, preset := range Presets {
+		for _, p := range []Params{preset(nil), preset(random)} {
+			if err := p.Validate(); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+
+			data, err := json.Marshal(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var read Params
+			if err := json.Unmarshal(data, &read); err != nil || read != p {
+				t.Fatalf("%s read back as %+v, %v", name, read, err)
+			}
+		}
+	}
+}
+
+func TestUnmarshalValidates(t *testing.T) {
+	invalid := []string{
+		`{"vibratoDepth": 2}`,
+		`{"frequency": -440}`,
+		`{"envelope": {"release": -1}}`,
+		`{"volume": 1.5}`,
+		`{"duty": -0.5}`,
+	}
+	for _, text := range invalid {
+		p := DefaultParams()
+		if err := json.Unmarshal([]byte(text), &p); !errors.Is(err, ErrInvalidParams) {
+			t.Errorf("%s gives %v", text, err)
+		}
+	}
+
+	p := DefaultParams()
+	if err := json.Unmarshal([]byte(`{"waveform": "buzz"}`), &p); !errors.Is(err, ErrUnknownWaveform) {
+		t.Errorf("an unknown waveform gives %v", err)
+	}
+
+	//missing fields keep their value
+	p = DefaultParams()
+	if err := json.Unmarshal([]byte(`{"waveform": "noise", "volume": 0.25}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	want := DefaultParams()
+	want.Waveform, want.Volume = Noise, 0.25
+	if p != want {
+		t.Fatalf("unmarshalled %+v", p)
+	}
+}
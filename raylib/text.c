@@ -321,7 +321,8 @@ Font LoadFont(const char *fileName)
         TraceLog(LOG_WARNING, "[%s] Font could not be loaded, using default font", fileName);
         font = GetFontDefault();
     }
-    else SetTextureFilter(font.texture, FILTER_POINT);    // By default we set point filter (best performance)
+    else if (!IsUploadDeferred()) SetTextureFilter(font.texture, FILTER_POINT);    // By default we set point filter (best performance)
+    // NOTE: While uploads are deferred there is no GL context, the filter is set once the texture is uploaded
 
     return font;
 }
@@ -751,8 +752,8 @@ Image GenImageFontAtlas(const CharInfo *chars, Rectangle **charRecs, int charsCo
 // Unload Font from GPU memory (VRAM)
 void UnloadFont(Font font)
 {
-    // NOTE: Make sure font is not default font (fallback)
-    if (font.texture.id != GetFontDefault().texture.id)
+    // NOTE: Make sure font is not default font (fallback), fonts without a texture are told apart by their chars
+    if ((font.texture.id != GetFontDefault().texture.id) || (font.chars != GetFontDefault().chars))
     {
         for (int i = 0; i < font.charsCount; i++) UnloadImage(font.chars[i].image);
 
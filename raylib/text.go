@@ -5,3 +5,10 @@ package raylib
 #include <stdlib.h>
 */
 import "C"
+import "fmt"
+
+//TextFormat : Text formatting with variables (sprintf style).
+// cgo does not support variadic C functions, so this is formatted by Go's fmt.Sprintf instead.
+func TextFormat(text string, a ...interface{}) string {
+	return fmt.Sprintf(text, a...)
+}
@@ -1,16 +1,21 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T15:49:09Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
 */
 import "C"
-import "unsafe"
+import (
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
 
 //GetFontDefault : Get the default Font
 func GetFontDefault() *Font {
+	checkMainThread("GetFontDefault")
 	res := C.GetFontDefault()
 	retval := newFontFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -19,6 +24,7 @@ func GetFontDefault() *Font {
 
 //LoadFont : Load font from file into GPU memory (VRAM)
 func LoadFont(fileName string) *Font {
+	checkUploadThread("LoadFont")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 	res := C.LoadFont(cfileName)
@@ -27,8 +33,23 @@ func LoadFont(fileName string) *Font {
 	return retval
 }
 
+//LoadFontE : Load font from file into GPU memory (VRAM)
+//Returns an error instead of an invalid Font if it cannot be loaded
+func LoadFontE(fileName string) (*Font, error) {
+	var retval *Font
+	err := loadChecked("LoadFont", ".ttf;.otf;.fnt;.png;.gif;.dds;.hdr", "Font could not be loaded", func() Unloadable {
+		retval = LoadFont(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
 //LoadFontEx : Load font from file with extended parameters
 func LoadFontEx(fileName string, fontSize int, fontChars int, charsCount int) (*Font, int) {
+	checkMainThread("LoadFontEx")
 	cfontChars := C.int(int32(fontChars))
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
@@ -40,6 +61,7 @@ func LoadFontEx(fileName string, fontSize int, fontChars int, charsCount int) (*
 
 //LoadFontFromImage : Load font from Image (XNA style)
 func LoadFontFromImage(image *Image, key Color, firstChar int) *Font {
+	checkMainThread("LoadFontFromImage")
 	ckey := *key.cptr()
 	cimage := *image.cptr()
 	res := C.LoadFontFromImage(cimage, ckey, C.int(int32(firstChar)))
@@ -48,8 +70,9 @@ func LoadFontFromImage(image *Image, key Color, firstChar int) *Font {
 	return retval
 }
 
-// LoadFontData : Load font data and copy into a new Go Slice. Original is then freed.
+//LoadFontData : Load font data and copy into a new Go Slice. Original is then freed.
 func LoadFontData(fileName string, fontSize, charsCount int, fontType FontType) []CharInfo {
+	checkMainThread("LoadFontData")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 
@@ -57,19 +80,45 @@ func LoadFontData(fileName string, fontSize, charsCount int, fontType FontType)
 	defer C.free(unsafe.Pointer(res))
 
 	//Get the slice
-	tmpslice := (*[1 << 24]*C.CharInfo)(unsafe.Pointer(res))[:charsCount:charsCount]
+	tmpslice := (*[1 << 24]C.CharInfo)(unsafe.Pointer(res))[:charsCount:charsCount]
 
-	//Convert to a Color array
+	//Convert to a CharInfo array
 	goslice := make([]CharInfo, charsCount)
-	for i, s := range tmpslice {
-		goslice[i] = newCharInfoFromPointer(unsafe.Pointer(s))
+	for i := range tmpslice {
+		goslice[i] = newCharInfoFromPointer(unsafe.Pointer(&tmpslice[i]))
 	}
 
 	return goslice
 }
 
+//GenImageFontAtlas : Generate image font atlas using chars info, and the rectangle of every char in it. The atlas is GrayAlpha, with white pixels and the chars in the alpha.
+// The images of the chars must be Grayscale, like LoadFontData gives. The pack method is 0 for the default packing and 1 for skyline packing.
+// An empty chars gives an empty image.
+func GenImageFontAtlas(chars []CharInfo, fontSize int, padding int, packMethod int) (*Image, []Rectangle) {
+	if len(chars) == 0 {
+		TraceLog(LogWarning, "[FONT] Cannot generate a font atlas without chars")
+		image := &Image{}
+		RegisterUnloadable(image)
+		return image, nil
+	}
+
+	var crecs *C.Rectangle
+	cchars := (*C.CharInfo)(unsafe.Pointer(&chars[0]))
+	res := C.GenImageFontAtlas(cchars, &crecs, C.int(int32(len(chars))), C.int(int32(fontSize)), C.int(int32(padding)), C.int(int32(packMethod)))
+	defer C.free(unsafe.Pointer(crecs))
+
+	retval := newImageFromPointer(unsafe.Pointer(&res))
+	RegisterUnloadable(retval)
+
+	//Copy the rectangles, the C array is freed
+	recs := make([]Rectangle, len(chars))
+	copy(recs, (*[1 << 24]Rectangle)(unsafe.Pointer(crecs))[:len(chars):len(chars)])
+	return retval, recs
+}
+
 //Unload : Unload Font from GPU memory (VRAM)
 func (font *Font) Unload() {
+	checkMainThread("UnloadFont")
 	cfont := *font.cptr()
 	C.UnloadFont(cfont)
 	UnregisterUnloadable(font)
@@ -83,11 +132,13 @@ func UnloadFont(font *Font) {
 
 //DrawFPS : Shows current FPS
 func DrawFPS(posX int, posY int) {
+	checkMainThread("DrawFPS")
 	C.DrawFPS(C.int(int32(posX)), C.int(int32(posY)))
 }
 
 //DrawText : Draw text (using default font)
 func DrawText(text string, posX int, posY int, fontSize int, color Color) {
+	checkMainThread("DrawText")
 	ccolor := *color.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -96,6 +147,7 @@ func DrawText(text string, posX int, posY int, fontSize int, color Color) {
 
 //DrawTextEx : Draw text using font and additional parameters
 func DrawTextEx(font Font, text string, position Vector2, fontSize float32, spacing float32, tint Color) {
+	checkMainThread("DrawTextEx")
 	ctint := *tint.cptr()
 	cposition := *position.cptr()
 	ctext := C.CString(text)
@@ -106,6 +158,7 @@ func DrawTextEx(font Font, text string, position Vector2, fontSize float32, spac
 
 //DrawTextRec : Draw text using font inside rectangle limits
 func DrawTextRec(font Font, text string, rec Rectangle, fontSize float32, spacing float32, wordWrap bool, tint Color) {
+	checkMainThread("DrawTextRec")
 	ctint := *tint.cptr()
 	crec := *rec.cptr()
 	ctext := C.CString(text)
@@ -116,6 +169,7 @@ func DrawTextRec(font Font, text string, rec Rectangle, fontSize float32, spacin
 
 //DrawTextRecEx : Draw text using font inside rectangle limits with support for text selection
 func DrawTextRecEx(font Font, text string, rec Rectangle, fontSize float32, spacing float32, wordWrap bool, tint Color, selectStart int, selectLength int, selectText Color, selectBack Color) {
+	checkMainThread("DrawTextRecEx")
 	cselectBack := *selectBack.cptr()
 	cselectText := *selectText.cptr()
 	ctint := *tint.cptr()
@@ -126,8 +180,18 @@ func DrawTextRecEx(font Font, text string, rec Rectangle, fontSize float32, spac
 	C.DrawTextRecEx(cfont, ctext, crec, C.float(fontSize), C.float(spacing), C.bool(wordWrap), ctint, C.int(selectStart), C.int(selectLength), cselectText, cselectBack)
 }
 
+//DrawTextCodepoint : Draw one character (codepoint)
+func DrawTextCodepoint(font Font, codepoint rune, position Vector2, scale float32, tint Color) {
+	checkMainThread("DrawTextCodepoint")
+	ctint := *tint.cptr()
+	cposition := *position.cptr()
+	cfont := *font.cptr()
+	C.DrawTextCodepoint(cfont, C.int(int32(codepoint)), cposition, C.float(scale), ctint)
+}
+
 //MeasureText : Measure string width for default font
 func MeasureText(text string, fontSize int) int {
+	checkMainThread("MeasureText")
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
 	res := C.MeasureText(ctext, C.int(int32(fontSize)))
@@ -144,8 +208,175 @@ func MeasureTextEx(font Font, text string, fontSize float32, spacing float32) Ve
 }
 
 //GetGlyphIndex : Get index position for a unicode character on font
-func GetGlyphIndex(font Font, character int) int {
+func GetGlyphIndex(font Font, codepoint int) int {
 	cfont := *font.cptr()
-	res := C.GetGlyphIndex(cfont, C.int(int32(character)))
+	res := C.GetGlyphIndex(cfont, C.int(int32(codepoint)))
+	return int(int32(res))
+}
+
+//TextIsEqual : Check if two text string are equal
+func TextIsEqual(text1 string, text2 string) bool {
+	ctext2 := C.CString(text2)
+	defer C.free(unsafe.Pointer(ctext2))
+	ctext1 := C.CString(text1)
+	defer C.free(unsafe.Pointer(ctext1))
+	res := C.TextIsEqual(ctext1, ctext2)
+	return bool(res)
+}
+
+//TextLength : Get text length, checks for '\0' ending
+func TextLength(text string) uint32 {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextLength(ctext)
+	return uint32(res)
+}
+
+//TextSubtext : Get a piece of a text string
+func TextSubtext(text string, position int, length int) string {
+	checkMainThread("TextSubtext")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextSubtext(ctext, C.int(int32(position)), C.int(int32(length)))
+	return C.GoString(res)
+}
+
+//TextReplace : Replace text string. The text is returned unchanged if replace is empty.
+func TextReplace(text string, replace string, by string) string {
+	cby := C.CString(by)
+	defer C.free(unsafe.Pointer(cby))
+	creplace := C.CString(replace)
+	defer C.free(unsafe.Pointer(creplace))
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	res := C.TextReplace(ctext, creplace, cby)
+	if res == nil {
+		return text
+	}
+	defer C.free(unsafe.Pointer(res))
+	return C.GoString(res)
+}
+
+//TextInsert : Insert text in a position
+// This is done in Go, as raylib's TextInsert copies the wrong parts of the text and insert.
+func TextInsert(text string, insert string, position int) string {
+	if position < 0 {
+		position = 0
+	} else if position > len(text) {
+		position = len(text)
+	}
+	return text[:position] + insert + text[position:]
+}
+
+//TextJoin : Join text strings with delimiter
+// This is done in Go, as raylib's TextJoin drops the strings that do not fit in its 1024 byte buffer.
+func TextJoin(textList []string, delimiter string) string {
+	return strings.Join(textList, delimiter)
+}
+
+//TextSplit : Split text into multiple strings
+// This is done in Go, as raylib's TextSplit only splits the first 1024 bytes into at most 128 strings.
+func TextSplit(text string, delimiter byte) []string {
+	return strings.Split(text, string([]byte{delimiter}))
+}
+
+//TextFindIndex : Find first text occurrence within a string
+func TextFindIndex(text string, find string) int {
+	cfind := C.CString(find)
+	defer C.free(unsafe.Pointer(cfind))
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextFindIndex(ctext, cfind)
 	return int(int32(res))
 }
+
+//TextToUpper : Get upper case version of provided string
+func TextToUpper(text string) string {
+	checkMainThread("TextToUpper")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextToUpper(ctext)
+	return C.GoString(res)
+}
+
+//TextToLower : Get lower case version of provided string
+func TextToLower(text string) string {
+	checkMainThread("TextToLower")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextToLower(ctext)
+	return C.GoString(res)
+}
+
+//TextToPascal : Get Pascal case notation version of provided string
+func TextToPascal(text string) string {
+	checkMainThread("TextToPascal")
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextToPascal(ctext)
+	return C.GoString(res)
+}
+
+//TextToInteger : Get integer value from text (negative values not supported)
+func TextToInteger(text string) int {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.TextToInteger(ctext)
+	return int(int32(res))
+}
+
+//TextToUtf8 : Encode text codepoint into utf8 text
+func TextToUtf8(codepoints []rune) string {
+	if len(codepoints) == 0 {
+		return ""
+	}
+
+	res := C.TextToUtf8((*C.int)(unsafe.Pointer(&codepoints[0])), C.int(len(codepoints)))
+	defer C.free(unsafe.Pointer(res))
+	return C.GoString(res)
+}
+
+//GetCodepoints : Get all codepoints in a string
+// The text is decoded the same way DrawTextEx and MeasureTextEx decode it, where every invalid byte becomes a '?'.
+// Unlike raylib's GetCodepoints, this does not skip bytes after a truncated sequence and is not limited to 512 codepoints.
+func GetCodepoints(text string) []rune {
+	codepoints := make([]rune, 0, len(text))
+	for i, r := range text {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(text[i:]); size == 1 {
+				r = '?'
+			}
+		}
+		codepoints = append(codepoints, r)
+	}
+	return codepoints
+}
+
+//GetCodepointsCount : Get total number of characters (codepoints) in a UTF8 encoded string
+func GetCodepointsCount(text string) int {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	res := C.GetCodepointsCount(ctext)
+	return int(int32(res))
+}
+
+//GetNextCodepoint : Returns next codepoint in a UTF8 encoded string and the amount of bytes it used; 0x3f('?') is returned on failure
+func GetNextCodepoint(text string) (rune, int) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	cbytesProcessed := C.int(0)
+	res := C.GetNextCodepoint(ctext, &cbytesProcessed)
+	return rune(res), int(int32(cbytesProcessed))
+}
+
+//CodepointToUtf8 : Encode codepoint into utf8 text
+func CodepointToUtf8(codepoint rune) string {
+	checkMainThread("CodepointToUtf8")
+	cbyteLength := C.int(0)
+	res := C.CodepointToUtf8(C.int(codepoint), &cbyteLength)
+
+	//The static buffer is not cleared between calls, so we cannot rely on the null terminator
+	return C.GoStringN(res, cbyteLength)
+}
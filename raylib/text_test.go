@@ -0,0 +1,155 @@
+package raylib
+
+import (
+	"strings"
+	"testing"
+)
+
+//testFont makes a font for the runes, with a different size for each glyph and no texture
+func testFont(t *testing.T, runes []rune) *Font {
+	chars := make([]CharInfo, len(runes))
+	recs := make([]Rectangle, len(runes))
+	for i, r := range runes {
+		chars[i].Value = r
+		chars[i].OffsetX = int32(i % 3)
+		if i%2 == 0 {
+			chars[i].AdvanceX = int32(5 + i)
+		}
+		recs[i].Width = float32(7 + i)
+		recs[i].Height = 10
+	}
+	font := NewFontFromGlyphs(10, Texture2D{}, chars, recs)
+	//Unload has to be called on the main thread, so the few bytes of glyphs are left for the test process
+	t.Cleanup(func() { UnregisterUnloadable(font) })
+	return font
+}
+
+func TestTextCodepointsMatchRaylib(t *testing.T) {
+	runes := []rune{}
+	for r := rune(32); r < 127; r++ {
+		runes = append(runes, r)
+	}
+	runes = append(runes, 'é', 'ß', '日', '本', '語', '🎮', 0xFFFD)
+	font := testFont(t, runes)
+
+	texts := []string{
+		"hello",
+		"héllo\nwörld",
+		"日本語 🎮",
+		"ab\n\ncd",
+		"bad\xff\xfe bytes",
+		"trunc\xe2\x82A",
+		"\xef\xbf\xbd ok",
+		"\xed\xa0\x80x\xc0\x80\xf4\x90\x80\x80",
+	}
+	for _, text := range texts {
+		codepoints := GetCodepoints(text)
+		if len(codepoints) != GetCodepointsCount(text) {
+			t.Errorf("%q: %d codepoints, GetCodepointsCount %d", text, len(codepoints), GetCodepointsCount(text))
+		}
+
+		measured := MeasureTextEx(*font, text, 20, 2)
+		if got := font.MeasureCodepoints(codepoints, 20, 2); got != measured {
+			t.Errorf("%q: MeasureCodepoints %v, MeasureTextEx %v", text, got, measured)
+		}
+
+		glyphs := font.TextGlyphs(text)
+		for i, codepoint := range codepoints {
+			if want := GetGlyphIndex(*font, int(codepoint)); glyphs[i] != want {
+				t.Errorf("%q: glyph %d is %d, GetGlyphIndex %d", text, i, glyphs[i], want)
+			}
+		}
+	}
+}
+
+func TestTextJoinSplit(t *testing.T) {
+	//More than raylib's 1024 byte buffer and 128 strings
+	parts := make([]string, 300)
+	for i := range parts {
+		parts[i] = strings.Repeat("x", i%7) + "日本"
+	}
+	parts[10] = ""
+
+	joined := TextJoin(parts, ", ")
+	if len(joined) <= 1024 {
+		t.Fatalf("joined text is only %d bytes", len(joined))
+	}
+	if want := strings.Join(parts, ", "); joined != want {
+		t.Fatalf("TextJoin dropped text: %d bytes, want %d", len(joined), len(want))
+	}
+
+	split := TextSplit(TextJoin(parts, ";"), ';')
+	if len(split) != len(parts) {
+		t.Fatalf("TextSplit gave %d strings, want %d", len(split), len(parts))
+	}
+	for i := range parts {
+		if split[i] != parts[i] {
+			t.Fatalf("string %d is %q, want %q", i, split[i], parts[i])
+		}
+	}
+
+	if got := TextSplit("", ','); len(got) != 1 || got[0] != "" {
+		t.Errorf("TextSplit of empty text is %q", got)
+	}
+}
+
+func TestGenImageFontAtlas(t *testing.T) {
+	chars := make([]CharInfo, 20)
+	for i := range chars {
+		pixels := make([]uint8, (4+i%5)*(6+i%3))
+		for p := range pixels {
+			pixels[p] = uint8(i + 1)
+		}
+		image := NewImageFromPixels(pixels, int32(4+i%5), int32(6+i%3))
+		defer image.Unload()
+		chars[i] = CharInfo{Value: rune('a' + i), Image: *image}
+	}
+
+	for _, packMethod := range []int{0, 1} {
+		atlas, recs := GenImageFontAtlas(chars, 8, 2, packMethod)
+		if atlas.Format != UncompressedGrayAlpha || len(recs) != len(chars) {
+			t.Fatalf("pack method %d gives format %d and %d rectangles", packMethod, atlas.Format, len(recs))
+		}
+
+		//every char is copied into the alpha of its rectangle, so the rectangles do not overlap
+		pixels := Pixels[PixelGrayAlpha](atlas)
+		for i, rec := range recs {
+			if rec.Width != float32(chars[i].Image.Width) || rec.Height != float32(chars[i].Image.Height) {
+				t.Errorf("pack method %d char %d is %vx%v", packMethod, i, rec.Width, rec.Height)
+			}
+			for y := int(rec.Y); y < int(rec.Y+rec.Height); y++ {
+				for x := int(rec.X); x < int(rec.X+rec.Width); x++ {
+					if v := pixels[y*int(atlas.Width)+x].Alpha; v != uint8(i+1) {
+						t.Fatalf("pack method %d char %d has %d at %d,%d", packMethod, i, v, x, y)
+					}
+				}
+			}
+		}
+		atlas.Unload()
+	}
+
+	empty, recs := GenImageFontAtlas(nil, 8, 2, 0)
+	defer empty.Unload()
+	if empty.Width != 0 || recs != nil {
+		t.Fatalf("no chars gives a %dx%d image", empty.Width, empty.Height)
+	}
+}
+
+func TestFontGlyphs(t *testing.T) {
+	font := testFont(t, []rune("abc"))
+	if len(font.GetChars()) != 3 || len(font.GetRecs()) != 3 || cap(font.GetChars()) != 3 {
+		t.Fatalf("the font has %d chars and %d recs", len(font.GetChars()), len(font.GetRecs()))
+	}
+	if char, rec := font.GetGlyph('b'); char.Value != 'b' || rec.Width != 8 {
+		t.Errorf("b is %c with a width of %v", char.Value, rec.Width)
+	}
+	if char, rec := font.GetGlyph('z'); char.Value != 0 || rec.Width != 0 {
+		t.Errorf("a missing glyph gives %c with a width of %v", char.Value, rec.Width)
+	}
+
+	empty := NewFontFromGlyphs(10, Texture2D{}, nil, nil)
+	defer UnregisterUnloadable(empty)
+	if empty.GetChars() != nil || empty.GetRecs() != nil {
+		t.Error("a font without glyphs has glyphs")
+	}
+}
@@ -25,7 +25,12 @@ func newTexture2DFromPointer(ptr unsafe.Pointer) Texture2D {
 	return *(*Texture2D)(ptr)
 }
 
-//LoadTextureFromGo loads image data from image.Image. Uses NewImageFromGoImage.
+//IsValid returns true if the texture has been uploaded to the GPU
+func (t Texture2D) IsValid() bool {
+	return t.Id > 0
+}
+
+//LoadTextureFromGo loads image data from image.Image. Uses LoadImageFromGo.
 func LoadTextureFromGo(image image.Image) Texture2D {
 	img := LoadImageFromGo(image)
 	defer img.Unload()
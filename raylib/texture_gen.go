@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:31:29Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -19,6 +19,20 @@ func LoadImage(fileName string) *Image {
 	return retval
 }
 
+//LoadImageE : Load image from file into CPU memory (RAM)
+//Returns an error instead of an invalid Image if it cannot be loaded
+func LoadImageE(fileName string) (*Image, error) {
+	var retval *Image
+	err := loadChecked("LoadImage", ".png;.gif;.dds;.hdr", "", func() Unloadable {
+		retval = LoadImage(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return retval, nil
+}
+
 // LoadImageEx Load image data from Color array data (RGBA - 32bit)
 func LoadImageEx(pixels []Color, width, height int32) *Image {
 	cpixels := pixels[0].cptr()
@@ -79,6 +93,7 @@ func ExportImageAsCode(image *Image, fileName string) {
 
 //LoadTexture : Load texture from file into GPU memory (VRAM)
 func LoadTexture(fileName string) Texture2D {
+	checkMainThread("LoadTexture")
 	cfileName := C.CString(fileName)
 	defer C.free(unsafe.Pointer(cfileName))
 	res := C.LoadTexture(cfileName)
@@ -87,8 +102,23 @@ func LoadTexture(fileName string) Texture2D {
 	return retval
 }
 
+//LoadTextureE : Load texture from file into GPU memory (VRAM)
+//Returns an error instead of an invalid Texture2D if it cannot be loaded
+func LoadTextureE(fileName string) (Texture2D, error) {
+	var retval Texture2D
+	err := loadChecked("LoadTexture", ".png;.gif;.dds;.hdr", "", func() Unloadable {
+		retval = LoadTexture(fileName)
+		return retval
+	}, fileName)
+	if err != nil {
+		return Texture2D{}, err
+	}
+	return retval, nil
+}
+
 //LoadTextureFromImage : Load texture from image data
 func LoadTextureFromImage(image *Image) Texture2D {
+	checkMainThread("LoadTextureFromImage")
 	cimage := *image.cptr()
 	res := C.LoadTextureFromImage(cimage)
 	retval := newTexture2DFromPointer(unsafe.Pointer(&res))
@@ -98,6 +128,7 @@ func LoadTextureFromImage(image *Image) Texture2D {
 
 //LoadTextureCubemap : Load cubemap from image, multiple image cubemap layouts supported
 func LoadTextureCubemap(image *Image, layoutType CubemapLayoutType) *TextureCubemap {
+	checkMainThread("LoadTextureCubemap")
 	cimage := *image.cptr()
 	res := C.LoadTextureCubemap(cimage, C.int(int32(layoutType)))
 	retval := newTextureCubemapFromPointer(unsafe.Pointer(&res))
@@ -107,6 +138,7 @@ func LoadTextureCubemap(image *Image, layoutType CubemapLayoutType) *TextureCube
 
 //LoadRenderTexture : Load texture for rendering (framebuffer)
 func LoadRenderTexture(width int, height int) RenderTexture2D {
+	checkMainThread("LoadRenderTexture")
 	res := C.LoadRenderTexture(C.int(int32(width)), C.int(int32(height)))
 	retval := newRenderTexture2DFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -128,6 +160,7 @@ func UnloadImage(image *Image) {
 
 //Unload : Unload texture from GPU memory (VRAM)
 func (texture Texture2D) Unload() {
+	checkMainThread("UnloadTexture")
 	ctexture := *texture.cptr()
 	C.UnloadTexture(ctexture)
 	UnregisterUnloadable(texture)
@@ -141,6 +174,7 @@ func UnloadTexture(texture Texture2D) {
 
 //Unload : Unload render texture from GPU memory (VRAM)
 func (target RenderTexture2D) Unload() {
+	checkMainThread("UnloadRenderTexture")
 	ctarget := *target.cptr()
 	C.UnloadRenderTexture(ctarget)
 	UnregisterUnloadable(target)
@@ -158,43 +192,10 @@ func GetImageData(image *Image) []Color {
 	return image.GetPixels()
 }
 
-//GetPixels returns the pixel data from an image as a colour slice.
+//GetPixels returns a copy of the pixel data from an image as a colour slice. Returns nil for compressed images.
+//Use Pixels[Color] to read R8G8B8A8 images without copying.
 func (image *Image) GetPixels() []Color {
-
-	//https://github.com/gen2brain/raylib-go/blob/48689803b8b82802b2899cc62afcb473d7ba8c00/raylib/textures.go#L131
-	cimg := image.cptr()
-	ret := C.GetImageData(*cimg)
-	defer C.free(unsafe.Pointer(ret))
-
-	tmpslice := (*[1 << 24]Color)(unsafe.Pointer(ret))[0 : image.Width*image.Height]
-	goslice := make([]Color, image.Width*image.Height)
-
-	for i, _ := range tmpslice {
-		goslice[i] = Color{tmpslice[i].R, tmpslice[i].G, tmpslice[i].B, tmpslice[i].A}
-	}
-
-	return goslice
-
-	/*
-		//Prepare the pointer and get the data
-		cimg := image.cptr()
-		res := C.GetImageData(*cimg)
-		//defer C.free(unsafe.Pointer(res))
-
-		//Calculate the samples
-		samples := image.Width*image.Height
-
-		//Get the slice
-		tmpslice := (*[1 << 24]C.Color)(unsafe.Pointer(res))[:samples:samples]
-
-		//Convert to a Color array
-		goslice := make([]Color, samples)
-		for i, s := range tmpslice {
-			goslice[i] = newColorFromPointer(unsafe.Pointer(&s))
-		}
-
-		return goslice
-	*/
+	return PixelsAs[Color](image)
 }
 
 // GetImageDataNormalized : Get pixel data from image as a Color slice
@@ -203,26 +204,10 @@ func GetImageDataNormalized(image *Image) []Vector4 {
 	return image.GetPixelsNormalized()
 }
 
-//GetPixelsNormalized returns the pixel data from an image as a colour slice.
+//GetPixelsNormalized returns a copy of the pixel data from an image as normalized colours [0..1]. Returns nil for compressed images.
+//Float formats are not clamped, use Pixels[Vector4] to read R32G32B32A32 images without copying.
 func (image *Image) GetPixelsNormalized() []Vector4 {
-	//Prepare the pointer and get the data
-	cimg := image.cptr()
-	res := C.GetImageDataNormalized(*cimg)
-	defer C.free(unsafe.Pointer(res))
-
-	//Calculate the samples
-	samples := image.Width * image.Height
-
-	//Get the slice
-	tmpslice := (*[1 << 24]*C.Vector4)(unsafe.Pointer(res))[:samples:samples]
-
-	//Convert to a Color array
-	goslice := make([]Vector4, samples)
-	for i, s := range tmpslice {
-		goslice[i] = newVector4FromPointer(unsafe.Pointer(s))
-	}
-
-	return goslice
+	return PixelsAs[Vector4](image)
 }
 
 //GetAlphaBorder : Get image alpha border rectangle
@@ -246,6 +231,7 @@ func GetPixelDataSize(width int, height int, format PixelFormat) int {
 
 //GetTextureData : Get pixel data from GPU texture and return an Image
 func (texture Texture2D) GetTextureData() *Image {
+	checkMainThread("GetTextureData")
 	ctexture := *texture.cptr()
 	res := C.GetTextureData(ctexture)
 	retval := newImageFromPointer(unsafe.Pointer(&res))
@@ -261,6 +247,7 @@ func GetTextureData(texture Texture2D) *Image {
 
 //GetScreenData : Get pixel data from screen buffer and return an Image (screenshot)
 func GetScreenData() *Image {
+	checkMainThread("GetScreenData")
 	res := C.GetScreenData()
 	retval := newImageFromPointer(unsafe.Pointer(&res))
 	RegisterUnloadable(retval)
@@ -269,6 +256,7 @@ func GetScreenData() *Image {
 
 //UpdateTexture : Update GPU texture with new data
 func (texture *Texture2D) UpdateTexture(pixels []Color) {
+	checkMainThread("UpdateTexture")
 	ctexture := *texture.cptr()
 	cpixels := pixels[0].cptr()
 	C.UpdateTexture(ctexture, unsafe.Pointer(cpixels))
@@ -491,6 +479,7 @@ func ImageExtractPalette(image *Image, maxPaletteSize int) ([]Color, int) {
 
 //ImageText : Create an image from text (default font)
 func ImageText(text string, fontSize int, color Color) *Image {
+	checkMainThread("ImageText")
 	ccolor := *color.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -500,6 +489,7 @@ func ImageText(text string, fontSize int, color Color) *Image {
 
 //ImageTextEx : Create an image from text (custom sprite font)
 func ImageTextEx(font Font, text string, fontSize float32, spacing float32, tint Color) *Image {
+	checkMainThread("ImageTextEx")
 	ctint := *tint.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -554,6 +544,7 @@ func ImageDrawRectangleLines(dst *Image, rec Rectangle, thick int, color Color)
 
 //DrawText : Draw text (default font) within an image (destination)
 func (dst *Image) DrawText(position Vector2, text string, fontSize int, color Color) {
+	checkMainThread("ImageDrawText")
 	ccolor := *color.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -570,6 +561,7 @@ func ImageDrawText(dst *Image, position Vector2, text string, fontSize int, colo
 
 //DrawTextEx : Draw text (custom sprite font) within an image (destination)
 func (dst *Image) DrawTextEx(position Vector2, font *Font, text string, fontSize float32, spacing float32, color Color) {
+	checkMainThread("ImageDrawTextEx")
 	ccolor := *color.cptr()
 	ctext := C.CString(text)
 	defer C.free(unsafe.Pointer(ctext))
@@ -783,6 +775,7 @@ func GenImageCellular(width int, height int, tileSize int) *Image {
 
 //GenTextureMipmaps : Generate GPU mipmaps for a texture
 func (texture Texture2D) GenTextureMipmaps() {
+	checkMainThread("GenTextureMipmaps")
 	ctexture := texture.cptr()
 	C.GenTextureMipmaps(ctexture)
 }
@@ -795,6 +788,7 @@ func GenTextureMipmaps(texture Texture2D) {
 
 //SetTextureFilter : Set texture scaling filter mode
 func (texture Texture2D) SetTextureFilter(filterMode TextureFilterMode) {
+	checkMainThread("SetTextureFilter")
 	ctexture := *texture.cptr()
 	C.SetTextureFilter(ctexture, C.int(int32(filterMode)))
 }
@@ -807,6 +801,7 @@ func SetTextureFilter(texture Texture2D, filterMode TextureFilterMode) {
 
 //SetWrap : Set texture wrapping mode
 func (texture *Texture2D) SetWrap(wrapMode TextureWrapMode) {
+	checkMainThread("SetTextureWrap")
 	ctexture := *texture.cptr()
 	C.SetTextureWrap(ctexture, C.int(int32(wrapMode)))
 }
@@ -819,6 +814,7 @@ func SetTextureWrap(texture *Texture2D, wrapMode TextureWrapMode) {
 
 //DrawTexture : Draw a Texture2D
 func DrawTexture(texture Texture2D, posX int, posY int, tint Color) {
+	checkMainThread("DrawTexture")
 	ctint := *tint.cptr()
 	ctexture := *texture.cptr()
 	C.DrawTexture(ctexture, C.int(int32(posX)), C.int(int32(posY)), ctint)
@@ -826,6 +822,7 @@ func DrawTexture(texture Texture2D, posX int, posY int, tint Color) {
 
 //DrawTextureV : Draw a Texture2D with position defined as Vector2
 func DrawTextureV(texture Texture2D, position Vector2, tint Color) {
+	checkMainThread("DrawTextureV")
 	ctint := *tint.cptr()
 	cposition := *position.cptr()
 	ctexture := *texture.cptr()
@@ -834,6 +831,7 @@ func DrawTextureV(texture Texture2D, position Vector2, tint Color) {
 
 //DrawTextureEx : Draw a Texture2D with extended parameters
 func DrawTextureEx(texture Texture2D, position Vector2, rotation float32, scale float32, tint Color) {
+	checkMainThread("DrawTextureEx")
 	ctint := *tint.cptr()
 	cposition := *position.cptr()
 	ctexture := *texture.cptr()
@@ -842,6 +840,7 @@ func DrawTextureEx(texture Texture2D, position Vector2, rotation float32, scale
 
 //DrawTextureRec : Draw a part of a texture defined by a rectangle
 func DrawTextureRec(texture Texture2D, sourceRec Rectangle, position Vector2, tint Color) {
+	checkMainThread("DrawTextureRec")
 	ctint := *tint.cptr()
 	cposition := *position.cptr()
 	csourceRec := *sourceRec.cptr()
@@ -851,6 +850,7 @@ func DrawTextureRec(texture Texture2D, sourceRec Rectangle, position Vector2, ti
 
 //DrawTextureQuad : Draw texture quad with tiling and offset parameters
 func DrawTextureQuad(texture Texture2D, tiling Vector2, offset Vector2, quad Rectangle, tint Color) {
+	checkMainThread("DrawTextureQuad")
 	ctint := *tint.cptr()
 	cquad := *quad.cptr()
 	coffset := *offset.cptr()
@@ -861,6 +861,7 @@ func DrawTextureQuad(texture Texture2D, tiling Vector2, offset Vector2, quad Rec
 
 //DrawTexturePro : Draw a part of a texture defined by a rectangle with 'pro' parameters
 func DrawTexturePro(texture Texture2D, sourceRec Rectangle, destRec Rectangle, origin Vector2, rotation float32, tint Color) {
+	checkMainThread("DrawTexturePro")
 	ctint := *tint.cptr()
 	corigin := *origin.cptr()
 	cdestRec := *destRec.cptr()
@@ -871,6 +872,7 @@ func DrawTexturePro(texture Texture2D, sourceRec Rectangle, destRec Rectangle, o
 
 //DrawTextureNPatch : Draws a texture (or part of it) that stretches or shrinks nicely
 func DrawTextureNPatch(texture Texture2D, nPatchInfo NPatchInfo, destRec Rectangle, origin Vector2, rotation float32, tint Color) {
+	checkMainThread("DrawTextureNPatch")
 	ctint := *tint.cptr()
 	corigin := *origin.cptr()
 	cdestRec := *destRec.cptr()
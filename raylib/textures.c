@@ -141,7 +141,7 @@
 //----------------------------------------------------------------------------------
 // Defines and Macros
 //----------------------------------------------------------------------------------
-// Nop...
+#define DEFERRED_TEXTURE_ID    0x80000000   // Marks the ids of textures waiting in a DeferredUpload, the rest of the id is the image index
 
 //----------------------------------------------------------------------------------
 // Types and Structures Definition
@@ -151,7 +151,8 @@
 //----------------------------------------------------------------------------------
 // Global Variables Definition
 //----------------------------------------------------------------------------------
-// It's lonely here...
+static THREAD_LOCAL bool uploadDeferred = false;            // Textures and meshes made on this thread are left for the main thread to upload
+static THREAD_LOCAL DeferredUpload deferredUpload = { 0 };  // Images of the textures made on this thread while deferred
 
 //----------------------------------------------------------------------------------
 // Other Modules Functions Declaration (required by text)
@@ -410,7 +411,15 @@ Texture2D LoadTextureFromImage(Image image)
 
     if ((image.data != NULL) && (image.width != 0) && (image.height != 0))
     {
-        texture.id = rlLoadTexture(image.data, image.width, image.height, image.format, image.mipmaps);
+        if (uploadDeferred)
+        {
+            // Keep a copy of the image, the caller unloads theirs
+            deferredUpload.images = (Image *)RL_REALLOC(deferredUpload.images, (deferredUpload.count + 1)*sizeof(Image));
+            deferredUpload.images[deferredUpload.count] = ImageCopy(image);
+            texture.id = DEFERRED_TEXTURE_ID | deferredUpload.count;
+            deferredUpload.count++;
+        }
+        else texture.id = rlLoadTexture(image.data, image.width, image.height, image.format, image.mipmaps);
     }
     else TraceLog(LOG_WARNING, "Texture could not be loaded from Image");
 
@@ -422,6 +431,50 @@ Texture2D LoadTextureFromImage(Image image)
     return texture;
 }
 
+// Defer the GPU upload of the textures and meshes made on this thread, so assets can be loaded on other threads
+// NOTE: Textures get a placeholder id until UploadDeferredTexture() is called on the main thread
+void BeginDeferredUpload(void)
+{
+    uploadDeferred = true;
+    deferredUpload = (DeferredUpload){ 0 };
+}
+
+// Stop deferring uploads on this thread, returns the images of the textures made since BeginDeferredUpload()
+DeferredUpload EndDeferredUpload(void)
+{
+    DeferredUpload upload = deferredUpload;
+
+    uploadDeferred = false;
+    deferredUpload = (DeferredUpload){ 0 };
+
+    return upload;
+}
+
+// Check if the uploads of this thread are being deferred
+bool IsUploadDeferred(void)
+{
+    return uploadDeferred;
+}
+
+// Upload a texture made while uploads were deferred, textures that were not deferred are left as they are
+void UploadDeferredTexture(Texture2D *texture, DeferredUpload upload)
+{
+    if ((texture->id & DEFERRED_TEXTURE_ID) == 0) return;
+
+    unsigned int index = texture->id & ~DEFERRED_TEXTURE_ID;
+
+    if ((int)index < upload.count) *texture = LoadTextureFromImage(upload.images[index]);
+    else texture->id = 0;
+}
+
+// Unload the images of a deferred upload, once its textures have been uploaded or discarded
+void UnloadDeferredUpload(DeferredUpload upload)
+{
+    for (int i = 0; i < upload.count; i++) UnloadImage(upload.images[i]);
+
+    RL_FREE(upload.images);
+}
+
 // Load texture for rendering (framebuffer)
 // NOTE: Render texture is loaded by default with RGBA color attachment and depth RenderBuffer
 RenderTexture2D LoadRenderTexture(int width, int height)
@@ -493,9 +546,9 @@ Color *GetImageData(Image image)
                 {
                     unsigned short pixel = ((unsigned short *)image.data)[i];
 
-                    pixels[i].r = (unsigned char)((float)((pixel & 0b1111100000000000) >> 11)*(255/31));
-                    pixels[i].g = (unsigned char)((float)((pixel & 0b0000011111000000) >> 6)*(255/31));
-                    pixels[i].b = (unsigned char)((float)((pixel & 0b0000000000111110) >> 1)*(255/31));
+                    pixels[i].r = (unsigned char)(((pixel & 0b1111100000000000) >> 11)*255/31);
+                    pixels[i].g = (unsigned char)(((pixel & 0b0000011111000000) >> 6)*255/31);
+                    pixels[i].b = (unsigned char)(((pixel & 0b0000000000111110) >> 1)*255/31);
                     pixels[i].a = (unsigned char)((pixel & 0b0000000000000001)*255);
 
                 } break;
@@ -503,9 +556,9 @@ Color *GetImageData(Image image)
                 {
                     unsigned short pixel = ((unsigned short *)image.data)[i];
 
-                    pixels[i].r = (unsigned char)((float)((pixel & 0b1111100000000000) >> 11)*(255/31));
-                    pixels[i].g = (unsigned char)((float)((pixel & 0b0000011111100000) >> 5)*(255/63));
-                    pixels[i].b = (unsigned char)((float)(pixel & 0b0000000000011111)*(255/31));
+                    pixels[i].r = (unsigned char)(((pixel & 0b1111100000000000) >> 11)*255/31);
+                    pixels[i].g = (unsigned char)(((pixel & 0b0000011111100000) >> 5)*255/63);
+                    pixels[i].b = (unsigned char)((pixel & 0b0000000000011111)*255/31);
                     pixels[i].a = 255;
 
                 } break;
@@ -513,10 +566,10 @@ Color *GetImageData(Image image)
                 {
                     unsigned short pixel = ((unsigned short *)image.data)[i];
 
-                    pixels[i].r = (unsigned char)((float)((pixel & 0b1111000000000000) >> 12)*(255/15));
-                    pixels[i].g = (unsigned char)((float)((pixel & 0b0000111100000000) >> 8)*(255/15));
-                    pixels[i].b = (unsigned char)((float)((pixel & 0b0000000011110000) >> 4)*(255/15));
-                    pixels[i].a = (unsigned char)((float)(pixel & 0b0000000000001111)*(255/15));
+                    pixels[i].r = (unsigned char)(((pixel & 0b1111000000000000) >> 12)*255/15);
+                    pixels[i].g = (unsigned char)(((pixel & 0b0000111100000000) >> 8)*255/15);
+                    pixels[i].b = (unsigned char)(((pixel & 0b0000000011110000) >> 4)*255/15);
+                    pixels[i].a = (unsigned char)((pixel & 0b0000000000001111)*255/15);
 
                 } break;
                 case UNCOMPRESSED_R8G8B8A8:
@@ -539,9 +592,10 @@ Color *GetImageData(Image image)
                 } break;
                 case UNCOMPRESSED_R32:
                 {
-                    pixels[i].r = (unsigned char)(((float *)image.data)[k]*255.0f);
-                    pixels[i].g = 0;
-                    pixels[i].b = 0;
+                    // NOTE: R32 is a grayscale format, like ImageFormat() converts to it
+                    pixels[i].r = (unsigned char)(((float *)image.data)[i]*255.0f);
+                    pixels[i].g = pixels[i].r;
+                    pixels[i].b = pixels[i].r;
                     pixels[i].a = 255;
 
                 } break;
@@ -557,9 +611,9 @@ Color *GetImageData(Image image)
                 case UNCOMPRESSED_R32G32B32A32:
                 {
                     pixels[i].r = (unsigned char)(((float *)image.data)[k]*255.0f);
-                    pixels[i].g = (unsigned char)(((float *)image.data)[k]*255.0f);
-                    pixels[i].b = (unsigned char)(((float *)image.data)[k]*255.0f);
-                    pixels[i].a = (unsigned char)(((float *)image.data)[k]*255.0f);
+                    pixels[i].g = (unsigned char)(((float *)image.data)[k + 1]*255.0f);
+                    pixels[i].b = (unsigned char)(((float *)image.data)[k + 2]*255.0f);
+                    pixels[i].a = (unsigned char)(((float *)image.data)[k + 3]*255.0f);
 
                     k += 4;
                 } break;
@@ -650,9 +704,10 @@ Vector4 *GetImageDataNormalized(Image image)
                 } break;
                 case UNCOMPRESSED_R32:
                 {
-                    pixels[i].x = ((float *)image.data)[k];
-                    pixels[i].y = 0.0f;
-                    pixels[i].z = 0.0f;
+                    // NOTE: R32 is a grayscale format, like ImageFormat() converts to it
+                    pixels[i].x = ((float *)image.data)[i];
+                    pixels[i].y = pixels[i].x;
+                    pixels[i].z = pixels[i].x;
                     pixels[i].w = 1.0f;
 
                 } break;
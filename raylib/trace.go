@@ -3,19 +3,36 @@ package raylib
 /*
 #include "raylib.h"
 #include <stdlib.h>
+#include <stdio.h>
+#include <stdarg.h>
 #ifndef GO_TRACE
 #define GO_TRACE
 
-#define MAX_TRACELOG_BUFFER_SIZE   128  // Max length of one trace-log message. From raylib/utils.c
-
-void Go_TraceLogWrapper(int logType, const char *text) {
-  TraceLog(logType, text);
-}
+#define GO_TRACELOG_BUFFER_SIZE   512     // Most messages fit on the stack
+#define GO_TRACELOG_MAX_SIZE      65536   // Longer messages are truncated to this
 
+// Formats with vsnprintf, so a message can never write past its buffer. Messages that do not fit
+// on the stack are formatted again into a buffer of the right size.
 void Go_CustomCallbackHook(int logType, const char *text, va_list args) {
-  char buffer[MAX_TRACELOG_BUFFER_SIZE] = { 0 };
-  vsprintf (buffer, text, args);
-  onTraceCallback(logType, buffer);
+  char buffer[GO_TRACELOG_BUFFER_SIZE] = { 0 };
+  va_list copy;
+  va_copy(copy, args);
+
+  int length = vsnprintf(buffer, GO_TRACELOG_BUFFER_SIZE, text, args);
+  if (length >= GO_TRACELOG_BUFFER_SIZE) {
+    size_t size = length < GO_TRACELOG_MAX_SIZE ? (size_t)length + 1 : GO_TRACELOG_MAX_SIZE;
+    char *large = (char *)malloc(size);
+    if (large != NULL) {
+      vsnprintf(large, size, text, copy);
+      va_end(copy);
+      onTraceCallback(logType, large);
+      free(large);
+      return;
+    }
+  }
+
+  va_end(copy);
+  onTraceCallback(logType, length < 0 ? (char *)text : buffer);
 }
 
 void Go_EnableCustomCallback() {
@@ -26,12 +43,22 @@ void Go_DisableCustomCallback() {
   SetTraceLogCallback(NULL);
 }
 
+// Logs the text through raylib, so it is formatted by the hook like the logs of raylib are
+void Go_TraceLogText(int logType, const char *text) {
+  TraceLog(logType, "%s", text);
+}
+
 
 #endif
 */
 import "C"
 import (
 	"fmt"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
 	"unsafe"
 )
 
@@ -39,52 +66,280 @@ var logLevelType TraceLogType = LogInfo
 var logLevelExit TraceLogType = LogError
 var traceCallback func(logType TraceLogType, text string)
 
+//traceSubsystemLevels are the levels set with SetTraceLogSubsystemLevel, by subsystem
+var traceSubsystemLevels = make(map[string]TraceLogType)
+
+//traceListeners see every log that is output, alongside the callback. ie: the Console
+var traceListeners []traceListener
+
+//traceListener is told about every log that passes the levels
+type traceListener interface {
+	onTrace(logType TraceLogType, text string)
+}
+
+//traceMutex guards the levels, the callback, the listeners and the per thread state below, as raylib and TraceLog can log from any thread.
+// It is never held while a log is output, so the callback and listeners can log themselves.
+var traceMutex sync.Mutex
+
+//traceCaptures collect the warnings raylib logs on each thread while captureTraceWarnings is running on it
+var traceCaptures = make(map[uintptr]*[]string)
+
+//traceDeferredThreads are the threads whose logs are held until flushTraceLogs, so the callback and listeners only run on the main thread
+var traceDeferredThreads = make(map[uintptr]bool)
+
+//traceDeferred are the logs held from the deferred threads
+var traceDeferred []deferredTrace
+
+type deferredTrace struct {
+	logType TraceLogType
+	text    string
+}
+
 //SetTraceLogLevel : Set the current threshold (minimum) log level
 func SetTraceLogLevel(logType TraceLogType) {
+	traceMutex.Lock()
 	logLevelType = logType
-	C.SetTraceLogLevel(C.int(logType))
+	traceMutex.Unlock()
+	updateTraceHook()
 }
 
 //SetTraceLogExit : Set the exit threshold (minimum) log level
 // Set to NONE to disable this. (none is above all log levels).
 func SetTraceLogExit(logType TraceLogType) {
+	traceMutex.Lock()
 	logLevelExit = logType
+	traceMutex.Unlock()
 	C.SetTraceLogExit(C.int(logType))
 }
 
 //SetTraceLogCallback : Set the callback for custom logging.
+// It is called on the goroutine that logged, except for the logs of the AssetLoader workers which are passed on by the main thread.
 func SetTraceLogCallback(callback func(logType TraceLogType, text string)) {
+	traceMutex.Lock()
 	traceCallback = callback
-	if traceCallback != nil {
+	traceMutex.Unlock()
+	updateTraceHook()
+}
+
+//SetTraceLogSubsystemLevel sets the threshold (minimum) log level of a subsystem, which is used instead of the SetTraceLogLevel one.
+// The subsystem is the tag at the start of the message, ie: TEXTURE for "TEXTURE: [ID 3] Texture created successfully" and UNLOAD for "[UNLOAD] Unloading scope".
+func SetTraceLogSubsystemLevel(subsystem string, logType TraceLogType) {
+	traceMutex.Lock()
+	traceSubsystemLevels[strings.ToUpper(subsystem)] = logType
+	traceMutex.Unlock()
+	updateTraceHook()
+}
+
+//ClearTraceLogSubsystemLevel makes the subsystem use the SetTraceLogLevel level again
+func ClearTraceLogSubsystemLevel(subsystem string) {
+	traceMutex.Lock()
+	delete(traceSubsystemLevels, strings.ToUpper(subsystem))
+	traceMutex.Unlock()
+	updateTraceHook()
+}
+
+//updateTraceHook sets the C level and hooks our callback in when we need to see the messages ourselves.
+// The C level is the lowest level any subsystem wants, the messages are then filtered again by traceEnabled.
+func updateTraceHook() {
+	traceMutex.Lock()
+	level := logLevelType
+	for _, subsystemLevel := range traceSubsystemLevels {
+		if subsystemLevel < level {
+			level = subsystemLevel
+		}
+	}
+	subsystems, capturing, deferring := len(traceSubsystemLevels) > 0, len(traceCaptures) > 0, len(traceDeferredThreads) > 0
+	output := traceCallback != nil || len(traceListeners) > 0
+	traceMutex.Unlock()
+
+	//The C callback only sees logs above the C level, so make sure the warnings get through.
+	if capturing && level > LogWarning {
+		level = LogWarning
+	}
+
+	C.SetTraceLogLevel(C.int(level))
+	if output || capturing || deferring || subsystems {
 		C.Go_EnableCustomCallback()
 	} else {
 		C.Go_DisableCustomCallback()
 	}
 }
 
+//traceEnabled returns true if the message is above the level of its subsystem
+func traceEnabled(logType TraceLogType, subsystem string) bool {
+	traceMutex.Lock()
+	defer traceMutex.Unlock()
+	level, ok := traceSubsystemLevels[subsystem]
+	if !ok {
+		level = logLevelType
+	}
+	return logType >= level
+}
+
+//traceMessage is a log split into its parts
+type traceMessage struct {
+	subsystem string
+	id        int
+	text      string
+}
+
+var (
+	reTraceSubsystem = regexp.MustCompile(`^(?:\[([A-Z][A-Z0-9_]*)\]|([A-Z][A-Z0-9_]*):)\s*`)
+	reTraceID        = regexp.MustCompile(`^\[ID (\d+)\]\s*`)
+)
+
+//parseTraceMessage splits the subsystem tag and object ID from the start of a log, ie: "TEXTURE: [ID 3] Texture created successfully".
+// The id is -1 if the message does not have one.
+func parseTraceMessage(text string) traceMessage {
+	message := traceMessage{id: -1, text: text}
+	if match := reTraceSubsystem.FindStringSubmatch(message.text); match != nil {
+		message.subsystem = match[1] + match[2]
+		message.text = message.text[len(match[0]):]
+	}
+	if match := reTraceID.FindStringSubmatch(message.text); match != nil {
+		message.id, _ = strconv.Atoi(match[1])
+		message.text = message.text[len(match[0]):]
+	}
+	return message
+}
+
+//traceLogRaylib logs the text through TraceLog of raylib, instead of straight to dispatchTrace like TraceLog does
+func traceLogRaylib(logType TraceLogType, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+	C.Go_TraceLogText(C.int(logType), ctext)
+}
+
+//captureTraceWarnings runs fn and returns the warnings and errors raylib logged during it.
+// The logs are still passed on to the callback (or printed) as normal. Only the logs of this goroutine are captured.
+func captureTraceWarnings(fn func()) []string {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	thread := currentThread()
+
+	warnings := make([]string, 0)
+	traceMutex.Lock()
+	previous := traceCaptures[thread]
+	traceCaptures[thread] = &warnings
+	traceMutex.Unlock()
+	if previous == nil {
+		updateTraceHook()
+	}
+
+	fn()
+
+	traceMutex.Lock()
+	if previous == nil {
+		delete(traceCaptures, thread)
+	} else {
+		traceCaptures[thread] = previous
+	}
+	traceMutex.Unlock()
+	if previous == nil {
+		updateTraceHook()
+	}
+	return warnings
+}
+
+//deferTraceLogs holds the logs of this thread until flushTraceLogs, or passes them on straight away again.
+// The goroutine must be locked to the thread.
+func deferTraceLogs(deferred bool) {
+	thread := currentThread()
+	traceMutex.Lock()
+	if deferred {
+		traceDeferredThreads[thread] = true
+	} else {
+		delete(traceDeferredThreads, thread)
+	}
+	traceMutex.Unlock()
+	updateTraceHook()
+}
+
+//flushTraceLogs passes on the logs held from the deferred threads. It is called on the main thread.
+func flushTraceLogs() {
+	traceMutex.Lock()
+	logs := traceDeferred
+	traceDeferred = nil
+	traceMutex.Unlock()
+
+	for _, log := range logs {
+		if traceEnabled(log.logType, parseTraceMessage(log.text).subsystem) {
+			traceOutput(log.logType, log.text)
+			tracePanicCheck(log.logType, log.text)
+		}
+	}
+}
+
 //TraceLog creates a new log with a particular type. If a custom callback for logs
-// is set, then it will directly invoke it, avoiding the raylib function.
+// is set, then it will directly invoke it, otherwise it is printed the way raylib prints its logs.
+// The message is never formatted by C, so it can be any length and contain any characters.
+// It goes through the same steps as the logs of raylib, so it is captured by the loaders and held from the AssetLoader workers too.
 func TraceLog(logType TraceLogType, a ...interface{}) {
-	line := fmt.Sprint(a...)
-	if traceCallback != nil {
+	dispatchTrace(logType, fmt.Sprint(a...))
+}
+
+//dispatchTrace passes on a log of raylib or TraceLog. It is recorded if a loader on this thread is capturing the warnings,
+// and held for flushTraceLogs if this thread is deferred. Otherwise it is output if it is above the level of its subsystem.
+func dispatchTrace(logType TraceLogType, text string) {
+	thread := currentThread()
+
+	traceMutex.Lock()
+	if capture := traceCaptures[thread]; capture != nil && logType >= LogWarning {
+		*capture = append(*capture, text)
+	}
+	if traceDeferredThreads[thread] {
+		traceDeferred = append(traceDeferred, deferredTrace{logType, text})
+		traceMutex.Unlock()
+		return
+	}
+	traceMutex.Unlock()
+
+	//The C level may have been lowered to capture the warnings, or for a subsystem
+	if !traceEnabled(logType, parseTraceMessage(text).subsystem) {
+		return
+	}
 
-		//Make sure we are the correct level. Since we are doing this ourselves,
-		// we need to keep track of this
-		if logType < logLevelType {
-			return
+	traceOutput(logType, text)
+	tracePanicCheck(logType, text)
+}
+
+//addTraceListener starts telling the listener about the logs. It is safe to call from any thread.
+func addTraceListener(listener traceListener) {
+	traceMutex.Lock()
+	traceListeners = append(traceListeners[:len(traceListeners):len(traceListeners)], listener)
+	traceMutex.Unlock()
+	updateTraceHook()
+}
+
+//removeTraceListener stops telling the listener about the logs. It is safe to call from any thread.
+func removeTraceListener(listener traceListener) {
+	traceMutex.Lock()
+	for i, l := range traceListeners {
+		if l == listener {
+			//Make a new slice, as traceOutput may be going through the old one
+			listeners := make([]traceListener, 0, len(traceListeners)-1)
+			traceListeners = append(append(listeners, traceListeners[:i]...), traceListeners[i+1:]...)
+			break
 		}
+	}
+	traceMutex.Unlock()
+	updateTraceHook()
+}
 
-		//We have a custom tracer, so lets just use that and avoid the C end entirely.
-		//Note that since we are avoiding the C calls, we have to tracePanicCheck again.
-		traceCallback(logType, line)
-		tracePanicCheck(logType, line)
-	} else {
+//traceOutput sends the log to the listeners and the callback, or prints it if there is no callback.
+// They are called without the lock, so they can log or add listeners themselves.
+func traceOutput(logType TraceLogType, text string) {
+	traceMutex.Lock()
+	listeners, callback := traceListeners, traceCallback
+	traceMutex.Unlock()
 
-		//We dont have a custom tracer yet, so we will just execute our wrapper over it.
-		// We have a wrapper because cgo doesn't support variable length arguments.
-		cline := C.CString(line)
-		defer C.free(unsafe.Pointer(cline))
-		C.Go_TraceLogWrapper(C.int(logType), cline)
+	for _, listener := range listeners {
+		listener.onTrace(logType, text)
+	}
+	if callback != nil {
+		callback(logType, text)
+	} else {
+		fmt.Println(strings.ToUpper(logType.ToString()) + ": " + text)
 	}
 }
 
@@ -117,7 +372,10 @@ func TraceFatal(a ...interface{}) {
 func tracePanicCheck(logType TraceLogType, message string) {
 	//If the log is greater than the exit log, then we need to exit too.
 	// (this is normally handled on the C function, but since we overridden it, we need to do it manually.)
-	if logType >= logLevelExit && logLevelExit != LogNone {
+	traceMutex.Lock()
+	exit := logLevelExit
+	traceMutex.Unlock()
+	if logType >= exit && exit != LogNone {
 		panic(message)
 		//os.Exit(1)
 	}
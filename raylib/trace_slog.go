@@ -0,0 +1,75 @@
+package raylib
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	//TraceSubsystemKey is the attribute key of the subsystem tag, ie: TEXTURE for "TEXTURE: [ID 3] Texture created successfully"
+	TraceSubsystemKey = "subsystem"
+	//TraceIDKey is the attribute key of the object ID, ie: 3 for "TEXTURE: [ID 3] Texture created successfully"
+	TraceIDKey = "id"
+)
+
+//Levels for the trace log types that slog does not have
+const (
+	SlogLevelTrace = slog.LevelDebug - 4
+	SlogLevelFatal = slog.LevelError + 4
+)
+
+//SlogLevel converts the TraceLogType to the slog level. Trace and Fatal are SlogLevelTrace and SlogLevelFatal.
+func (v TraceLogType) SlogLevel() slog.Level {
+	switch v {
+	case LogAll, LogTrace:
+		return SlogLevelTrace
+	case LogDebug:
+		return slog.LevelDebug
+	case LogInfo:
+		return slog.LevelInfo
+	case LogWarning:
+		return slog.LevelWarn
+	case LogError:
+		return slog.LevelError
+	default:
+		return SlogLevelFatal
+	}
+}
+
+//SetTraceLogHandler sends the raylib and binding logs to the handler instead of printing them. It replaces the SetTraceLogCallback callback.
+// The subsystem tag and object ID are taken off the start of the message and added as the TraceSubsystemKey and TraceIDKey attributes.
+// The logs still have to be above the SetTraceLogLevel (or SetTraceLogSubsystemLevel) level before they reach the handler. Nil stops sending them.
+func SetTraceLogHandler(handler slog.Handler) {
+	if handler == nil {
+		SetTraceLogCallback(nil)
+		return
+	}
+
+	SetTraceLogCallback(func(logType TraceLogType, text string) {
+		ctx := context.Background()
+		level := logType.SlogLevel()
+		if !handler.Enabled(ctx, level) {
+			return
+		}
+
+		message := parseTraceMessage(text)
+		record := slog.NewRecord(time.Now(), level, message.text, 0)
+		if message.subsystem != "" {
+			record.AddAttrs(slog.String(TraceSubsystemKey, message.subsystem))
+		}
+		if message.id >= 0 {
+			record.AddAttrs(slog.Int(TraceIDKey, message.id))
+		}
+		handler.Handle(ctx, record)
+	})
+}
+
+//SetTraceLogLogger sends the raylib and binding logs to the logger. See SetTraceLogHandler
+func SetTraceLogLogger(logger *slog.Logger) {
+	if logger == nil {
+		SetTraceLogHandler(nil)
+		return
+	}
+	SetTraceLogHandler(logger.Handler())
+}
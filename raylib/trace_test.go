@@ -0,0 +1,279 @@
+package raylib
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+//testTraceListener counts the logs it is told about
+type testTraceListener struct {
+	mutex sync.Mutex
+	count int
+}
+
+func (l *testTraceListener) onTrace(logType TraceLogType, text string) {
+	l.mutex.Lock()
+	l.count++
+	l.mutex.Unlock()
+}
+
+//testTraceCallback records the logs, and is reset when the test ends
+func testTraceCallback(t *testing.T) *[]string {
+	var mutex sync.Mutex
+	logs := make([]string, 0)
+	SetTraceLogCallback(func(logType TraceLogType, text string) {
+		mutex.Lock()
+		logs = append(logs, text)
+		mutex.Unlock()
+	})
+	t.Cleanup(func() { SetTraceLogCallback(nil) })
+	return &logs
+}
+
+func TestTraceLogIsCaptured(t *testing.T) {
+	logs := testTraceCallback(t)
+	warnings := captureTraceWarnings(func() {
+		TraceLog(LogInfo, "[TEST] not a warning")
+		TraceLog(LogWarning, "[TEST] captured")
+	})
+	if len(warnings) != 1 || warnings[0] != "[TEST] captured" {
+		t.Fatalf("captured %q", warnings)
+	}
+	if len(*logs) != 2 {
+		t.Fatalf("captured logs are not passed on: %q", *logs)
+	}
+}
+
+func TestTraceLogIsHeldOnWorkers(t *testing.T) {
+	logs := testTraceCallback(t)
+
+	done := make(chan struct{})
+	go func() {
+		beginWorkerThread()
+		defer endWorkerThread()
+		TraceLog(LogWarning, "[TEST] from a worker")
+		close(done)
+	}()
+	<-done
+
+	if len(*logs) != 0 {
+		t.Fatalf("the worker called the callback with %q", *logs)
+	}
+	flushTraceLogs()
+	if len(*logs) != 1 || (*logs)[0] != "[TEST] from a worker" {
+		t.Fatalf("flushed %q", *logs)
+	}
+}
+
+func TestTraceListenersFromManyGoroutines(t *testing.T) {
+	SetTraceLogCallback(func(logType TraceLogType, text string) {})
+	defer SetTraceLogCallback(nil)
+
+	var wait sync.WaitGroup
+	listeners := make([]*testTraceListener, 8)
+	for i := range listeners {
+		listeners[i] = &testTraceListener{}
+		wait.Add(2)
+		go func(listener *testTraceListener) {
+			defer wait.Done()
+			for j := 0; j < 50; j++ {
+				addTraceListener(listener)
+				removeTraceListener(listener)
+			}
+			addTraceListener(listener)
+		}(listeners[i])
+		go func() {
+			defer wait.Done()
+			for j := 0; j < 50; j++ {
+				TraceLog(LogInfo, "[TEST] ", j)
+			}
+		}()
+	}
+	wait.Wait()
+
+	//every listener is still there once, and sees the next log
+	TraceLog(LogInfo, "[TEST] last")
+	for i, listener := range listeners {
+		listener.mutex.Lock()
+		count := listener.count
+		listener.mutex.Unlock()
+		if count == 0 {
+			t.Errorf("listener %d did not see the last log", i)
+		}
+		removeTraceListener(listener)
+	}
+	if len(traceListeners) != 0 {
+		t.Fatalf("%d listeners are left", len(traceListeners))
+	}
+}
+
+func TestParseTraceMessage(t *testing.T) {
+	tests := []struct {
+		text      string
+		subsystem string
+		id        int
+		message   string
+	}{
+		{"TEXTURE: [ID 3] Texture created successfully", "TEXTURE", 3, "Texture created successfully"},
+		{"[UNLOAD] Unloading scope", "UNLOAD", -1, "Unloading scope"},
+		{"[ID 12] has no tag", "", 12, "has no tag"},
+		{"GL_EXT: extension", "GL_EXT", -1, "extension"},
+		{"Initializing raylib 3.0", "", -1, "Initializing raylib 3.0"},
+		{"texture: lower case is not a tag", "", -1, "texture: lower case is not a tag"},
+		{"[ID x] not an id", "", -1, "[ID x] not an id"},
+	}
+	for _, test := range tests {
+		message := parseTraceMessage(test.text)
+		if message.subsystem != test.subsystem || message.id != test.id || message.text != test.message {
+			t.Errorf("%q parsed as %+v", test.text, message)
+		}
+	}
+}
+
+func TestTraceLogSubsystemLevel(t *testing.T) {
+	logs := testTraceCallback(t)
+	SetTraceLogSubsystemLevel("unload", LogWarning)
+	SetTraceLogSubsystemLevel("SHADER", LogTrace)
+	t.Cleanup(func() {
+		ClearTraceLogSubsystemLevel("UNLOAD")
+		ClearTraceLogSubsystemLevel("shader")
+	})
+
+	TraceLog(LogInfo, "[UNLOAD] below its level")
+	TraceLog(LogWarning, "[UNLOAD] at its level")
+	TraceLog(LogDebug, "SHADER: above its level")
+	TraceLog(LogDebug, "TEXTURE: below the default level")
+	TraceLog(LogInfo, "TEXTURE: at the default level")
+	//the logs of raylib are filtered the same, even though the C level is lowered for the shaders
+	traceLogRaylib(LogDebug, "SHADER: [ID 2] from raylib")
+	traceLogRaylib(LogDebug, "MODEL: from raylib")
+
+	want := "[UNLOAD] at its level|SHADER: above its level|TEXTURE: at the default level|SHADER: [ID 2] from raylib"
+	if got := strings.Join(*logs, "|"); got != want {
+		t.Fatalf("logged %q", got)
+	}
+
+	//clearing the level uses the default again
+	ClearTraceLogSubsystemLevel("unload")
+	*logs = (*logs)[:0]
+	TraceLog(LogInfo, "[UNLOAD] at the default level")
+	if len(*logs) != 1 {
+		t.Fatalf("logged %q", *logs)
+	}
+}
+
+func TestTraceLogLongMessages(t *testing.T) {
+	logs := testTraceCallback(t)
+
+	//raylib formats its logs in C, and messages longer than the stack buffer are formatted again on the heap
+	long := strings.Repeat("abcdefghij", 200) + "|end"
+	traceLogRaylib(LogWarning, "TEXTURE: [ID 7] "+long)
+	if len(*logs) != 1 || (*logs)[0] != "TEXTURE: [ID 7] "+long {
+		t.Fatalf("the long log was %d bytes", len((*logs)[0]))
+	}
+
+	//just past the stack buffer, which has the terminator as its last byte
+	edge := strings.Repeat("x", 512)
+	traceLogRaylib(LogWarning, edge[:511])
+	traceLogRaylib(LogWarning, edge)
+	if len(*logs) != 3 || (*logs)[1] != edge[:511] || (*logs)[2] != edge {
+		t.Fatal("the logs at the size of the stack buffer were cut")
+	}
+
+	//and are cut off at the largest size
+	traceLogRaylib(LogWarning, strings.Repeat("y", 100000))
+	if len(*logs) != 4 || (*logs)[3] != strings.Repeat("y", 65535) {
+		t.Fatalf("the huge log was %d bytes", len((*logs)[3]))
+	}
+}
+
+//testSlogHandler records the logs it handles above its level
+type testSlogHandler struct {
+	level   slog.Level
+	records []slog.Record
+}
+
+func (h *testSlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *testSlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	h.records = append(h.records, record)
+	return nil
+}
+
+func (h *testSlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler { return h }
+func (h *testSlogHandler) WithGroup(name string) slog.Handler       { return h }
+
+func TestTraceLogHandler(t *testing.T) {
+	levels := map[TraceLogType]slog.Level{
+		LogAll:     SlogLevelTrace,
+		LogTrace:   SlogLevelTrace,
+		LogDebug:   slog.LevelDebug,
+		LogInfo:    slog.LevelInfo,
+		LogWarning: slog.LevelWarn,
+		LogError:   slog.LevelError,
+		LogFatal:   SlogLevelFatal,
+		LogNone:    SlogLevelFatal,
+	}
+	for logType, level := range levels {
+		if logType.SlogLevel() != level {
+			t.Errorf("%s is %v, not %v", logType.ToString(), logType.SlogLevel(), level)
+		}
+	}
+
+	handler := &testSlogHandler{level: slog.LevelDebug}
+	SetTraceLogHandler(handler)
+	SetTraceLogLevel(LogAll)
+	t.Cleanup(func() {
+		SetTraceLogHandler(nil)
+		SetTraceLogLevel(LogInfo)
+	})
+
+	TraceLog(LogWarning, "TEXTURE: [ID 4] Texture loaded")
+	TraceLog(LogTrace, "below the level of the handler")
+	TraceLog(LogDebug, "[UNLOAD] Unloading scope")
+	traceLogRaylib(LogInfo, "[ID 9] from raylib")
+
+	type attrs map[string]any
+	want := []struct {
+		level   slog.Level
+		message string
+		attrs   attrs
+	}{
+		{slog.LevelWarn, "Texture loaded", attrs{TraceSubsystemKey: "TEXTURE", TraceIDKey: int64(4)}},
+		{slog.LevelDebug, "Unloading scope", attrs{TraceSubsystemKey: "UNLOAD"}},
+		{slog.LevelInfo, "from raylib", attrs{TraceIDKey: int64(9)}},
+	}
+	if len(handler.records) != len(want) {
+		t.Fatalf("handled %d logs", len(handler.records))
+	}
+	for i, record := range handler.records {
+		got := attrs{}
+		record.Attrs(func(attr slog.Attr) bool {
+			got[attr.Key] = attr.Value.Any()
+			return true
+		})
+		if record.Level != want[i].level || record.Message != want[i].message || len(got) != len(want[i].attrs) {
+			t.Errorf("log %d is %v %q with %v", i, record.Level, record.Message, got)
+			continue
+		}
+		for key, value := range want[i].attrs {
+			if got[key] != value {
+				t.Errorf("log %d has %s=%v, not %v", i, key, got[key], value)
+			}
+		}
+	}
+
+	//nil stops sending the logs to the handler
+	SetTraceLogHandler(nil)
+	traceMutex.Lock()
+	callback := traceCallback
+	traceMutex.Unlock()
+	if callback != nil {
+		t.Fatal("the handler is still the callback")
+	}
+}
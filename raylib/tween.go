@@ -0,0 +1,337 @@
+package raylib
+
+//Tween animates a value over a duration with an easing function.
+// Tweens are driven by calling Update with the time that has passed (or Tick, which uses GetFrameTime), so they are deterministic for a given dt.
+// The setters return the tween so they can be chained:
+//	r.TweenVector2(&pos, target, 0.5, r.EaseQuadOut).SetDelay(1).SetYoyo(true).SetRepeat(-1)
+type Tween struct {
+	duration float32
+	delay    float32
+	ease     EasingFunction
+	repeat   int
+	yoyo     bool
+
+	apply      func(amount float32)
+	onStart    func()
+	onUpdate   func()
+	onComplete func()
+
+	delayLeft float32
+	elapsed   float32
+	played    int
+	started   bool
+	reversed  bool
+	done      bool
+}
+
+//NewTween creates a tween that calls apply every update with the eased amount, from 0 to 1 (which may overshoot, depending on the easing).
+// A nil ease is linear.
+func NewTween(duration float32, ease EasingFunction, apply func(amount float32)) *Tween {
+	if ease == nil {
+		ease = EaseLinearNone
+	}
+	return &Tween{duration: duration, ease: ease, apply: apply}
+}
+
+//TweenFloat32 animates the value to the target. The starting value is read when the tween starts (after its delay).
+func TweenFloat32(value *float32, target float32, duration float32, ease EasingFunction) *Tween {
+	var from float32
+	tween := NewTween(duration, ease, func(amount float32) { *value = from + (target-from)*amount })
+	tween.onStart = func() { from = *value }
+	return tween
+}
+
+//TweenVector2 animates the vector to the target. The starting value is read when the tween starts (after its delay).
+func TweenVector2(value *Vector2, target Vector2, duration float32, ease EasingFunction) *Tween {
+	var from Vector2
+	tween := NewTween(duration, ease, func(amount float32) { *value = from.Lerp(target, amount) })
+	tween.onStart = func() { from = *value }
+	return tween
+}
+
+//TweenVector3 animates the vector to the target. The starting value is read when the tween starts (after its delay).
+func TweenVector3(value *Vector3, target Vector3, duration float32, ease EasingFunction) *Tween {
+	var from Vector3
+	tween := NewTween(duration, ease, func(amount float32) { *value = from.Lerp(target, amount) })
+	tween.onStart = func() { from = *value }
+	return tween
+}
+
+//TweenColor animates the color to the target in RGB. The starting value is read when the tween starts (after its delay).
+func TweenColor(value *Color, target Color, duration float32, ease EasingFunction) *Tween {
+	var from Color
+	tween := NewTween(duration, ease, func(amount float32) { *value = from.Lerp(target, amount) })
+	tween.onStart = func() { from = *value }
+	return tween
+}
+
+//TweenColorHSV animates the color to the target in HSV, which keeps the colors in between saturated. The starting value is read when the tween starts (after its delay).
+func TweenColorHSV(value *Color, target Color, duration float32, ease EasingFunction) *Tween {
+	var from Color
+	tween := NewTween(duration, ease, func(amount float32) { *value = from.LerpHSV(target, amount) })
+	tween.onStart = func() { from = *value }
+	return tween
+}
+
+//TweenRectangle animates the rectangle to the target. The starting value is read when the tween starts (after its delay).
+func TweenRectangle(value *Rectangle, target Rectangle, duration float32, ease EasingFunction) *Tween {
+	var from Rectangle
+	tween := NewTween(duration, ease, func(amount float32) { *value = from.Lerp(target, amount) })
+	tween.onStart = func() { from = *value }
+	return tween
+}
+
+//SetDelay sets how many seconds to wait before the tween starts
+func (tween *Tween) SetDelay(seconds float32) *Tween {
+	tween.delay = seconds
+	tween.delayLeft = seconds
+	return tween
+}
+
+//SetRepeat sets how many more times the tween plays after the first. -1 repeats forever.
+func (tween *Tween) SetRepeat(count int) *Tween {
+	tween.repeat = count
+	return tween
+}
+
+//SetYoyo makes every other repeat play backwards
+func (tween *Tween) SetYoyo(yoyo bool) *Tween {
+	tween.yoyo = yoyo
+	return tween
+}
+
+//OnUpdate sets the function called every update the tween is playing, once the value has been set. It is called before OnComplete on the last update.
+func (tween *Tween) OnUpdate(callback func()) *Tween {
+	tween.onUpdate = callback
+	return tween
+}
+
+//OnComplete sets the function called when the tween has finished all of its repeats
+func (tween *Tween) OnComplete(callback func()) *Tween {
+	tween.onComplete = callback
+	return tween
+}
+
+//IsDone returns true if the tween has finished
+func (tween *Tween) IsDone() bool {
+	return tween.done
+}
+
+//Reset restarts the tween, including its delay. The starting value is not read again.
+func (tween *Tween) Reset() {
+	tween.delayLeft = tween.delay
+	tween.elapsed = 0
+	tween.played = 0
+	tween.reversed = false
+	tween.done = false
+}
+
+//Tick updates the tween with GetFrameTime. Returns false once the tween has finished.
+func (tween *Tween) Tick() bool {
+	return tween.Update(GetFrameTime())
+}
+
+//Update advances the tween by dt seconds. Returns false once the tween has finished.
+func (tween *Tween) Update(dt float32) bool {
+	tween.advance(dt)
+	return !tween.done
+}
+
+//advance moves the tween forward and returns the time that was left over after it finished
+func (tween *Tween) advance(dt float32) float32 {
+	if tween.done {
+		return dt
+	}
+
+	//Wait for the delay
+	if tween.delayLeft > 0 {
+		if dt < tween.delayLeft {
+			tween.delayLeft -= dt
+			return 0
+		}
+		dt -= tween.delayLeft
+		tween.delayLeft = 0
+	}
+
+	if !tween.started {
+		tween.started = true
+		if tween.onStart != nil {
+			tween.onStart()
+		}
+	}
+
+	for {
+		tween.elapsed += dt
+		if tween.elapsed < tween.duration {
+			tween.set(tween.elapsed)
+			tween.updated()
+			return 0
+		}
+
+		//Finished this play, carry the extra time into the next one
+		dt = tween.elapsed - tween.duration
+		tween.set(tween.duration)
+		tween.played++
+
+		if (tween.repeat >= 0 && tween.played > tween.repeat) || tween.duration <= 0 {
+			tween.done = true
+			tween.updated()
+			if tween.onComplete != nil {
+				tween.onComplete()
+			}
+			return dt
+		}
+
+		tween.elapsed = 0
+		if tween.yoyo {
+			tween.reversed = !tween.reversed
+		}
+	}
+}
+
+//updated calls the OnUpdate callback
+func (tween *Tween) updated() {
+	if tween.onUpdate != nil {
+		tween.onUpdate()
+	}
+}
+
+//set applies the eased amount for the time into the current play
+func (tween *Tween) set(elapsed float32) {
+	if tween.apply == nil {
+		return
+	}
+
+	if tween.reversed {
+		elapsed = tween.duration - elapsed
+	}
+
+	if tween.duration <= 0 {
+		if tween.reversed {
+			tween.apply(0)
+		} else {
+			tween.apply(1)
+		}
+		return
+	}
+
+	tween.apply(tween.ease(elapsed, 0, 1, tween.duration))
+}
+
+//Timeline plays groups of tweens one after the other. The tweens in a group play at the same time,
+// and the next group starts once they have all finished.
+//	r.NewTimeline().Then(moveIn).Wait(2).Then(moveOut, fadeOut).Call(removeEnemy)
+type Timeline struct {
+	steps      [][]*Tween
+	index      int
+	repeat     int
+	played     int
+	onComplete func()
+	done       bool
+}
+
+//NewTimeline creates an empty timeline
+func NewTimeline() *Timeline {
+	return &Timeline{}
+}
+
+//Then adds a group of tweens that play at the same time
+func (timeline *Timeline) Then(tweens ...*Tween) *Timeline {
+	timeline.steps = append(timeline.steps, tweens)
+	return timeline
+}
+
+//Wait adds a pause of the seconds
+func (timeline *Timeline) Wait(seconds float32) *Timeline {
+	return timeline.Then(NewTween(seconds, nil, nil))
+}
+
+//Call adds a function to call once the previous groups have finished
+func (timeline *Timeline) Call(callback func()) *Timeline {
+	return timeline.Then(NewTween(0, nil, nil).OnComplete(callback))
+}
+
+//SetRepeat sets how many more times the timeline plays after the first. -1 repeats forever.
+// Every repeat starts the tweens from the values they started from the first time.
+func (timeline *Timeline) SetRepeat(count int) *Timeline {
+	timeline.repeat = count
+	return timeline
+}
+
+//OnComplete sets the function called when the timeline has finished all of its repeats
+func (timeline *Timeline) OnComplete(callback func()) *Timeline {
+	timeline.onComplete = callback
+	return timeline
+}
+
+//IsDone returns true if the timeline has finished
+func (timeline *Timeline) IsDone() bool {
+	return timeline.done
+}
+
+//Reset restarts the timeline and all of its tweens
+func (timeline *Timeline) Reset() {
+	timeline.restart()
+	timeline.played = 0
+	timeline.done = false
+}
+
+//restart resets the tweens for the next play
+func (timeline *Timeline) restart() {
+	for _, step := range timeline.steps {
+		for _, tween := range step {
+			tween.Reset()
+		}
+	}
+	timeline.index = 0
+}
+
+//Tick updates the timeline with GetFrameTime. Returns false once the timeline has finished.
+func (timeline *Timeline) Tick() bool {
+	return timeline.Update(GetFrameTime())
+}
+
+//Update advances the timeline by dt seconds. Returns false once the timeline has finished.
+func (timeline *Timeline) Update(dt float32) bool {
+	passStart := dt
+	for !timeline.done {
+		if timeline.index >= len(timeline.steps) {
+			timeline.played++
+			if timeline.repeat >= 0 && timeline.played > timeline.repeat {
+				timeline.done = true
+				if timeline.onComplete != nil {
+					timeline.onComplete()
+				}
+				break
+			}
+
+			//A play that took no time would repeat forever, so the next one waits for the next update
+			timeline.restart()
+			if dt == passStart {
+				break
+			}
+			passStart = dt
+		}
+
+		//The group is done when every tween is, and the time left over is what the longest tween did not use
+		left := dt
+		finished := true
+		for _, tween := range timeline.steps[timeline.index] {
+			remaining := tween.advance(dt)
+			if !tween.done {
+				finished = false
+			}
+			if remaining < left {
+				left = remaining
+			}
+		}
+
+		if !finished {
+			break
+		}
+
+		dt = left
+		timeline.index++
+	}
+	return !timeline.done
+}
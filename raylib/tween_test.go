@@ -0,0 +1,172 @@
+package raylib
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+//testTweenSteps updates the tween by each dt and checks the value after it
+func testTweenSteps(t *testing.T, name string, tween *Tween, value *float32, steps [][2]float32) {
+	for i, step := range steps {
+		tween.Update(step[0])
+		if !testNear(*value, step[1]) {
+			t.Fatalf("%s: step %d is at %v, not %v", name, i, *value, step[1])
+		}
+	}
+}
+
+func TestTweenDelay(t *testing.T) {
+	value := float32(0)
+	tween := TweenFloat32(&value, 10, 1, nil).SetDelay(0.5)
+	tween.Update(0.25)
+
+	//the starting value is read once the delay is over
+	value = 2
+	testTweenSteps(t, "delay", tween, &value, [][2]float32{{0.5, 4}, {0.5, 8}})
+	if tween.Update(0.5) || !tween.IsDone() || value != 10 {
+		t.Fatalf("the tween finished at %v", value)
+	}
+
+	//resetting waits for the delay again
+	tween.Reset()
+	testTweenSteps(t, "reset", tween, &value, [][2]float32{{0.25, 10}, {0.5, 4}})
+}
+
+func TestTweenRepeat(t *testing.T) {
+	value := float32(0)
+	completed := 0
+	tween := TweenFloat32(&value, 10, 1, nil).SetRepeat(2).SetYoyo(true).OnComplete(func() { completed++ })
+
+	//forwards, backwards, then forwards again, carrying the time over between the plays
+	testTweenSteps(t, "yoyo", tween, &value, [][2]float32{{0.5, 5}, {0.75, 7.5}, {0.5, 2.5}, {0.5, 2.5}, {0.5, 7.5}})
+	if tween.Update(0.5) || value != 10 || completed != 1 {
+		t.Fatalf("the yoyo finished at %v and completed %d times", value, completed)
+	}
+	tween.Update(1)
+	if completed != 1 {
+		t.Fatal("updating a finished tween completed it again")
+	}
+
+	//without a yoyo every play starts from the beginning, and one update can finish several
+	value = 0
+	tween = TweenFloat32(&value, 10, 1, nil).SetRepeat(1)
+	testTweenSteps(t, "repeat", tween, &value, [][2]float32{{0.5, 5}, {0.75, 2.5}})
+	if tween.Update(0.75) || value != 10 {
+		t.Fatalf("the repeat finished at %v", value)
+	}
+	value = 0
+	tween = TweenFloat32(&value, 10, 1, nil).SetRepeat(3)
+	if tween.Update(10) || value != 10 {
+		t.Fatalf("a long update left the repeat at %v", value)
+	}
+
+	//repeating forever never completes
+	value = 0
+	tween = TweenFloat32(&value, 10, 1, nil).SetRepeat(-1).SetYoyo(true).OnComplete(func() { completed++ })
+	for i := 0; i < 100; i++ {
+		if !tween.Update(0.75) {
+			t.Fatal("an endless tween finished")
+		}
+	}
+	//75 seconds is the 76th play, which goes backwards
+	if !testNear(value, 10) || completed != 1 {
+		t.Fatalf("the endless tween is at %v", value)
+	}
+}
+
+func TestTweenCallbacks(t *testing.T) {
+	var events []string
+	record := func(event string) func() {
+		return func() { events = append(events, event) }
+	}
+
+	value := float32(0)
+	tween := TweenFloat32(&value, 1, 1, nil).SetDelay(0.5).SetRepeat(1).
+		OnUpdate(func() { events = append(events, fmt.Sprint(value)) }).
+		OnComplete(record("done"))
+	for _, dt := range []float32{0.25, 0.5, 0.5, 1, 0.5} {
+		tween.Update(dt)
+	}
+
+	//nothing while waiting, one OnUpdate an update even across plays, and OnComplete after the last
+	want := "0.25 0.75 0.75 1 done"
+	if got := strings.Join(events, " "); got != want {
+		t.Fatalf("the callbacks were %q, not %q", got, want)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	var events []string
+	record := func(event string) func() {
+		return func() { events = append(events, event) }
+	}
+
+	a, b, c := float32(0), float32(0), float32(0)
+	timeline := NewTimeline().
+		Then(TweenFloat32(&a, 1, 1, nil).OnComplete(record("a"))).
+		Wait(0.5).
+		Then(TweenFloat32(&b, 1, 1, nil).OnComplete(record("b")), TweenFloat32(&c, 1, 2, nil).OnComplete(record("c"))).
+		Call(record("call")).
+		OnComplete(record("timeline"))
+
+	steps := []struct {
+		dt      float32
+		a, b, c float32
+	}{
+		{0.5, 0.5, 0, 0},
+		//a finishes, and the rest of the time goes to the wait
+		{0.75, 1, 0, 0},
+		//the wait finishes, and b and c play at the same time
+		{0.5, 1, 0.25, 0.125},
+		{1, 1, 1, 0.625},
+		//the next group waits for c, the longest
+		{0.5, 1, 1, 0.875},
+	}
+	for i, step := range steps {
+		if !timeline.Update(step.dt) {
+			t.Fatalf("step %d: the timeline finished", i)
+		}
+		if !testNear(a, step.a) || !testNear(b, step.b) || !testNear(c, step.c) {
+			t.Fatalf("step %d: the values are %v %v %v, not %v %v %v", i, a, b, c, step.a, step.b, step.c)
+		}
+	}
+	if strings.Join(events, " ") != "a b" {
+		t.Fatalf("the callbacks before the end were %v", events)
+	}
+
+	if timeline.Update(0.25) || !timeline.IsDone() || c != 1 {
+		t.Fatalf("the timeline did not finish, c is %v", c)
+	}
+	if got := strings.Join(events, " "); got != "a b c call timeline" {
+		t.Fatalf("the callbacks were %q", got)
+	}
+}
+
+func TestTimelineRepeat(t *testing.T) {
+	value := float32(0)
+	plays := 0
+	timeline := NewTimeline().
+		Then(TweenFloat32(&value, 10, 1, nil)).
+		Call(func() { plays++ }).
+		SetRepeat(1)
+
+	//every play starts the tween from the value it first started from
+	timeline.Update(1.5)
+	if !testNear(value, 5) || plays != 1 {
+		t.Fatalf("the second play is at %v after %d plays", value, plays)
+	}
+	if timeline.Update(1) || plays != 2 || value != 10 {
+		t.Fatalf("the timeline finished at %v after %d plays", value, plays)
+	}
+
+	//a timeline that takes no time plays once an update when repeating forever
+	calls := 0
+	timeline = NewTimeline().Call(func() { calls++ }).SetRepeat(-1)
+	for i := 0; i < 3; i++ {
+		timeline.Update(0.1)
+	}
+	if calls != 3 || timeline.IsDone() {
+		t.Fatalf("the empty timeline was called %d times", calls)
+	}
+}
@@ -1,64 +1,328 @@
 package raylib
 
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
 //Unloadable is any object that has a Unload function and needs to be freed
 // when it has finished being used.
+// Unloadables are used as map keys, so they must be comparable (pointers are recommended).
 type Unloadable interface {
 	Unload()
 }
 
-var unloadingAll bool = false
-var unloadables []Unloadable = make([]Unloadable, 100)
+//ResourceScope keeps a record of Unloadables so they can be released together.
+// Load functions register into the current scope (see BeginResourceScope), which is DefaultResourceScope unless another scope has begun.
+// Closing a scope unloads everything it still holds in the reverse order it was loaded.
+type ResourceScope struct {
+	name      string
+	resources map[Unloadable]*scopedResource
+}
+
+//scopedResource is the record a scope keeps of each Unloadable
+type scopedResource struct {
+	unloadable Unloadable
+	order      uint64
+	caller     string
+}
+
+//ResourceLeak describes an Unloadable that was still held by a scope
+type ResourceLeak struct {
+	//Type is the name of the type of the resource, ie: Texture2D
+	Type string
+	//Caller is where the resource was loaded. Only recorded while resource debugging is enabled.
+	Caller string
+}
+
+//DefaultResourceScope is the scope Load functions register into when no other scope has begun. UnloadAll closes it.
+var DefaultResourceScope = NewResourceScope("default")
+
+var resourceMutex sync.Mutex
+var resourceOrder uint64
+var resourceOwners = make(map[Unloadable]*ResourceScope)
+var resourceScopes []*ResourceScope
+
+//resourceDebug records the call site of every registered Unloadable. Enabled by default with the debug build tag.
+var resourceDebug = false
+
+//NewResourceScope creates a new empty scope. The name is used in the logs.
+func NewResourceScope(name string) *ResourceScope {
+	return &ResourceScope{name: name, resources: make(map[Unloadable]*scopedResource)}
+}
+
+//SetResourceDebug enables recording where each Unloadable is loaded, which is shown in leak reports.
+// This walks the stack on every Load so it is slower. It is enabled by default when building with the debug tag.
+func SetResourceDebug(enabled bool) {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	resourceDebug = enabled
+}
+
+//BeginResourceScope makes Load functions register into the scope until EndResourceScope is called.
+// Scopes can be nested.
+func BeginResourceScope(scope *ResourceScope) {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	resourceScopes = append(resourceScopes, scope)
+}
 
-//TODO: Fix this
-func finalizeUnloadables(unlds *[]Unloadable) {
-	TraceLog(LogInfo, "[UNLOAD] Finalizing Unloadables")
-	UnloadAll()
+//EndResourceScope stops registering into the scope given to the last BeginResourceScope. It does not close the scope.
+func EndResourceScope() {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	if len(resourceScopes) > 0 {
+		resourceScopes[len(resourceScopes)-1] = nil
+		resourceScopes = resourceScopes[:len(resourceScopes)-1]
+	}
 }
 
-//RegisterUnloadable registers an unloadable to the slice
+//CurrentResourceScope gets the scope that Load functions are currently registering into
+func CurrentResourceScope() *ResourceScope {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	return currentResourceScope()
+}
+
+func currentResourceScope() *ResourceScope {
+	if len(resourceScopes) > 0 {
+		return resourceScopes[len(resourceScopes)-1]
+	}
+	return DefaultResourceScope
+}
+
+//RegisterUnloadable registers an unloadable to the current scope
 // This is called on Load functions
 func RegisterUnloadable(unloadable Unloadable) {
-	TraceLog(LogTrace, "[UNLOAD] New unloadable created")
-	unloadables = append(unloadables, unloadable)
+	resourceMutex.Lock()
+	scope := currentResourceScope()
+	previous, registered := scope.register(unloadable)
+	resourceMutex.Unlock()
+	traceRegister(scope, previous, registered)
 }
 
-//UnregisterUnloadable unregisters an unloadable to the slice
+//UnregisterUnloadable unregisters an unloadable from the scope that holds it
 // This is called on Unload functions
-// This does not remove from the slice if unloadingAll is true (as that will clear post)
 func UnregisterUnloadable(unloadable Unloadable) {
-	if !unloadingAll {
-		for i, u := range unloadables {
-			if u == unloadable {
-				unloadables[i] = unloadables[len(unloadables)-1]
-				unloadables[len(unloadables)-1] = nil
-				unloadables = unloadables[:len(unloadables)-1]
-				TraceLog(LogTrace, "[UNLOAD] Removed Unloadable")
-				break
-			}
+	resourceMutex.Lock()
+	owner, ok := resourceOwners[unloadable]
+	if ok {
+		owner.unregister(unloadable)
+	}
+	resourceMutex.Unlock()
+	if ok {
+		traceRegister(nil, owner, false)
+	}
+}
+
+//Name gets the name of the scope
+func (scope *ResourceScope) Name() string {
+	return scope.name
+}
+
+//Register records the unloadable in this scope, moving it out of any other scope that held it
+func (scope *ResourceScope) Register(unloadable Unloadable) {
+	resourceMutex.Lock()
+	previous, registered := scope.register(unloadable)
+	resourceMutex.Unlock()
+	traceRegister(scope, previous, registered)
+}
+
+//Unregister removes the unloadable from this scope without unloading it
+func (scope *ResourceScope) Unregister(unloadable Unloadable) {
+	resourceMutex.Lock()
+	owned := resourceOwners[unloadable] == scope
+	if owned {
+		scope.unregister(unloadable)
+	}
+	resourceMutex.Unlock()
+	if owned {
+		traceRegister(nil, scope, false)
+	}
+}
+
+//register records the unloadable, and returns the scope it was moved out of and if it was registered.
+// resourceMutex must be held, so it does not log. Pass the results to traceRegister once it is unlocked.
+func (scope *ResourceScope) register(unloadable Unloadable) (previous *ResourceScope, registered bool) {
+	if unloadable == nil {
+		return nil, false
+	}
+
+	if owner, ok := resourceOwners[unloadable]; ok {
+		if owner == scope {
+			return nil, false
 		}
+		owner.unregister(unloadable)
+		previous = owner
+	}
+
+	resourceOrder++
+	res := &scopedResource{unloadable: unloadable, order: resourceOrder}
+	if resourceDebug {
+		res.caller = resourceCaller()
 	}
+
+	scope.resources[unloadable] = res
+	resourceOwners[unloadable] = scope
+	return previous, true
 }
 
-//UnloadAll clears all unloadables that have been recorded.
-// NOTE: Not everything maybe included in this list and it is experimental feature.
-// 			 Please unload these objects when you are not using them anyways.
-func UnloadAll() {
-	TraceLog(LogInfo, "[UNLOAD] Unloading all unloadables: ", len(unloadables))
+//unregister removes the unloadable. resourceMutex must be held, so it does not log.
+func (scope *ResourceScope) unregister(unloadable Unloadable) {
+	delete(scope.resources, unloadable)
+	delete(resourceOwners, unloadable)
+}
 
-	//Count the tally
-	tally := 0
+//traceRegister logs what register and unregister did. It is called once resourceMutex is unlocked,
+// as the log callback and listeners can load and unload themselves.
+func traceRegister(scope *ResourceScope, previous *ResourceScope, registered bool) {
+	if previous != nil {
+		TraceLog(LogTrace, "[UNLOAD] Removed Unloadable from scope ", previous.name)
+	}
+	if registered {
+		TraceLog(LogTrace, "[UNLOAD] New unloadable created in scope ", scope.name)
+	}
+}
+
+//Len gets the number of unloadables the scope holds
+func (scope *ResourceScope) Len() int {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	return len(scope.resources)
+}
+
+//Counts gets the number of unloadables the scope holds for each type, ie: counts["Texture2D"]
+func (scope *ResourceScope) Counts() map[string]int {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	counts := make(map[string]int)
+	for u := range scope.resources {
+		counts[resourceTypeName(u)]++
+	}
+	return counts
+}
+
+//resourceCounts gets the number of unloadables each scope holds for each type, by the name of the scope
+func resourceCounts() map[string]map[string]int {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	counts := make(map[string]map[string]int)
+	for u, owner := range resourceOwners {
+		if counts[owner.name] == nil {
+			counts[owner.name] = make(map[string]int)
+		}
+		counts[owner.name][resourceTypeName(u)]++
+	}
+	return counts
+}
+
+//Leaks lists the unloadables the scope still holds, latest loaded first
+func (scope *ResourceScope) Leaks() []ResourceLeak {
+	resourceMutex.Lock()
+	defer resourceMutex.Unlock()
+	return resourceLeaks(scope.sorted())
+}
+
+//LeakReport describes the unloadables the scope still holds, one per line. Returns an empty string if there are none.
+func (scope *ResourceScope) LeakReport() string {
+	return scope.leakReport(scope.Leaks())
+}
+
+func (scope *ResourceScope) leakReport(leaks []ResourceLeak) string {
+	if len(leaks) == 0 {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "[UNLOAD] Scope %s has %d unreleased resources", scope.name, len(leaks))
+	for _, leak := range leaks {
+		if leak.Caller != "" {
+			fmt.Fprintf(&sb, "\n    %s loaded at %s", leak.Type, leak.Caller)
+		} else {
+			fmt.Fprintf(&sb, "\n    %s", leak.Type)
+		}
+	}
+	return sb.String()
+}
+
+//Close unloads everything the scope holds, latest loaded first. The scope can still be used afterwards.
+// When resource debugging is enabled, the leak report is logged as a warning first.
+func (scope *ResourceScope) Close() {
+	//Take the resources out of the scope before unloading them, as Unload calls UnregisterUnloadable
+	resourceMutex.Lock()
+	debug := resourceDebug
+	sorted := scope.sorted()
+	for _, res := range sorted {
+		delete(resourceOwners, res.unloadable)
+	}
+	scope.resources = make(map[Unloadable]*scopedResource)
+	resourceMutex.Unlock()
 
-	//Unload everything
-	unloadingAll = true
-	for _, ul := range unloadables {
-		if ul != nil {
-			ul.Unload()
-			tally++
+	if debug {
+		if report := scope.leakReport(resourceLeaks(sorted)); report != "" {
+			TraceLog(LogWarning, report)
 		}
 	}
-	unloadingAll = false
 
-	//Clear the unloadables
-	unloadables = unloadables[:0]
-	TraceLog(LogInfo, "[UNLOAD] Unloaded ", tally)
+	TraceLog(LogInfo, "[UNLOAD] Unloading scope ", scope.name, ": ", len(sorted))
+	for _, res := range sorted {
+		res.unloadable.Unload()
+	}
+}
+
+//sorted gets the resources latest loaded first
+func (scope *ResourceScope) sorted() []*scopedResource {
+	sorted := make([]*scopedResource, 0, len(scope.resources))
+	for _, res := range scope.resources {
+		sorted = append(sorted, res)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].order > sorted[j].order })
+	return sorted
+}
+
+func resourceLeaks(sorted []*scopedResource) []ResourceLeak {
+	leaks := make([]ResourceLeak, len(sorted))
+	for i, res := range sorted {
+		leaks[i] = ResourceLeak{Type: resourceTypeName(res.unloadable), Caller: res.caller}
+	}
+	return leaks
+}
+
+//resourceTypeName gets the name of the unloadable's type without the pointer or package
+func resourceTypeName(unloadable Unloadable) string {
+	t := reflect.TypeOf(unloadable)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+//resourcePackage is the prefix of every function in this package, used to skip our own frames
+var resourcePackage = reflect.TypeOf(ResourceScope{}).PkgPath() + "."
+
+//resourceCaller finds the first caller outside of this package
+func resourceCaller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, resourcePackage) {
+			return fmt.Sprintf("%s:%d (%s)", frame.File, frame.Line, frame.Function)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+//UnloadAll closes the DefaultResourceScope, unloading everything that has been recorded in it.
+// NOTE: Not everything maybe included in this list and it is experimental feature.
+// 			 Please unload these objects when you are not using them anyways.
+func UnloadAll() {
+	DefaultResourceScope.Close()
 }
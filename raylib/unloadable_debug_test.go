@@ -0,0 +1,51 @@
+// +build debug
+
+package raylib_test
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/lachee/raylib-goplus/raylib"
+)
+
+//The caller is the first frame outside of the raylib package, so this test is outside of it too
+
+//testLeakedUnloadable is an Unloadable that is left in its scope
+type testLeakedUnloadable struct{}
+
+func (u *testLeakedUnloadable) Unload() { raylib.UnregisterUnloadable(u) }
+
+func TestResourceScopeRecordsCaller(t *testing.T) {
+	warnings := make([]string, 0)
+	raylib.SetTraceLogCallback(func(logType raylib.TraceLogType, text string) {
+		if logType == raylib.LogWarning {
+			warnings = append(warnings, text)
+		}
+	})
+	defer raylib.SetTraceLogCallback(nil)
+
+	scope := raylib.NewResourceScope("test")
+	raylib.BeginResourceScope(scope)
+	_, file, line, _ := runtime.Caller(0)
+	raylib.RegisterUnloadable(&testLeakedUnloadable{})
+	raylib.EndResourceScope()
+
+	//debug builds record the caller without enabling it
+	caller := fmt.Sprintf("%s:%d (github.com/lachee/raylib-goplus/raylib_test.TestResourceScopeRecordsCaller)", file, line+1)
+	leaks := scope.Leaks()
+	if len(leaks) != 1 || leaks[0].Type != "testLeakedUnloadable" || leaks[0].Caller != caller {
+		t.Fatalf("the leaks are %+v, not loaded at %s", leaks, caller)
+	}
+	if report := scope.LeakReport(); !strings.HasSuffix(report, "\n    testLeakedUnloadable loaded at "+caller) {
+		t.Fatalf("the leak report is %q", report)
+	}
+
+	//closing the scope warns about what it still held
+	scope.Close()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], caller) {
+		t.Fatalf("closing the scope warned %q", warnings)
+	}
+}
@@ -0,0 +1,175 @@
+package raylib
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+//testUnloadable records that it was unloaded
+type testUnloadable struct{ unloaded bool }
+
+func (u *testUnloadable) Unload() {
+	u.unloaded = true
+	UnregisterUnloadable(u)
+}
+
+//testNamedUnloadable adds its name to unloaded when it is unloaded
+type testNamedUnloadable struct {
+	name     string
+	unloaded *[]string
+}
+
+func (u *testNamedUnloadable) Unload() {
+	*u.unloaded = append(*u.unloaded, u.name)
+	UnregisterUnloadable(u)
+}
+
+func TestUnloadableLogsWithoutTheLock(t *testing.T) {
+	SetTraceLogLevel(LogTrace)
+	defer SetTraceLogLevel(LogInfo)
+
+	//the callback uses the scopes, which would deadlock if register logged while holding the lock
+	SetTraceLogCallback(func(logType TraceLogType, text string) {
+		CurrentResourceScope().Len()
+	})
+	defer SetTraceLogCallback(nil)
+
+	scope := NewResourceScope("test")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		u := &testUnloadable{}
+		RegisterUnloadable(u)
+		scope.Register(u)
+		scope.Unregister(u)
+		scope.Register(u)
+		scope.Close()
+		if !u.unloaded || scope.Len() != 0 {
+			t.Error("closing the scope did not unload the resource")
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("registering an unloadable deadlocked while logging")
+	}
+}
+
+func TestResourceScopeClose(t *testing.T) {
+	unloaded := make([]string, 0)
+	resources := make(map[string]*testNamedUnloadable)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		resources[name] = &testNamedUnloadable{name: name, unloaded: &unloaded}
+	}
+
+	scope := NewResourceScope("test")
+	BeginResourceScope(scope)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		RegisterUnloadable(resources[name])
+	}
+	EndResourceScope()
+
+	//moving a resource back in counts as loading it again, but registering it twice in the same scope does not
+	DefaultResourceScope.Register(resources["b"])
+	scope.Register(resources["b"])
+	scope.Register(resources["a"])
+
+	scope.Close()
+	if got := strings.Join(unloaded, " "); got != "b d c a" {
+		t.Fatalf("the scope unloaded %q", got)
+	}
+	if scope.Len() != 0 || len(scope.Counts()) != 0 || len(resourceCounts()["test"]) != 0 {
+		t.Fatalf("the closed scope still holds %v", scope.Counts())
+	}
+
+	//the scope can be used again after it is closed
+	unloaded = unloaded[:0]
+	scope.Register(resources["c"])
+	scope.Register(resources["a"])
+	scope.Close()
+	if got := strings.Join(unloaded, " "); got != "a c" {
+		t.Fatalf("the reused scope unloaded %q", got)
+	}
+}
+
+func TestResourceScopeCounts(t *testing.T) {
+	unloaded := make([]string, 0)
+	plain := []*testUnloadable{{}, {}}
+	named := []*testNamedUnloadable{{"a", &unloaded}, {"b", &unloaded}, {"c", &unloaded}}
+
+	scope := NewResourceScope("test")
+	other := NewResourceScope("other")
+	defer other.Close()
+	defer scope.Close()
+	for _, u := range plain {
+		scope.Register(u)
+	}
+	for _, u := range named {
+		scope.Register(u)
+	}
+
+	tests := []struct {
+		change func()
+		plain  int
+		named  int
+		other  int
+	}{
+		{func() {}, 2, 3, 0},
+		{func() { plain[0].Unload() }, 1, 3, 0},
+		{func() { scope.Unregister(named[0]) }, 1, 2, 0},
+		{func() { other.Register(named[1]) }, 1, 1, 1},
+		//the type is dropped once the scope holds none of it
+		{func() { plain[1].Unload() }, 0, 1, 1},
+		{func() { named[2].Unload() }, 0, 0, 1},
+	}
+	for i, test := range tests {
+		test.change()
+		counts := scope.Counts()
+		if counts["testUnloadable"] != test.plain || counts["testNamedUnloadable"] != test.named || other.Counts()["testNamedUnloadable"] != test.other {
+			t.Fatalf("after change %d the counts are %v and %v", i, counts, other.Counts())
+		}
+		for name, count := range counts {
+			if count == 0 {
+				t.Fatalf("after change %d the counts still have %s", i, name)
+			}
+		}
+		if all := resourceCounts(); all["test"]["testNamedUnloadable"] != test.named || all["other"]["testNamedUnloadable"] != test.other {
+			t.Fatalf("after change %d every scope counts %v", i, all)
+		}
+	}
+
+	//the counts are a copy
+	other.Counts()["testNamedUnloadable"] = 10
+	if other.Counts()["testNamedUnloadable"] != 1 {
+		t.Fatal("changing the counts changed the scope")
+	}
+}
+
+func TestResourceScopeLeaks(t *testing.T) {
+	resourceMutex.Lock()
+	debug := resourceDebug
+	resourceMutex.Unlock()
+	defer SetResourceDebug(debug)
+	SetResourceDebug(false)
+
+	unloaded := make([]string, 0)
+	scope := NewResourceScope("test")
+	defer scope.Close()
+	scope.Register(&testNamedUnloadable{"a", &unloaded})
+	scope.Register(&testUnloadable{})
+
+	//without resource debugging the caller is not recorded
+	leaks := scope.Leaks()
+	if len(leaks) != 2 || leaks[0] != (ResourceLeak{Type: "testUnloadable"}) || leaks[1] != (ResourceLeak{Type: "testNamedUnloadable"}) {
+		t.Fatalf("the leaks are %+v", leaks)
+	}
+	want := "[UNLOAD] Scope test has 2 unreleased resources\n    testUnloadable\n    testNamedUnloadable"
+	if report := scope.LeakReport(); report != want {
+		t.Fatalf("the leak report is %q", report)
+	}
+	if report := NewResourceScope("empty").LeakReport(); report != "" {
+		t.Fatalf("an empty scope reported %q", report)
+	}
+}
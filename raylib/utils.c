@@ -30,6 +30,10 @@
 *
 **********************************************************************************************/
 
+#if defined(__linux__) && !defined(_GNU_SOURCE)
+    #define _GNU_SOURCE                 // Required for: fopencookie()
+#endif
+
 #include "raylib.h"                     // WARNING: Required for: LogType enum
 
 // Check if config flags have been externally provided on compilation line
@@ -50,6 +54,10 @@
 #include <stdarg.h>                     // Required for: va_list, va_start(), vfprintf(), va_end()
 #include <string.h>                     // Required for: strcpy(), strcat()
 
+#if !defined(PLATFORM_ANDROID)
+    #undef fopen                        // virtual_fopen() falls back to the real fopen()
+#endif
+
 #define MAX_TRACELOG_BUFFER_SIZE   128  // Max length of one trace-log message
 
 #define MAX_UWP_MESSAGES 512            // Max UWP messages to process
@@ -65,6 +73,16 @@ static TraceLogCallback logCallback = NULL;             // Log callback function
 
 #if defined(PLATFORM_ANDROID)
 static AAssetManager *assetManager = NULL;              // Android assets manager pointer 
+#else
+static THREAD_LOCAL VirtualFileCallback virtualFileCallback = NULL;    // Virtual file system of this thread
+static THREAD_LOCAL uintptr_t virtualFileContext = 0;                  // Virtual file system context, passed to the callback
+
+// Virtual file data, read by the FILE
+typedef struct VirtualFile {
+    unsigned char *data;
+    long size;
+    long position;
+} VirtualFile;
 #endif
 
 #if defined(PLATFORM_UWP)
@@ -86,6 +104,8 @@ static int android_read(void *cookie, char *buf, int size);
 static int android_write(void *cookie, const char *buf, int size);
 static fpos_t android_seek(void *cookie, fpos_t offset, int whence);
 static int android_close(void *cookie);
+#else
+static FILE *OpenVirtualFile(unsigned char *data, unsigned int size);    // Open the data as a read only FILE
 #endif
 
 //----------------------------------------------------------------------------------
@@ -182,6 +202,27 @@ FILE *android_fopen(const char *fileName, const char *mode)
 
     return funopen(asset, android_read, android_write, android_seek, android_close);
 }
+#else
+// Set the virtual file system of the calling thread
+// NOTE: Files opened for reading on this thread are read from the callback until it is set to NULL
+void SetVirtualFileSystem(VirtualFileCallback callback, uintptr_t context)
+{
+    virtualFileCallback = callback;
+    virtualFileContext = context;
+}
+
+// Replacement for fopen
+FILE *virtual_fopen(const char *fileName, const char *mode)
+{
+    if ((virtualFileCallback == NULL) || (mode[0] != 'r') || (strchr(mode, '+') != NULL)) return fopen(fileName, mode);
+
+    unsigned int size = 0;
+    unsigned char *data = virtualFileCallback(virtualFileContext, fileName, &size);
+
+    if (data == NULL) return NULL;
+
+    return OpenVirtualFile(data, size);
+}
 #endif  // PLATFORM_ANDROID
 
 //----------------------------------------------------------------------------------
@@ -212,6 +253,104 @@ static int android_close(void *cookie)
 }
 #endif  // PLATFORM_ANDROID
 
+#if !defined(PLATFORM_ANDROID)
+#if defined(__linux__) || defined(__APPLE__) || defined(__FreeBSD__) || defined(__OpenBSD__) || defined(__NetBSD__)
+static int virtual_read(VirtualFile *file, char *buf, int size)
+{
+    long remaining = file->size - file->position;
+    if (size > remaining) size = (int)remaining;
+
+    memcpy(buf, file->data + file->position, size);
+    file->position += size;
+
+    return size;
+}
+
+static long virtual_seek(VirtualFile *file, long offset, int whence)
+{
+    long position = offset;
+    if (whence == SEEK_CUR) position += file->position;
+    else if (whence == SEEK_END) position += file->size;
+
+    if ((position < 0) || (position > file->size)) return -1;
+
+    file->position = position;
+
+    return position;
+}
+
+static int virtual_close(void *cookie)
+{
+    free(((VirtualFile *)cookie)->data);
+    RL_FREE(cookie);
+
+    return 0;
+}
+#endif
+
+#if defined(__linux__)
+static ssize_t virtual_cookie_read(void *cookie, char *buf, size_t size)
+{
+    return virtual_read((VirtualFile *)cookie, buf, (size > 0x7fffffff)? 0x7fffffff : (int)size);
+}
+
+static int virtual_cookie_seek(void *cookie, off64_t *offset, int whence)
+{
+    long position = virtual_seek((VirtualFile *)cookie, (long)*offset, whence);
+    if (position < 0) return -1;
+
+    *offset = position;
+
+    return 0;
+}
+#elif defined(__APPLE__) || defined(__FreeBSD__) || defined(__OpenBSD__) || defined(__NetBSD__)
+static int virtual_funopen_read(void *cookie, char *buf, int size)
+{
+    return virtual_read((VirtualFile *)cookie, buf, size);
+}
+
+static fpos_t virtual_funopen_seek(void *cookie, fpos_t offset, int whence)
+{
+    return virtual_seek((VirtualFile *)cookie, (long)offset, whence);
+}
+#endif
+
+// Open the data as a read only FILE, the FILE frees the data when it is closed
+// NOTE: Platforms without fopencookie() or funopen() copy the data into a temporary file
+static FILE *OpenVirtualFile(unsigned char *data, unsigned int size)
+{
+#if defined(__linux__) || defined(__APPLE__) || defined(__FreeBSD__) || defined(__OpenBSD__) || defined(__NetBSD__)
+    VirtualFile *file = (VirtualFile *)RL_MALLOC(sizeof(VirtualFile));
+    file->data = data;
+    file->size = size;
+    file->position = 0;
+
+#if defined(__linux__)
+    cookie_io_functions_t functions = { virtual_cookie_read, NULL, virtual_cookie_seek, virtual_close };
+    FILE *result = fopencookie(file, "rb", functions);
+#else
+    FILE *result = funopen(file, virtual_funopen_read, NULL, virtual_funopen_seek, virtual_close);
+#endif
+
+    if (result == NULL) virtual_close(file);
+
+    return result;
+#else
+    FILE *result = tmpfile();
+
+    if (result != NULL)
+    {
+        fwrite(data, 1, size, result);
+        rewind(result);
+    }
+
+    free(data);
+
+    return result;
+#endif
+}
+#endif  // !PLATFORM_ANDROID
+
 #if defined(PLATFORM_UWP)
 UWPMessage *CreateUWPMessage(void)
 {
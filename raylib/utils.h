@@ -27,8 +27,11 @@
 #ifndef UTILS_H
 #define UTILS_H
 
+#include "raylib.h"                         // Required for: Image, Texture2D, Model, AudioStream
+#include <stdio.h>                          // Required for: FILE
+#include <stdint.h>                         // Required for: uintptr_t
+
 #if defined(PLATFORM_ANDROID)
-    #include <stdio.h>                      // Required for: FILE
     #include <android/asset_manager.h>      // Required for: AAssetManager
 #endif
 
@@ -37,6 +40,14 @@
 //----------------------------------------------------------------------------------
 #if defined(PLATFORM_ANDROID)
     #define fopen(name, mode) android_fopen(name, mode)
+#else
+    #define fopen(name, mode) virtual_fopen(name, mode)
+#endif
+
+#if defined(_MSC_VER)
+    #define THREAD_LOCAL __declspec(thread)
+#else
+    #define THREAD_LOCAL __thread
 #endif
 
 //----------------------------------------------------------------------------------
@@ -46,6 +57,15 @@
 extern "C" {            // Prevents name mangling of functions
 #endif
 
+// Virtual file system callback, returns the data of the file (allocated with malloc, the FILE frees it) or NULL if it does not exist
+typedef unsigned char *(*VirtualFileCallback)(uintptr_t context, const char *fileName, unsigned int *bytesRead);
+
+// Deferred upload, the images of the textures made while uploads were deferred
+typedef struct DeferredUpload {
+    Image *images;
+    int count;
+} DeferredUpload;
+
 //----------------------------------------------------------------------------------
 // Global Variables Definition
 //----------------------------------------------------------------------------------
@@ -57,8 +77,22 @@ extern "C" {            // Prevents name mangling of functions
 #if defined(PLATFORM_ANDROID)
 void InitAssetManager(AAssetManager *manager);  // Initialize asset manager from android app
 FILE *android_fopen(const char *fileName, const char *mode);    // Replacement for fopen()
+#else
+void SetVirtualFileSystem(VirtualFileCallback callback, uintptr_t context);   // Read the files opened on this thread from the callback, NULL reads from disk again
+FILE *virtual_fopen(const char *fileName, const char *mode);    // Replacement for fopen()
 #endif
 
+// Deferred uploads, so assets can be loaded on other threads and uploaded to the GPU on the main thread
+void BeginDeferredUpload(void);                                     // Leave the textures and meshes made on this thread in RAM
+DeferredUpload EndDeferredUpload(void);                             // Stop deferring, returns the images of the textures made
+bool IsUploadDeferred(void);                                        // Check if the uploads of this thread are being deferred
+void UploadDeferredTexture(Texture2D *texture, DeferredUpload upload);  // Upload a texture made while deferred (main thread)
+void UploadDeferredModel(Model *model, DeferredUpload upload);      // Upload the meshes and textures of a model made while deferred (main thread)
+void UnloadDeferredUpload(DeferredUpload upload);                   // Unload the images once the textures are uploaded or discarded
+
+// Audio stream buffers, so the audio that has been queued can be checked
+unsigned int ReadAudioStreamBuffer(AudioStream stream, void *data, unsigned int frameCount);  // Copy the frames queued to play next, in the format of the stream
+
 #if defined(PLATFORM_UWP)
 // UWP Messages System
 typedef enum {
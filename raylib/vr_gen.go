@@ -1,7 +1,7 @@
 package raylib
 
 /*
-//Generated 2020-01-29T21:04:25+01:00
+//Generated 2026-10-18T12:05:21Z
 #include "raylib.h"
 #include <stdlib.h>
 #include "go.h"
@@ -10,22 +10,26 @@ import "C"
 
 //InitVrSimulator : Init VR simulator for selected device parameters
 func InitVrSimulator() {
+	checkMainThread("InitVrSimulator")
 	C.InitVrSimulator()
 }
 
 //CloseVrSimulator : Close VR simulator for current device
 func CloseVrSimulator() {
+	checkMainThread("CloseVrSimulator")
 	C.CloseVrSimulator()
 }
 
 //UpdateVrTracking : Update VR tracking (position and orientation) and camera
 func UpdateVrTracking(camera *Camera) {
+	checkMainThread("UpdateVrTracking")
 	ccamera := camera.cptr()
 	C.UpdateVrTracking(ccamera)
 }
 
 //SetVrConfiguration : Set stereo rendering configuration parameters
 func SetVrConfiguration(info VrDeviceInfo, distortion Shader) {
+	checkMainThread("SetVrConfiguration")
 	cdistortion := *distortion.cptr()
 	cinfo := *info.cptr()
 	C.SetVrConfiguration(cinfo, cdistortion)
@@ -33,21 +37,25 @@ func SetVrConfiguration(info VrDeviceInfo, distortion Shader) {
 
 //IsVrSimulatorReady : Detect if VR simulator is ready
 func IsVrSimulatorReady() bool {
+	checkMainThread("IsVrSimulatorReady")
 	res := C.IsVrSimulatorReady()
 	return bool(res)
 }
 
 //ToggleVrMode : Enable/Disable VR experience
 func ToggleVrMode() {
+	checkMainThread("ToggleVrMode")
 	C.ToggleVrMode()
 }
 
 //BeginVrDrawing : Begin VR simulator stereo rendering
 func BeginVrDrawing() {
+	checkMainThread("BeginVrDrawing")
 	C.BeginVrDrawing()
 }
 
 //EndVrDrawing : End VR simulator stereo rendering
 func EndVrDrawing() {
+	checkMainThread("EndVrDrawing")
 	C.EndVrDrawing()
 }
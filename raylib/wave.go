@@ -0,0 +1,309 @@
+package raylib
+
+/*
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+import (
+	"math"
+	"unsafe"
+)
+
+//NewWave creates a silent wave. The sample count is the number of frames (a sample for every channel), see Wave.SampleCount.
+// The sample size is 8, 16 or 32 bits, which are uint8, int16 and float32 samples.
+// Like raylib's loaders, it logs a warning and gives back an empty wave if there are no channels or the sample size is not supported.
+func NewWave(sampleCount uint32, sampleRate uint32, sampleSize uint32, channels uint32) *Wave {
+	wave := &Wave{
+		SampleCount: sampleCount,
+		SampleRate:  sampleRate,
+		SampleSize:  sampleSize,
+		Channels:    channels,
+	}
+	if validWaveFormat(sampleSize, channels) {
+		wave.data = C.calloc(1, C.size_t(sampleCount*channels*sampleSize/8))
+	} else {
+		TraceLog(LogWarning, "[WAVE] Cannot create a wave of ", channels, " channels with ", sampleSize, " bit samples")
+		wave.SampleCount = 0
+	}
+	RegisterUnloadable(wave)
+	return wave
+}
+
+//validWaveFormat returns true if the wave has channels and an 8, 16 or 32 bit sample size, which the Go wave functions need
+func validWaveFormat(sampleSize uint32, channels uint32) bool {
+	return channels > 0 && (sampleSize == 8 || sampleSize == 16 || sampleSize == 32)
+}
+
+//NewWaveFromSamples creates a wave from interleaved samples, ie: left, right, left, right... for two channels. The sample size is the size of T.
+// The samples are copied into memory raylib owns, so the wave can be given to LoadSoundFromWave and is freed with Unload.
+func NewWaveFromSamples[T Sample](samples []T, sampleRate uint32, channels uint32) *Wave {
+	var sample T
+	size := uint32(unsafe.Sizeof(sample))
+	frames := uint32(0)
+	if channels > 0 {
+		frames = uint32(len(samples)) / channels
+	}
+	wave := NewWave(frames, sampleRate, size*8, channels)
+	copy(WaveSamples[T](wave), samples)
+	return wave
+}
+
+//NewWaveFromBytes creates a wave from interleaved PCM bytes in the sample size (8, 16 or 32 bits, little endian), ie: the data chunk of a .wav file.
+func NewWaveFromBytes(data []byte, sampleRate uint32, sampleSize uint32, channels uint32) *Wave {
+	frames := uint32(0)
+	if frameSize := channels * sampleSize / 8; frameSize > 0 {
+		frames = uint32(len(data)) / frameSize
+	}
+	wave := NewWave(frames, sampleRate, sampleSize, channels)
+	copy(wave.Bytes(), data)
+	return wave
+}
+
+//WaveSamples gets the interleaved samples of the wave without copying them, so changing them changes the wave.
+// Returns nil if T does not have the sample size of the wave. The slice must not be used after the wave is unloaded, or its data is replaced by Format, Crop, Resample...
+func WaveSamples[T Sample](wave *Wave) []T {
+	var sample T
+	if wave.data == nil || uintptr(wave.SampleSize/8) != unsafe.Sizeof(sample) {
+		return nil
+	}
+	return unsafe.Slice((*T)(wave.data), wave.SampleCount*wave.Channels)
+}
+
+//Bytes gets the PCM data of the wave without copying it. The same rules as WaveSamples apply.
+func (wave *Wave) Bytes() []byte {
+	if wave.data == nil {
+		return nil
+	}
+	return unsafe.Slice((*byte)(wave.data), wave.SampleCount*wave.Channels*wave.SampleSize/8)
+}
+
+//Resample converts the wave to the sample rate, with linear interpolation
+func (wave *Wave) Resample(sampleRate uint32) {
+	if sampleRate == wave.SampleRate || sampleRate == 0 || wave.SampleRate == 0 || wave.SampleCount == 0 || !validWaveFormat(wave.SampleSize, wave.Channels) {
+		return
+	}
+	samples := resampleFrames(wave.floats(), int(wave.Channels), wave.SampleRate, sampleRate)
+	wave.SampleRate = sampleRate
+	wave.setFloats(samples)
+}
+
+//WaveResample converts the wave to the sample rate, with linear interpolation
+//Recommended to use wave.Resample(sampleRate) instead
+func WaveResample(wave *Wave, sampleRate uint32) {
+	wave.Resample(sampleRate)
+}
+
+//Normalize scales the wave so its loudest sample is at the peak, ie: 1 for full scale. A silent wave is not changed.
+func (wave *Wave) Normalize(peak float32) {
+	if !validWaveFormat(wave.SampleSize, wave.Channels) {
+		return
+	}
+	samples := wave.floats()
+	loudest := float32(0)
+	for _, s := range samples {
+		if s < 0 {
+			s = -s
+		}
+		if s > loudest {
+			loudest = s
+		}
+	}
+	if loudest == 0 {
+		return
+	}
+	scale := peak / loudest
+	for i := range samples {
+		samples[i] *= scale
+	}
+	wave.setFloats(samples)
+}
+
+//WaveNormalize scales the wave so its loudest sample is at the peak
+//Recommended to use wave.Normalize(peak) instead
+func WaveNormalize(wave *Wave, peak float32) {
+	wave.Normalize(peak)
+}
+
+//Reverse reverses the frames of the wave, so it plays backwards
+func (wave *Wave) Reverse() {
+	if !validWaveFormat(wave.SampleSize, wave.Channels) {
+		return
+	}
+	data := wave.Bytes()
+	frameSize := int(wave.Channels * wave.SampleSize / 8)
+	frame := make([]byte, frameSize)
+	for i, j := 0, len(data)-frameSize; i < j; i, j = i+frameSize, j-frameSize {
+		copy(frame, data[i:i+frameSize])
+		copy(data[i:i+frameSize], data[j:j+frameSize])
+		copy(data[j:j+frameSize], frame)
+	}
+}
+
+//WaveReverse reverses the frames of the wave, so it plays backwards
+//Recommended to use wave.Reverse() instead
+func WaveReverse(wave *Wave) {
+	wave.Reverse()
+}
+
+//WaveMix creates a wave of the waves played over each other, as long as the longest one. The samples are added together and clipped.
+// The new wave has the format of the first, the others are converted to it. Waves without channels are skipped.
+func WaveMix(waves ...*Wave) *Wave {
+	if len(waves) == 0 {
+		return nil
+	}
+	first := waves[0]
+	if !validWaveFormat(first.SampleSize, first.Channels) {
+		return NewWave(0, first.SampleRate, first.SampleSize, first.Channels)
+	}
+	channels := int(first.Channels)
+
+	mixed := make([]float32, 0)
+	for _, wave := range waves {
+		if !validWaveFormat(wave.SampleSize, wave.Channels) {
+			continue
+		}
+		samples := wave.floatsAs(first.SampleRate, first.Channels)
+		if len(samples) > len(mixed) {
+			mixed = append(mixed, make([]float32, len(samples)-len(mixed))...)
+		}
+		for i, s := range samples {
+			mixed[i] += s
+		}
+	}
+
+	result := NewWave(uint32(len(mixed)/channels), first.SampleRate, first.SampleSize, first.Channels)
+	result.writeFloats(mixed)
+	return result
+}
+
+//WaveConcat creates a wave of the waves played one after another. The new wave has the format of the first, the others are converted to it.
+// Waves without channels are skipped.
+func WaveConcat(waves ...*Wave) *Wave {
+	if len(waves) == 0 {
+		return nil
+	}
+	first := waves[0]
+	if !validWaveFormat(first.SampleSize, first.Channels) {
+		return NewWave(0, first.SampleRate, first.SampleSize, first.Channels)
+	}
+
+	joined := make([]float32, 0)
+	for _, wave := range waves {
+		if !validWaveFormat(wave.SampleSize, wave.Channels) {
+			continue
+		}
+		joined = append(joined, wave.floatsAs(first.SampleRate, first.Channels)...)
+	}
+
+	result := NewWave(uint32(len(joined))/first.Channels, first.SampleRate, first.SampleSize, first.Channels)
+	result.writeFloats(joined)
+	return result
+}
+
+//floats reads the samples of the wave from -1 to 1, converting them the way miniaudio does for WaveFormat
+func (wave *Wave) floats() []float32 {
+	samples := make([]float32, wave.SampleCount*wave.Channels)
+	switch wave.SampleSize {
+	case 8:
+		for i, s := range WaveSamples[uint8](wave) {
+			samples[i] = float32(s)*(2.0/255) - 1
+		}
+	case 16:
+		for i, s := range WaveSamples[int16](wave) {
+			samples[i] = float32(s) / 32768
+		}
+	case 32:
+		copy(samples, WaveSamples[float32](wave))
+	}
+	return samples
+}
+
+//floatsAs reads the samples of the wave, converted to the sample rate and channels
+func (wave *Wave) floatsAs(sampleRate uint32, channels uint32) []float32 {
+	samples := remapChannels(wave.floats(), int(wave.Channels), int(channels))
+	return resampleFrames(samples, int(channels), wave.SampleRate, sampleRate)
+}
+
+//setFloats replaces the data of the wave with the samples, which are the channels of the wave
+func (wave *Wave) setFloats(samples []float32) {
+	C.free(wave.data)
+	wave.SampleCount = uint32(len(samples)) / wave.Channels
+	wave.data = C.calloc(1, C.size_t(len(samples)*int(wave.SampleSize/8)))
+	wave.writeFloats(samples)
+}
+
+//writeFloats writes the samples into the data of the wave, clipping them like miniaudio does
+func (wave *Wave) writeFloats(samples []float32) {
+	switch wave.SampleSize {
+	case 8:
+		dst := WaveSamples[uint8](wave)
+		for i := range dst {
+			dst[i] = uint8((Clamp32(samples[i], -1, 1) + 1) * 127.5)
+		}
+	case 16:
+		dst := WaveSamples[int16](wave)
+		for i := range dst {
+			dst[i] = int16(Clamp32(samples[i], -1, 1) * 32767)
+		}
+	case 32:
+		dst := WaveSamples[float32](wave)
+		for i := range dst {
+			dst[i] = Clamp32(samples[i], -1, 1)
+		}
+	}
+}
+
+//resampleFrames converts interleaved samples between sample rates with linear interpolation.
+// The number of frames is rounded up, like miniaudio does.
+func resampleFrames(samples []float32, channels int, from uint32, to uint32) []float32 {
+	if from == to || from == 0 || to == 0 || len(samples) == 0 {
+		return samples
+	}
+	frames := len(samples) / channels
+	count := int(math.Ceil(float64(frames) * float64(to) / float64(from)))
+	result := make([]float32, count*channels)
+
+	ratio := float64(from) / float64(to)
+	for i := 0; i < count; i++ {
+		position := float64(i) * ratio
+		index := int(position)
+		amount := float32(position - float64(index))
+		next := index + 1
+		if index >= frames {
+			index = frames - 1
+		}
+		if next >= frames {
+			next = frames - 1
+		}
+		for c := 0; c < channels; c++ {
+			a, b := samples[index*channels+c], samples[next*channels+c]
+			result[i*channels+c] = a + (b-a)*amount
+		}
+	}
+	return result
+}
+
+//remapChannels converts interleaved samples between channel counts. Mono is copied to every channel, and going to mono averages the channels.
+func remapChannels(samples []float32, from int, to int) []float32 {
+	if from == to {
+		return samples
+	}
+	frames := len(samples) / from
+	result := make([]float32, frames*to)
+	for i := 0; i < frames; i++ {
+		frame := samples[i*from : i*from+from]
+		if to == 1 {
+			sum := float32(0)
+			for _, s := range frame {
+				sum += s
+			}
+			result[i] = sum / float32(from)
+			continue
+		}
+		for c := 0; c < to; c++ {
+			result[i*to+c] = frame[c%from]
+		}
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+package raylib
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+//testSine makes interleaved int16 samples of a sine wave, offset in every channel
+func testSine(frames int, channels int, rate float64, freq float64) []int16 {
+	samples := make([]int16, frames*channels)
+	for i := 0; i < frames; i++ {
+		for c := 0; c < channels; c++ {
+			samples[i*channels+c] = int16(math.Sin(2*math.Pi*freq*float64(i)/rate+float64(c)) * 20000)
+		}
+	}
+	return samples
+}
+
+//maxDifference is the largest difference between the samples, skipping the edges where resamplers differ the most
+func maxDifference(a []int16, b []int16, edge int) float64 {
+	worst := 0.0
+	for i := edge; i < len(a)-edge && i < len(b)-edge; i++ {
+		worst = math.Max(worst, math.Abs(float64(a[i])-float64(b[i])))
+	}
+	return worst
+}
+
+func TestWaveSampleCountIsFrames(t *testing.T) {
+	wave := NewWaveFromSamples(testSine(1000, 2, 22050, 220), 22050, 2)
+	defer wave.Unload()
+	if wave.SampleCount != 1000 || len(WaveSamples[int16](wave)) != 2000 || len(wave.Bytes()) != 4000 {
+		t.Fatalf("stereo wave has %d frames, %d samples", wave.SampleCount, len(WaveSamples[int16](wave)))
+	}
+
+	//WaveFormat and WaveCrop count frames too
+	formatted := wave.Copy()
+	defer formatted.Unload()
+	formatted.Format(22050, 32, 1)
+	if formatted.SampleCount != 1000 {
+		t.Fatalf("WaveFormat to mono has %d frames", formatted.SampleCount)
+	}
+	cropped := wave.Copy()
+	defer cropped.Unload()
+	cropped.Crop(100, 300)
+	if cropped.SampleCount != 200 || !bytes.Equal(cropped.Bytes(), wave.Bytes()[100*4:300*4]) {
+		t.Fatalf("WaveCrop has %d frames", cropped.SampleCount)
+	}
+
+	//a sound of a stereo wave is as long as the wave, and not half of it
+	InitAudioDevice()
+	if !IsAudioDeviceReady() {
+		t.Skip("no audio device")
+	}
+	defer CloseAudioDevice()
+	sound := LoadSoundFromWave(wave)
+	defer sound.Unload()
+	frames := sound.SampleCount / sound.Stream.Channels
+	if want := uint32(1000 * 44100 / 22050); frames < want-1 || frames > want+1 {
+		t.Fatalf("sound has %d frames, expected %d", frames, want)
+	}
+}
+
+func TestWaveZeroChannels(t *testing.T) {
+	empty := []*Wave{
+		NewWave(10, 44100, 16, 0),
+		NewWave(10, 44100, 12, 1),
+		NewWaveFromSamples([]int16{1, 2, 3}, 44100, 0),
+		NewWaveFromBytes([]byte{1, 2, 3}, 44100, 16, 0),
+		WaveMix(NewWave(10, 44100, 16, 0)),
+		WaveConcat(NewWave(10, 44100, 16, 0)),
+	}
+	for i, wave := range empty {
+		if wave.SampleCount != 0 || wave.Bytes() != nil {
+			t.Errorf("wave %d has %d frames", i, wave.SampleCount)
+		}
+		wave.Resample(22050)
+		wave.Normalize(1)
+		wave.Reverse()
+		wave.Unload()
+	}
+
+	//waves without channels are skipped
+	wave := NewWaveFromSamples([]float32{0.5, 0.5}, 44100, 1)
+	defer wave.Unload()
+	mixed := WaveMix(wave, &Wave{SampleCount: 10, SampleRate: 44100, SampleSize: 16})
+	defer mixed.Unload()
+	joined := WaveConcat(wave, &Wave{SampleCount: 10, SampleRate: 44100, SampleSize: 16})
+	defer joined.Unload()
+	if mixed.SampleCount != 2 || joined.SampleCount != 2 {
+		t.Fatalf("mixed %d and joined %d frames", mixed.SampleCount, joined.SampleCount)
+	}
+
+	//a sample rate of 0 is ignored
+	wave.Resample(0)
+	if wave.SampleRate != 44100 || wave.SampleCount != 2 {
+		t.Fatalf("resampled to 0 gives %d frames at %d", wave.SampleCount, wave.SampleRate)
+	}
+}
+
+func TestWaveFloatsMatchWaveFormat(t *testing.T) {
+	wave := NewWaveFromSamples(testSine(1000, 2, 22050, 220), 22050, 2)
+	defer wave.Unload()
+
+	floats := wave.Copy()
+	defer floats.Unload()
+	floats.Format(22050, 32, 2)
+	mine := wave.floats()
+	theirs := WaveSamples[float32](floats)
+	for i := range mine {
+		if mine[i] != theirs[i] {
+			t.Fatalf("sample %d is %v, WaveFormat gives %v", i, mine[i], theirs[i])
+		}
+	}
+
+	for _, size := range []uint32{8, 16} {
+		formatted := floats.Copy()
+		formatted.Format(22050, int(size), 2)
+		written := NewWave(floats.SampleCount, 22050, size, 2)
+		written.writeFloats(theirs)
+		if !bytes.Equal(formatted.Bytes(), written.Bytes()) {
+			t.Errorf("%d bit samples differ from WaveFormat", size)
+		}
+		formatted.Unload()
+		written.Unload()
+	}
+}
+
+func TestWaveResample(t *testing.T) {
+	wave := NewWaveFromSamples(testSine(1000, 2, 22050, 220), 22050, 2)
+	defer wave.Unload()
+
+	for _, rate := range []uint32{11025, 44100, 48000} {
+		formatted := wave.Copy()
+		formatted.Format(int(rate), 16, 2)
+		resampled := wave.Copy()
+		resampled.Resample(rate)
+		if resampled.SampleCount != formatted.SampleCount || resampled.SampleRate != rate {
+			t.Errorf("resampled to %d has %d frames, WaveFormat gives %d", rate, resampled.SampleCount, formatted.SampleCount)
+		} else if worst := maxDifference(WaveSamples[int16](formatted), WaveSamples[int16](resampled), 40); worst > 800 {
+			t.Errorf("resampled to %d differs from WaveFormat by %v", rate, worst)
+		}
+		formatted.Unload()
+		resampled.Unload()
+	}
+}
+
+func TestWaveReverse(t *testing.T) {
+	wave := NewWaveFromSamples(testSine(1000, 2, 22050, 220), 22050, 2)
+	defer wave.Unload()
+	wave.Crop(100, 300)
+
+	reversed := wave.Copy()
+	defer reversed.Unload()
+	reversed.Reverse()
+	samples, original := WaveSamples[int16](reversed), WaveSamples[int16](wave)
+	if samples[0] != original[398] || samples[1] != original[399] {
+		t.Fatalf("first frame is %v, expected %v", samples[:2], original[398:])
+	}
+	reversed.Reverse()
+	if !bytes.Equal(reversed.Bytes(), wave.Bytes()) {
+		t.Fatal("reversing twice changed the wave")
+	}
+}
+
+func TestWaveConcat(t *testing.T) {
+	wave := NewWaveFromSamples(testSine(200, 2, 22050, 220), 22050, 2)
+	defer wave.Unload()
+	mono := NewWaveFromSamples([]float32{0.5, 0.5, 0.5, 0.5}, 11025, 1)
+	defer mono.Unload()
+
+	joined := WaveConcat(wave, mono)
+	defer joined.Unload()
+	if joined.SampleCount != 208 || joined.Channels != 2 || joined.SampleSize != 16 || joined.SampleRate != 22050 {
+		t.Fatalf("joined wave has %d frames, %d channels of %d bits", joined.SampleCount, joined.Channels, joined.SampleSize)
+	}
+	//samples go through floats like WaveFormat, so int16 samples can be off by one
+	samples := WaveSamples[int16](joined)
+	if worst := maxDifference(samples[:400], WaveSamples[int16](wave), 0); worst > 1 {
+		t.Fatalf("first wave changed by %v", worst)
+	}
+
+	//the second wave is converted like WaveFormat does, which fades the last frame to silence
+	formatted := mono.Copy()
+	defer formatted.Unload()
+	formatted.Format(22050, 16, 2)
+	if worst := maxDifference(samples[400:], WaveSamples[int16](formatted), 2); worst > 1 {
+		t.Fatalf("second wave %v, WaveFormat gives %v", samples[400:], WaveSamples[int16](formatted))
+	}
+}
+
+func TestWaveMix(t *testing.T) {
+	loud := NewWaveFromSamples([]float32{0.75, -0.75}, 22050, 1)
+	defer loud.Unload()
+	long := NewWaveFromSamples([]float32{0, 0, 0.25}, 22050, 1)
+	defer long.Unload()
+
+	mixed := WaveMix(loud, loud, long)
+	defer mixed.Unload()
+	if samples := WaveSamples[float32](mixed); len(samples) != 3 || samples[0] != 1 || samples[1] != -1 || samples[2] != 0.25 {
+		t.Fatalf("mixed samples are %v", samples)
+	}
+
+	//mixing a wave with shorter silence in another format gives it back, like WaveFormat
+	wave := NewWaveFromSamples(testSine(200, 2, 22050, 220), 22050, 2)
+	defer wave.Unload()
+	silence := NewWave(100, 44100, 32, 1)
+	defer silence.Unload()
+	alone := WaveMix(wave, silence)
+	defer alone.Unload()
+	if worst := maxDifference(WaveSamples[int16](alone), WaveSamples[int16](wave), 0); alone.SampleCount != 200 || worst > 1 {
+		t.Fatalf("mixing silence changed the wave by %v", worst)
+	}
+}
+
+func TestWaveNormalize(t *testing.T) {
+	wave := NewWaveFromSamples([]uint8{128, 160, 96}, 8000, 1)
+	defer wave.Unload()
+	wave.Normalize(1)
+
+	//the peak is the loudest sample, both ways
+	formatted := wave.Copy()
+	defer formatted.Unload()
+	formatted.Format(8000, 32, 1)
+	peak := float32(0)
+	for _, s := range WaveSamples[float32](formatted) {
+		peak = max(peak, float32(math.Abs(float64(s))))
+	}
+	if peak < 0.99 {
+		t.Fatalf("normalized peak is %v", peak)
+	}
+}
+
+func TestNewWaveFromBytes(t *testing.T) {
+	wave := NewWaveFromBytes([]byte{1, 0, 2, 0, 3, 0}, 8000, 16, 1)
+	defer wave.Unload()
+	if wave.SampleCount != 3 || WaveSamples[int16](wave)[2] != 3 || WaveSamples[float32](wave) != nil {
+		t.Fatalf("wave has %d frames", wave.SampleCount)
+	}
+}